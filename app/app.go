@@ -29,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -115,6 +116,7 @@ import (
 	interchainstakingtypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainquery"
+	interchainqueryclient "github.com/ingenuity-build/quicksilver/x/interchainquery/client"
 	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	interchainquerytypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 
@@ -163,6 +165,7 @@ var (
 			// Custom proposal types
 			interchainstakingclient.RegisterProposalHandler, interchainstakingclient.UpdateProposalHandler,
 			participationrewardsclient.AddProtocolDataProposalHandler,
+			interchainqueryclient.RegisterRelayerProposalHandler, interchainqueryclient.DeregisterRelayerProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -194,7 +197,7 @@ var (
 		ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:               nil,
 		interchainstakingtypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-		interchainquerytypes.ModuleName:   nil,
+		interchainquerytypes.ModuleName:   {authtypes.Burner},
 		// TODO: Remove Burner from participationrewards - for dev/test only;
 		participationrewardstypes.ModuleName: {authtypes.Burner},
 		airdroptypes.ModuleName:              nil,
@@ -419,7 +422,7 @@ func NewQuicksilver(
 	)
 	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
 
-	app.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper)
+	app.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper, app.BankKeeper, app.GetSubspace(interchainquerytypes.ModuleName))
 	interchainQueryModule := interchainquery.NewAppModule(appCodec, app.InterchainQueryKeeper)
 
 	app.InterchainstakingKeeper = interchainstakingkeeper.NewKeeper(
@@ -484,13 +487,16 @@ func NewQuicksilver(
 
 	app.ParticipationRewardsKeeper.SetEpochsKeeper(app.EpochsKeeper)
 
+	// observe the receipt of redemptions returned over IBC.
+	transferStack := interchainstaking.NewTransferMiddleware(transferIBCModule, app.InterchainstakingKeeper)
+
 	icaControllerIBCModule := icacontroller.NewIBCModule(app.ICAControllerKeeper, interchainstakingIBCModule)
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
 		AddRoute(interchainstakingtypes.ModuleName, icaControllerIBCModule)
@@ -512,7 +518,8 @@ func NewQuicksilver(
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(interchainstakingtypes.RouterKey, interchainstaking.NewProposalHandler(app.InterchainstakingKeeper)).
-		AddRoute(participationrewardstypes.RouterKey, participationrewards.NewProposalHandler(app.ParticipationRewardsKeeper))
+		AddRoute(participationrewardstypes.RouterKey, participationrewards.NewProposalHandler(app.ParticipationRewardsKeeper)).
+		AddRoute(interchainquerytypes.RouterKey, interchainquery.NewProposalHandler(app.InterchainQueryKeeper))
 	// add custom proposal routes here.
 
 	govKeeper := govkeeper.NewKeeper(
@@ -677,33 +684,32 @@ func NewQuicksilver(
 	// // add test gRPC service for testing gRPC queries in isolation
 	// // testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
 
-	// // create the simulation manager and define the order of the modules for deterministic simulations
-
-	// // NOTE: this is not required apps that don't use the simulator for fuzz testing
-	// // transactions
-	// app.sm = module.NewSimulationManager(
-	// 	auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-	// 	bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
-	// 	capability.NewAppModule(appCodec, *app.CapabilityKeeper),
-	// 	gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-	// 	mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
-	// 	staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
-	// 	distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-	// 	slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-	// 	params.NewAppModule(app.ParamsKeeper),
-	// 	evidence.NewAppModule(app.EvidenceKeeper),
-	// 	feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-	// 	authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-	// 	// ibc modules
-	// 	ibc.NewAppModule(app.IBCKeeper),
-	// 	transferModule,
-	// 	//icaModule,
-	// 	// Quicksilver app modules
-	// 	epochs.NewAppModule(appCodec, app.EpochsKeeper),
-	// 	interchainstakingModule,
-	// )
-
-	// app.sm.RegisterStoreDecoders()
+	// create the simulation manager and define the order of the modules for deterministic simulations
+	//
+	// NOTE: this is not required apps that don't use the simulator for fuzz testing
+	// transactions
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
+		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		distrSimulationModule{distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper)},
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		params.NewAppModule(app.ParamsKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper),
+		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		// ibc modules
+		ibc.NewAppModule(app.IBCKeeper),
+		transferModule,
+		// Quicksilver app modules
+		epochs.NewAppModule(appCodec, app.EpochsKeeper),
+		interchainstakingModule,
+		interchainQueryModule,
+	)
+
+	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -732,6 +738,10 @@ func NewQuicksilver(
 		"v0.6.6",
 		Getv0_6_6Upgrade(app),
 	)
+	app.UpgradeKeeper.SetUpgradeHandler(
+		"v0.7.0",
+		Getv0_7_0Upgrade(app),
+	)
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -979,3 +989,15 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(airdroptypes.ModuleName)
 	return paramsKeeper
 }
+
+// distrSimulationModule wraps the distribution module for the simulation manager. The community pool
+// spend proposal type is not registered with gov by the cosmos-sdk fork in use, so submitting one always
+// fails validation; such proposals are therefore not simulated.
+type distrSimulationModule struct {
+	distr.AppModule
+}
+
+// ProposalContents doesn't return any content functions for governance proposals.
+func (distrSimulationModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
@@ -0,0 +1,169 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// simulationConfig returns the simulation config from the command line flags. When the simulator is not
+// explicitly enabled, a short deterministic simulation is configured so that `go test` exercises the
+// application, its simulated operations and invariants on every run.
+func simulationConfig() (simtypes.Config, bool) {
+	config := simapp.NewConfigFromFlags()
+	config.ChainID = "quicksilver-sim"
+	if simapp.FlagEnabledValue {
+		return config, false
+	}
+
+	config.Seed = 42
+	config.NumBlocks = 30
+	config.BlockSize = 50
+	config.InitialBlockHeight = 1
+	config.Commit = true
+	config.AllInvariants = false
+	return config, true
+}
+
+func newSimulationApp(t *testing.T, config simtypes.Config, short bool) (*Quicksilver, dbm.DB, string) {
+	t.Helper()
+
+	var (
+		db     dbm.DB
+		dir    string
+		logger log.Logger
+		err    error
+	)
+	if short {
+		db, dir, logger = dbm.NewMemDB(), "", log.NewNopLogger()
+	} else {
+		_, db, dir, logger, _, err = simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+		require.NoError(t, err, "simulation setup failed")
+	}
+
+	// check invariants on every block of a short simulation.
+	invCheckPeriod := simapp.FlagPeriodValue
+	if short {
+		invCheckPeriod = 1
+	}
+
+	app := NewQuicksilver(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, invCheckPeriod, MakeEncodingConfig(), simapp.EmptyAppOptions{}, fauxMerkleModeOpt)
+	return app, db, dir
+}
+
+// TestFullAppSimulation runs a full application simulation, including the interchainstaking operations against
+// the mock host chain, checking all registered invariants.
+func TestFullAppSimulation(t *testing.T) {
+	config, short := simulationConfig()
+	app, db, dir := newSimulationApp(t, config, short)
+	defer func() {
+		db.Close()
+		if dir != "" {
+			require.NoError(t, os.RemoveAll(dir))
+		}
+	}()
+
+	// run randomized simulation
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		AppStateFn(app.AppCodec(), app.SimulationManager()),
+		simtypes.RandomAccounts, // replace with own random account function if using keys other than secp256k1
+		simapp.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err := simapp.CheckExportSimulation(app, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+}
+
+// TestAppStateDeterminism runs the same simulation several times for each of a number of seeds and checks that
+// the resulting app hashes are identical.
+func TestAppStateDeterminism(t *testing.T) {
+	if !simapp.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simapp.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = "quicksilver-sim"
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 5
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed = rand.Int63()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			db := dbm.NewMemDB()
+			app := NewQuicksilver(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{}, fauxMerkleModeOpt)
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err := simulation.SimulateFromSeed(
+				t,
+				os.Stdout,
+				app.BaseApp,
+				AppStateFn(app.AppCodec(), app.SimulationManager()),
+				simtypes.RandomAccounts,
+				simapp.SimulationOperations(app, app.AppCodec(), config),
+				app.ModuleAccountAddrs(),
+				config,
+				app.AppCodec(),
+			)
+			require.NoError(t, err)
+
+			if config.Commit {
+				simapp.PrintStats(db)
+			}
+
+			appHash := app.LastCommitID().Hash
+			appHashList[j] = appHash
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
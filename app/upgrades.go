@@ -18,6 +18,16 @@ func Getv0_6_6Upgrade(app *Quicksilver) types.UpgradeHandler {
 	}
 }
 
+func Getv0_7_0Upgrade(app *Quicksilver) types.UpgradeHandler {
+	return func(ctx sdk.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// the host validator set is now queried as a single proven subspace of the staking store.
+		if err := app.InterchainstakingKeeper.ReplaceLegacyValsetQueries(ctx); err != nil {
+			return nil, err
+		}
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	}
+}
+
 func GetInnuendo1Upgrade(app *Quicksilver) types.UpgradeHandler {
 	return func(ctx sdk.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		ReplaceZoneDropChain(ctx, app, "osmotestnet-4", "osmo-test-4", ctx.BlockHeader().Time)
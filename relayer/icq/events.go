@@ -0,0 +1,95 @@
+package icq
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// Query is a request for the state of a host chain, as emitted by the interchainquery module.
+type Query struct {
+	ID           string
+	ConnectionID string
+	ChainID      string
+	Type         string
+	Request      []byte
+	// Height is the host height the query is pinned to; zero for any recent height.
+	Height int64
+}
+
+// ParseQueries returns the queries emitted in the given events; other events are ignored.
+func ParseQueries(events []abci.Event) ([]Query, error) {
+	queries := []Query{}
+	for _, event := range events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if attrs[sdk.AttributeKeyModule] != types.AttributeValueCategory || attrs[sdk.AttributeKeyAction] != types.AttributeValueQuery {
+			continue
+		}
+
+		request, err := hex.DecodeString(attrs[types.AttributeKeyRequest])
+		if err != nil {
+			return nil, fmt.Errorf("invalid request of query %s: %w", attrs[types.AttributeKeyQueryID], err)
+		}
+		height, err := strconv.ParseInt(attrs[types.AttributeKeyHeight], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height of query %s: %w", attrs[types.AttributeKeyQueryID], err)
+		}
+		queries = append(queries, Query{
+			ID:           attrs[types.AttributeKeyQueryID],
+			ConnectionID: attrs[types.AttributeKeyConnectionID],
+			ChainID:      attrs[types.AttributeKeyChainID],
+			Type:         attrs[types.AttributeKeyType],
+			Request:      request,
+			Height:       height,
+		})
+	}
+	return queries, nil
+}
+
+// SubscribeEvents returns the end block events of each new block of the controller chain. Queries are emitted in
+// the EndBlocker of the interchainquery module, so this is where they are found.
+func SubscribeEvents(ctx context.Context, client rpcclient.EventsClient, subscriber string) (<-chan []abci.Event, error) {
+	blocks, err := client.Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan []abci.Event)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case block, ok := <-blocks:
+				if !ok {
+					return
+				}
+				data, ok := block.Data.(tmtypes.EventDataNewBlock)
+				if !ok {
+					continue
+				}
+				select {
+				case out <- data.ResultEndBlock.Events:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,82 @@
+package icq
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// Host is the backend of a host chain, against which queries are run.
+type Host interface {
+	// ChainID returns the chain id of the host.
+	ChainID() string
+	// LatestHeight returns the height of the latest block committed by the host.
+	LatestHeight(ctx context.Context) (int64, error)
+	// ABCIQuery runs an ABCI query against the state of the host at the given height.
+	ABCIQuery(ctx context.Context, path string, data []byte, height int64, prove bool) (abci.ResponseQuery, error)
+}
+
+// RPCClient is the subset of the tendermint RPC client used by RPCHost.
+type RPCClient interface {
+	rpcclient.ABCIClient
+	rpcclient.StatusClient
+}
+
+// RPCHost is a host reached through the RPC endpoint of one of its nodes.
+type RPCHost struct {
+	chainID string
+	client  RPCClient
+}
+
+var _ Host = RPCHost{}
+
+// NewRPCHost returns a host reached through the given RPC client.
+func NewRPCHost(chainID string, client RPCClient) RPCHost {
+	return RPCHost{chainID: chainID, client: client}
+}
+
+func (h RPCHost) ChainID() string {
+	return h.chainID
+}
+
+func (h RPCHost) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := h.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (h RPCHost) ABCIQuery(ctx context.Context, path string, data []byte, height int64, prove bool) (abci.ResponseQuery, error) {
+	res, err := h.client.ABCIQueryWithOptions(ctx, path, data, rpcclient.ABCIQueryOptions{Height: height, Prove: prove})
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+	return res.Response, nil
+}
+
+// LocalHost is a stand-in host that answers queries from an in-process application, such as an ibctesting chain.
+type LocalHost struct {
+	chainID string
+	app     abci.Application
+}
+
+var _ Host = LocalHost{}
+
+// NewLocalHost returns a host answering queries from the given application.
+func NewLocalHost(chainID string, app abci.Application) LocalHost {
+	return LocalHost{chainID: chainID, app: app}
+}
+
+func (h LocalHost) ChainID() string {
+	return h.chainID
+}
+
+func (h LocalHost) LatestHeight(_ context.Context) (int64, error) {
+	return h.app.Info(abci.RequestInfo{}).LastBlockHeight, nil
+}
+
+func (h LocalHost) ABCIQuery(_ context.Context, path string, data []byte, height int64, prove bool) (abci.ResponseQuery, error) {
+	return h.app.Query(abci.RequestQuery{Path: path, Data: data, Height: height, Prove: prove}), nil
+}
@@ -0,0 +1,180 @@
+// Package icq is a reference relayer for the interchainquery module. It watches the controller chain for emitted
+// queries, runs each against its host chain, and submits the results, with proofs where the query type requires
+// them, back to the controller.
+//
+// The relayer does not update the controller's light client of the host. A proven response at host height h is
+// verified against the consensus state at h+1, so the client must be kept current, by an IBC relayer or otherwise,
+// for proven responses at recent heights to be accepted.
+package icq
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// Submitter delivers responses to the controller chain.
+type Submitter interface {
+	Submit(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+// SubmitterFunc is a function that delivers responses to the controller chain.
+type SubmitterFunc func(ctx context.Context, msgs ...sdk.Msg) error
+
+func (f SubmitterFunc) Submit(ctx context.Context, msgs ...sdk.Msg) error {
+	return f(ctx, msgs...)
+}
+
+// NewTxSubmitter returns a submitter that signs and broadcasts responses in a transaction to the controller chain.
+func NewTxSubmitter(clientCtx client.Context, txf tx.Factory) Submitter {
+	return SubmitterFunc(func(_ context.Context, msgs ...sdk.Msg) error {
+		return tx.BroadcastTx(clientCtx, txf, msgs...)
+	})
+}
+
+// Relayer answers the queries of a controller chain from a single host chain.
+type Relayer struct {
+	host      Host
+	submitter Submitter
+	signer    sdk.AccAddress
+	logger    log.Logger
+}
+
+// NewRelayer returns a relayer answering queries from the given host, and submitting the responses signed by the
+// given account.
+func NewRelayer(host Host, submitter Submitter, signer sdk.AccAddress, logger log.Logger) *Relayer {
+	return &Relayer{
+		host:      host,
+		submitter: submitter,
+		signer:    signer,
+		logger:    logger.With("module", "icq-relayer", "chain_id", host.ChainID()),
+	}
+}
+
+// Run answers the queries in each batch of events received, until the context is done or the events are closed.
+// Failures are logged; a batch that cannot be answered does not stop the relayer.
+func (r *Relayer) Run(ctx context.Context, events <-chan []abci.Event) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case batch, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := r.HandleEvents(ctx, batch); err != nil {
+				r.logger.Error("unable to handle events", "error", err)
+			}
+		}
+	}
+}
+
+// HandleEvents answers the queries of the host emitted in the given events, and submits the responses together.
+// A query that cannot be answered is logged and skipped.
+func (r *Relayer) HandleEvents(ctx context.Context, events []abci.Event) error {
+	queries, err := ParseQueries(events)
+	if err != nil {
+		return err
+	}
+
+	msgs := []sdk.Msg{}
+	for _, query := range queries {
+		if query.ChainID != r.host.ChainID() {
+			continue
+		}
+		msg, err := r.Respond(ctx, query)
+		if err != nil {
+			r.logger.Error("unable to answer query", "query_id", query.ID, "type", query.Type, "error", err)
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	r.logger.Info("submitting responses", "count", len(msgs))
+	return r.submitter.Submit(ctx, msgs...)
+}
+
+// Respond runs a query against the host, and returns the response to submit to the controller. A query pinned to a
+// height is run at that height; any other at the height before the latest, the most recent for which a consensus
+// state of the host may exist. Store queries, of type store/<module>/key, are answered with a proof of the stored
+// value, and subspace queries, of type store/<module>/subspace, with a proof of each gap around the stored pairs;
+// any other type is run as a gRPC query of the same name.
+func (r *Relayer) Respond(ctx context.Context, query Query) (*types.MsgSubmitQueryResponse, error) {
+	height := query.Height
+	if height == 0 {
+		latest, err := r.host.LatestHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		height = latest - 1
+	}
+
+	msg := &types.MsgSubmitQueryResponse{
+		ChainId:     query.ChainID,
+		QueryId:     query.ID,
+		Height:      height,
+		FromAddress: r.signer.String(),
+	}
+
+	path := "/" + strings.TrimPrefix(query.Type, "/")
+	if !types.IsSubspace(query.Type) {
+		res, err := r.query(ctx, path, query.Request, height, types.IsProven(query.Type))
+		if err != nil {
+			return nil, err
+		}
+		msg.Result, msg.ProofOps = res.Value, res.ProofOps
+		return msg, nil
+	}
+
+	res, err := r.query(ctx, path, query.Request, height, false)
+	if err != nil {
+		return nil, err
+	}
+	var pairs kv.Pairs
+	if err := pairs.Unmarshal(res.Value); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal subspace result: %w", err)
+	}
+
+	// the prefix, and the immediate successor of each key, are probed in turn; see utils.ValidateSubspaceProofOps.
+	keyPath := strings.TrimSuffix(path, "subspace") + "key"
+	probe := query.Request
+	for i := 0; i <= len(pairs.Pairs); i++ {
+		proof, err := r.query(ctx, keyPath, probe, height, true)
+		if err != nil {
+			return nil, err
+		}
+		msg.SubspaceProofOps = append(msg.SubspaceProofOps, proof.ProofOps)
+		if i < len(pairs.Pairs) {
+			probe = append(append([]byte{}, pairs.Pairs[i].Key...), 0)
+		}
+	}
+	msg.Result = res.Value
+	return msg, nil
+}
+
+// query runs an ABCI query against the host, failing if it is unsuccessful or, when a proof is requested, has none.
+func (r *Relayer) query(ctx context.Context, path string, data []byte, height int64, prove bool) (abci.ResponseQuery, error) {
+	res, err := r.host.ABCIQuery(ctx, path, data, height, prove)
+	if err != nil {
+		return res, err
+	}
+	if !res.IsOK() {
+		return res, fmt.Errorf("query %s failed with code %d: %s", path, res.Code, res.Log)
+	}
+	if prove && res.ProofOps == nil {
+		return res, fmt.Errorf("no proof returned for query %s", path)
+	}
+	return res, nil
+}
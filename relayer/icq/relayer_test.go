@@ -0,0 +1,202 @@
+package icq_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ingenuity-build/quicksilver/app"
+	"github.com/ingenuity-build/quicksilver/relayer/icq"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+}
+
+func TestRelayerTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerTestSuite))
+}
+
+type RelayerTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// the controller, issuing queries, and the host, answering them.
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func (suite *RelayerTestSuite) GetSimApp(chain *ibctesting.TestChain) *app.Quicksilver {
+	app, ok := chain.App.(*app.Quicksilver)
+	if !ok {
+		panic("not quicksilver app")
+	}
+
+	return app
+}
+
+func (suite *RelayerTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.coordinator.SetupConnections(suite.path)
+}
+
+func (suite *RelayerTestSuite) TestHandleEvents() {
+	// the controller's client of the host must hold the consensus state of the latest host block to verify proofs.
+	suite.NoError(suite.path.EndpointA.UpdateClient())
+
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqKeeper := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	// responses to unproven queries are only accepted from bonded relayers.
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	bond := sdk.NewCoins(icqKeeper.GetParams(ctx).MinRelayerBond)
+	suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, bond))
+	suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, relayer, bond))
+	icqKeeper.RegisterRelayer(ctx, relayer)
+	suite.NoError(icqKeeper.BondRelayer(ctx, relayer, bond))
+
+	account := suite.chainB.SenderAccount.GetAddress()
+	balances := suite.GetSimApp(suite.chainB).BankKeeper.GetAllBalances(suite.chainB.GetContext(), account)
+	suite.NotEmpty(balances)
+
+	allBalances := banktypes.QueryAllBalancesRequest{Address: account.String()}
+	allBalancesRequest, err := allBalances.Marshal()
+	suite.NoError(err)
+
+	connectionID, chainID := suite.path.EndpointB.ConnectionID, suite.chainB.ChainID
+	requests := []struct {
+		chainID   string
+		queryType string
+		request   []byte
+	}{
+		{chainID, "store/bank/key", append(banktypes.CreateAccountBalancesPrefix(account), []byte(balances[0].Denom)...)},
+		{chainID, "cosmos.bank.v1beta1.Query/AllBalances", allBalancesRequest},
+		{chainID, "store/bank/subspace", banktypes.CreateAccountBalancesPrefix(account)},
+		{chainID, "cosmos.unknown.v1beta1.Query/Unknown", []byte{}},
+		{"otherchain-1", "cosmos.bank.v1beta1.Query/AllBalances", allBalancesRequest},
+	}
+	ids := []string{}
+	for _, r := range requests {
+		icqKeeper.MakeRequest(ctx, connectionID, r.chainID, r.queryType, r.request, sdk.NewInt(100), "", "", 100, 0, sdk.Coin{})
+		ids = append(ids, keeper.GenerateQueryHash(connectionID, r.chainID, r.queryType, r.request, ""))
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	icqKeeper.EndBlocker(ctx)
+	events := ctx.EventManager().ABCIEvents()
+
+	queries, err := icq.ParseQueries(events)
+	suite.NoError(err)
+	suite.Len(queries, len(requests))
+
+	// the relayer delivers its responses straight to the controller's msg server.
+	submitted := []sdk.Msg{}
+	msgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	submitter := icq.SubmitterFunc(func(_ context.Context, msgs ...sdk.Msg) error {
+		for _, msg := range msgs {
+			if _, err := msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), msg.(*icqtypes.MsgSubmitQueryResponse)); err != nil {
+				return err
+			}
+			submitted = append(submitted, msg)
+		}
+		return nil
+	})
+
+	host := icq.NewLocalHost(chainID, suite.chainB.App)
+	r := icq.NewRelayer(host, submitter, relayer, log.NewNopLogger())
+	suite.NoError(r.HandleEvents(context.Background(), events))
+
+	// 1. the queries of the host that could be answered are submitted together; others are skipped.
+	suite.Len(submitted, 3)
+
+	// 2. the proven query is answered with the stored balance.
+	datapoint, err := icqKeeper.GetDatapointForID(ctx, ids[0])
+	suite.NoError(err)
+	var balance sdk.Coin
+	suite.NoError(balance.Unmarshal(datapoint.Value))
+	suite.Equal(balances[0], balance)
+
+	// 3. the unproven query is answered with the gRPC response of the host.
+	datapoint, err = icqKeeper.GetDatapointForID(ctx, ids[1])
+	suite.NoError(err)
+	var allBalancesResponse banktypes.QueryAllBalancesResponse
+	suite.NoError(allBalancesResponse.Unmarshal(datapoint.Value))
+	suite.Equal(balances, allBalancesResponse.Balances)
+
+	// 4. the subspace query is answered with every stored balance of the account.
+	datapoint, err = icqKeeper.GetDatapointForID(ctx, ids[2])
+	suite.NoError(err)
+	var pairs kv.Pairs
+	suite.NoError(pairs.Unmarshal(datapoint.Value))
+	suite.Len(pairs.Pairs, len(balances))
+	for i, pair := range pairs.Pairs {
+		suite.NoError(balance.Unmarshal(pair.Value))
+		suite.Equal(balances[i], balance)
+	}
+
+	for _, id := range ids[3:] {
+		_, err = icqKeeper.GetDatapointForID(ctx, id)
+		suite.Error(err)
+	}
+}
+
+func (suite *RelayerTestSuite) TestRespondPinnedHeight() {
+	height := suite.chainB.LastHeader.Header.Height - 2
+	r := icq.NewRelayer(icq.NewLocalHost(suite.chainB.ChainID, suite.chainB.App), nil, suite.chainA.SenderAccount.GetAddress(), log.NewNopLogger())
+
+	msg, err := r.Respond(context.Background(), icq.Query{
+		ID:      "id",
+		ChainID: suite.chainB.ChainID,
+		Type:    "store/bank/key",
+		Request: []byte("key"),
+		Height:  height,
+	})
+	suite.NoError(err)
+	suite.Equal(height, msg.Height)
+	suite.NotNil(msg.ProofOps)
+}
+
+func TestParseQueries(t *testing.T) {
+	query := abci.Event{Type: sdk.EventTypeMessage, Attributes: []abci.EventAttribute{
+		{Key: []byte(sdk.AttributeKeyModule), Value: []byte(icqtypes.ModuleName)},
+		{Key: []byte(sdk.AttributeKeyAction), Value: []byte(icqtypes.AttributeValueQuery)},
+		{Key: []byte(icqtypes.AttributeKeyQueryID), Value: []byte("id")},
+		{Key: []byte(icqtypes.AttributeKeyChainID), Value: []byte("chain-1")},
+		{Key: []byte(icqtypes.AttributeKeyConnectionID), Value: []byte("connection-0")},
+		{Key: []byte(icqtypes.AttributeKeyType), Value: []byte("store/bank/key")},
+		{Key: []byte(icqtypes.AttributeKeyHeight), Value: []byte("10")},
+		{Key: []byte(icqtypes.AttributeKeyRequest), Value: []byte("0102")},
+	}}
+	other := abci.Event{Type: sdk.EventTypeMessage, Attributes: []abci.EventAttribute{
+		{Key: []byte(sdk.AttributeKeyModule), Value: []byte("bank")},
+	}}
+
+	queries, err := icq.ParseQueries([]abci.Event{other, query})
+	require.NoError(t, err)
+	require.Equal(t, []icq.Query{{ID: "id", ConnectionID: "connection-0", ChainID: "chain-1", Type: "store/bank/key", Request: []byte{1, 2}, Height: 10}}, queries)
+
+	query.Attributes[7].Value = []byte("invalid")
+	_, err = icq.ParseQueries([]abci.Event{query})
+	require.Error(t, err)
+}
@@ -1,12 +1,16 @@
 package utils
 
 import (
+	"bytes"
 	"fmt"
 	"net/url"
 
+	ics23 "github.com/confio/ics23/go"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibcKeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	tmclienttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -16,18 +20,9 @@ func ValidateProofOps(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID
 	if proofOps == nil {
 		return fmt.Errorf("unable to validate proof. No proof submitted")
 	}
-	connection, _ := ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
-
-	csHeight := clienttypes.NewHeight(clienttypes.ParseChainID(chainID), uint64(height)+1)
-	consensusState, found := ibcKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, csHeight)
-
-	if !found {
-		return fmt.Errorf("unable to fetch consensus state")
-	}
-
-	clientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
-	if !found {
-		return fmt.Errorf("unable to fetch client state")
+	root, specs, err := consensusRoot(ctx, ibcKeeper, connectionID, chainID, height)
+	if err != nil {
+		return err
 	}
 
 	path := commitmenttypes.NewMerklePath([]string{module, url.PathEscape(string(key))}...)
@@ -37,22 +32,122 @@ func ValidateProofOps(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID
 		return fmt.Errorf("error converting proofs")
 	}
 
-	tmClientState, ok := clientState.(*tmclienttypes.ClientState)
-	if !ok {
-		return fmt.Errorf("error unmarshaling client state")
-	}
-
 	if len(data) != 0 {
 		// if we got a non-nil response, verify inclusion proof.
-		if err := merkleProof.VerifyMembership(tmClientState.ProofSpecs, consensusState.GetRoot(), path, data); err != nil {
+		if err := merkleProof.VerifyMembership(specs, root, path, data); err != nil {
 			return fmt.Errorf("unable to verify inclusion proof: %s", err)
 		}
 		return nil
 
 	}
 	// if we got a nil response, verify non inclusion proof.
-	if err := merkleProof.VerifyNonMembership(tmClientState.ProofSpecs, consensusState.GetRoot(), path); err != nil {
+	if err := merkleProof.VerifyNonMembership(specs, root, path); err != nil {
 		return fmt.Errorf("unable to verify non-inclusion proof: %s", err)
 	}
 	return nil
 }
+
+// ValidateSubspaceProofOps verifies that pairs are all of the key/value pairs stored under prefix in the given
+// module store of the host. Completeness is proven gap by gap: proofOps holds one proof for each of len(pairs)+1
+// probe keys, the first being the prefix itself and each other the immediate successor of a pair's key. A probe
+// proven to exist must be the next pair; a probe proven absent must have the next pair as its right neighbour, and
+// after the last pair, no right neighbour under the prefix.
+func ValidateSubspaceProofOps(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID string, chainID string, height int64, module string, prefix []byte, pairs []kv.Pair, proofOps []*crypto.ProofOps) error {
+	if len(proofOps) != len(pairs)+1 {
+		return fmt.Errorf("unable to validate subspace proof. Expected %d proofs, got %d", len(pairs)+1, len(proofOps))
+	}
+	for i, pair := range pairs {
+		if !bytes.HasPrefix(pair.Key, prefix) {
+			return fmt.Errorf("key %X is not under prefix %X", pair.Key, prefix)
+		}
+		if i > 0 && bytes.Compare(pairs[i-1].Key, pair.Key) >= 0 {
+			return fmt.Errorf("keys are not in ascending order at %X", pair.Key)
+		}
+	}
+	root, specs, err := consensusRoot(ctx, ibcKeeper, connectionID, chainID, height)
+	if err != nil {
+		return err
+	}
+
+	probe := prefix
+	for i, proofOp := range proofOps {
+		if proofOp == nil {
+			return fmt.Errorf("unable to validate subspace proof. No proof submitted for probe %X", probe)
+		}
+		merkleProof, err := commitmenttypes.ConvertProofs(proofOp)
+		if err != nil || merkleProof.Empty() {
+			return fmt.Errorf("error converting proofs")
+		}
+		path := commitmenttypes.NewMerklePath([]string{module, url.PathEscape(string(probe))}...)
+
+		var next *kv.Pair
+		if i < len(pairs) {
+			next = &pairs[i]
+		}
+
+		switch proof := merkleProof.Proofs[0].Proof.(type) {
+		case *ics23.CommitmentProof_Exist:
+			// the probe exists, so must itself be the next pair.
+			if next == nil || !bytes.Equal(next.Key, probe) {
+				return fmt.Errorf("unexpected key %X under prefix %X", probe, prefix)
+			}
+			if err := merkleProof.VerifyMembership(specs, root, path, next.Value); err != nil {
+				return fmt.Errorf("unable to verify inclusion proof: %s", err)
+			}
+		case *ics23.CommitmentProof_Nonexist:
+			// the probe is absent, so the next key of the store after it must be the next pair, if any.
+			if err := merkleProof.VerifyNonMembership(specs, root, path); err != nil {
+				return fmt.Errorf("unable to verify non-inclusion proof: %s", err)
+			}
+			right := proof.Nonexist.Right
+			switch {
+			case next == nil && right != nil && bytes.HasPrefix(right.Key, prefix):
+				return fmt.Errorf("omitted key %X under prefix %X", right.Key, prefix)
+			case next != nil && (right == nil || !bytes.Equal(right.Key, next.Key)):
+				return fmt.Errorf("key %X is not the next key under prefix %X", next.Key, prefix)
+			case next != nil && !bytes.Equal(right.Value, next.Value):
+				return fmt.Errorf("unable to verify value of key %X", next.Key)
+			}
+		default:
+			return fmt.Errorf("unexpected proof type %T", proof)
+		}
+
+		if next != nil {
+			probe = append(append([]byte{}, next.Key...), 0)
+		}
+	}
+	return nil
+}
+
+// consensusRoot returns the commitment root of the host at the given height, and the proof specs against which
+// to verify it, from the light client of the connection. A proof of the state at a height is verified against the
+// consensus state of the following height, whose header commits to it.
+// ValidateConsensusHeight checks that the light client of the connection holds the consensus state against which
+// proofs of the given remote height are verified.
+func ValidateConsensusHeight(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID string, chainID string, height int64) error {
+	_, _, err := consensusRoot(ctx, ibcKeeper, connectionID, chainID, height)
+	return err
+}
+
+func consensusRoot(ctx sdk.Context, ibcKeeper *ibcKeeper.Keeper, connectionID string, chainID string, height int64) (exported.Root, []*ics23.ProofSpec, error) {
+	connection, _ := ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+
+	csHeight := clienttypes.NewHeight(clienttypes.ParseChainID(chainID), uint64(height)+1)
+	consensusState, found := ibcKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, csHeight)
+
+	if !found {
+		return nil, nil, fmt.Errorf("unable to fetch consensus state")
+	}
+
+	clientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return nil, nil, fmt.Errorf("unable to fetch client state")
+	}
+
+	tmClientState, ok := clientState.(*tmclienttypes.ClientState)
+	if !ok {
+		return nil, nil, fmt.Errorf("error unmarshaling client state")
+	}
+
+	return consensusState.GetRoot(), tmClientState.ProofSpecs, nil
+}
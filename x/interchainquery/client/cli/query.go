@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+const (
+	FlagModule             = "module"
+	FlagCallback           = "callback"
+	FlagQueryType          = "query-type"
+	FlagIncludeAnswered    = "include-answered"
+	FlagIncludeQuarantined = "include-quarantined"
+	FlagBlocks             = "blocks"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		Aliases:                    []string{"icq"},
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueries(),
+		GetCmdQuery(),
+		GetCmdDatapoints(),
+		GetCmdDatapoint(),
+		GetCmdChainStats(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueries returns the pending queries, optionally filtered.
+func GetCmdQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queries [chain_id]",
+		Short: "Query the pending queries of a chain, or of every chain",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery queries cosmoshub-4 --callback valset --include-answered`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRequestsRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				req.ChainId = args[0]
+			}
+			if req.Module, err = cmd.Flags().GetString(FlagModule); err != nil {
+				return err
+			}
+			if req.CallbackId, err = cmd.Flags().GetString(FlagCallback); err != nil {
+				return err
+			}
+			if req.QueryType, err = cmd.Flags().GetString(FlagQueryType); err != nil {
+				return err
+			}
+			if req.IncludeAnswered, err = cmd.Flags().GetBool(FlagIncludeAnswered); err != nil {
+				return err
+			}
+			if req.IncludeQuarantined, err = cmd.Flags().GetBool(FlagIncludeQuarantined); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Queries(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return printWithRequests(clientCtx, res)
+		},
+	}
+
+	cmd.Flags().String(FlagModule, "", "only queries owned by this module")
+	cmd.Flags().String(FlagCallback, "", "only queries with this callback")
+	cmd.Flags().String(FlagQueryType, "", "only queries of this type")
+	cmd.Flags().Bool(FlagIncludeAnswered, false, "include queries answered since they were last emitted")
+	cmd.Flags().Bool(FlagIncludeQuarantined, false, "include quarantined queries")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queries")
+
+	return cmd
+}
+
+// GetCmdQuery returns a single query by id.
+func GetCmdQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query [id]",
+		Short: "Query a single query by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			res, err := queryClient.Query(cmd.Context(), &types.QueryQueryRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return printWithRequests(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDatapoints returns the stored results of queries.
+func GetCmdDatapoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "datapoints",
+		Short: "Query the stored results of queries",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Datapoints(cmd.Context(), &types.QueryDatapointsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "datapoints")
+
+	return cmd
+}
+
+// GetCmdDatapoint returns the stored result of a single query.
+func GetCmdDatapoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "datapoint [id]",
+		Short: "Query the stored result of a single query",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			res, err := queryClient.Datapoint(cmd.Context(), &types.QueryDatapointRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChainStats returns statistics of the queries of a chain.
+func GetCmdChainStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [chain_id]",
+		Short: "Query statistics of the queries of a chain",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery stats cosmoshub-4 --blocks 100`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQuerySrvrClient(clientCtx)
+
+			blocks, err := cmd.Flags().GetUint64(FlagBlocks)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ChainStats(cmd.Context(), &types.QueryChainStatsRequest{ChainId: args[0], Blocks: blocks})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlocks, 0, "count responses over this many recent blocks (default: the whole response stats window)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// printWithRequests prints a response as PrintProto does, with the request of each query it contains decoded into
+// readable JSON.
+func printWithRequests(clientCtx client.Context, res proto.Message) error {
+	bz, err := clientCtx.Codec.MarshalJSON(res)
+	if err != nil {
+		return err
+	}
+	var out interface{}
+	if err := json.Unmarshal(bz, &out); err != nil {
+		return err
+	}
+	decodeRequests(clientCtx.Codec, out)
+
+	if clientCtx.OutputFormat == "text" {
+		bz, err = yaml.Marshal(out)
+		if err != nil {
+			return err
+		}
+		return clientCtx.PrintBytes(bz)
+	}
+	bz, err = json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintString(string(bz) + "\n")
+}
+
+// decodeRequests replaces the base64 encoded request of every query within a JSON value with its decoded request.
+func decodeRequests(cdc codec.JSONCodec, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		queryType, hasType := v["query_type"].(string)
+		if request, ok := v["request"].(string); ok && hasType {
+			if bz, err := base64.StdEncoding.DecodeString(request); err == nil {
+				var decoded interface{}
+				if err := json.Unmarshal(types.DecodeRequest(cdc, queryType, bz), &decoded); err == nil {
+					v["request"] = decoded
+				}
+			}
+		}
+		for _, value := range v {
+			decodeRequests(cdc, value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			decodeRequests(cdc, value)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// GetTxCmd returns a root CLI command handler for all x/interchainquery transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Aliases:                    []string{"icq"},
+		Short:                      "Interchain query transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(GetBondRelayerTxCmd())
+	txCmd.AddCommand(GetFundBountyBudgetTxCmd())
+
+	return txCmd
+}
+
+// GetBondRelayerTxCmd returns a CLI command handler for adding to the bond of
+// a registered relayer.
+func GetBondRelayerTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bond-relayer [amount]",
+		Short:   `Add to the bond of the sending registered relayer.`,
+		Example: `bond-relayer 1000000000uqck`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBondRelayer(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetFundBountyBudgetTxCmd returns a CLI command handler for adding to the
+// bounty budget of a module.
+func GetFundBountyBudgetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-bounty-budget [module] [amount]",
+		Short:   `Add to the bounty budget from which the query bounties of a module are paid.`,
+		Example: `fund-bounty-budget interchainstaking 1000000uqck`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundBountyBudget(clientCtx.GetFromAddress(), args[0], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitRegisterRelayerProposal implements the command to submit a relayer registration proposal.
+func GetCmdSubmitRegisterRelayerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-icq-relayer [relayer]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to register an interchain query relayer",
+		Example: `register-icq-relayer quick1... --title="Register relayer" --description="..." --deposit=512000000uqck --from=<key_or_address>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRegisterRelayerProposal(title, description, args[0])
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitDeregisterRelayerProposal implements the command to submit a relayer deregistration proposal.
+func GetCmdSubmitDeregisterRelayerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deregister-icq-relayer [relayer]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to deregister an interchain query relayer",
+		Example: `deregister-icq-relayer quick1... --title="Deregister relayer" --description="..." --deposit=512000000uqck --from=<key_or_address>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDeregisterRelayerProposal(title, description, args[0])
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+func parseProposalFlags(cmd *cobra.Command) (title string, description string, deposit sdk.Coins, err error) {
+	if title, err = cmd.Flags().GetString(govcli.FlagTitle); err != nil {
+		return
+	}
+	if description, err = cmd.Flags().GetString(govcli.FlagDescription); err != nil {
+		return
+	}
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return
+	}
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	return
+}
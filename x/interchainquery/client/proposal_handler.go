@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/client/cli"
+)
+
+// ProposalHandler is the relayer registry proposal handler.
+var (
+	RegisterRelayerProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitRegisterRelayerProposal, emptyRestHandler)
+	DeregisterRelayerProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitDeregisterRelayerProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-icq-client",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for ICQ proposals")
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package interchainquery
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
@@ -10,16 +12,42 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	// set registered zones info from genesis
 	for _, query := range genState.Queries {
 		// Initialize empty epoch values via Cosmos SDK
 		k.SetQuery(ctx, query)
 	}
+	for _, dp := range genState.Datapoints {
+		if _, found := k.GetQuery(ctx, dp.Id); !found {
+			panic(fmt.Sprintf("datapoint refers to unknown query %s", dp.Id))
+		}
+		k.SetDatapoint(ctx, dp)
+	}
+	for _, budget := range genState.BountyBudgets {
+		k.SetBountyBudget(ctx, budget)
+	}
+	for _, payout := range genState.RelayerPayouts {
+		k.SetRelayerPayout(ctx, payout)
+	}
+	for _, relayer := range genState.Relayers {
+		k.SetRelayer(ctx, relayer)
+	}
+	for _, record := range genState.ResponseRecords {
+		k.SetResponseRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Queries: k.AllQueries(ctx),
+		Queries:         k.AllQueries(ctx),
+		BountyBudgets:   k.AllBountyBudgets(ctx),
+		RelayerPayouts:  k.AllRelayerPayouts(ctx),
+		Params:          k.GetParams(ctx),
+		Relayers:        k.AllRelayers(ctx),
+		ResponseRecords: k.AllResponseRecords(ctx),
+		Datapoints:      k.AllDatapoints(ctx),
 	}
 }
@@ -67,7 +67,7 @@ func (suite *InterChainQueryTestSuite) TestInitGenesis() {
 		0,
 	)
 
-	interchainquery.InitGenesis(suite.chainA.GetContext(), suite.GetSimApp(suite.chainA).InterchainQueryKeeper, types.GenesisState{Queries: []types.Query{*query}})
+	interchainquery.InitGenesis(suite.chainA.GetContext(), suite.GetSimApp(suite.chainA).InterchainQueryKeeper, *types.NewGenesisState([]types.Query{*query}, types.DefaultParams()))
 
 	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "")
 	queryResponse, found := suite.GetSimApp(suite.chainA).InterchainQueryKeeper.GetQuery(suite.chainA.GetContext(), id)
@@ -80,6 +80,58 @@ func (suite *InterChainQueryTestSuite) TestInitGenesis() {
 	suite.Equal("", queryResponse.CallbackId)
 }
 
+func (suite *InterChainQueryTestSuite) TestGenesisRoundTrip() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	params := types.DefaultParams()
+	params.MaxCallbackFailures = 7
+	icqKeeper.SetParams(ctx, params)
+
+	// an emitted query, mid-retry with a recorded callback failure, and its datapoint.
+	queryType := "cosmos.staking.v1beta1.Query/Validators"
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, []byte("request"), sdk.NewInt(50), "", "", 10, 0, sdk.Coin{})
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, []byte("request"), "")
+	icqKeeper.EndBlocker(ctx)
+	query, found := icqKeeper.GetQuery(ctx, id)
+	suite.True(found)
+	query.Retries = 1
+	query.Failures = 2
+	query.LastError = "callback failed"
+	icqKeeper.SetQuery(ctx, query)
+	suite.NoError(icqKeeper.SetDatapointForID(ctx, id, []byte("result"), sdk.NewInt(100)))
+
+	// export, and import into another chain.
+	cdc := suite.GetSimApp(suite.chainA).AppCodec()
+	exported := interchainquery.ExportGenesis(ctx, icqKeeper)
+	suite.Len(exported.Datapoints, 1)
+	genState := types.GenesisState{}
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(exported), &genState)
+	suite.NoError(genState.Validate())
+
+	importKeeper := suite.GetSimApp(suite.chainB).InterchainQueryKeeper
+	importCtx := suite.chainB.GetContext()
+	interchainquery.InitGenesis(importCtx, importKeeper, genState)
+	suite.Equal(exported, interchainquery.ExportGenesis(importCtx, importKeeper))
+
+	// the emission, retry and expiry schedules are restored with the state.
+	suite.Contains(importKeeper.DueQueries(importCtx, query.Deadline+1), id)
+	suite.NotContains(importKeeper.DueQueries(importCtx, query.Deadline), id)
+	dp, err := importKeeper.GetDatapointForID(importCtx, id)
+	suite.NoError(err)
+	suite.Contains(importKeeper.ExpiredDatapoints(importCtx, dp.Expiry+1), id)
+	suite.Empty(importKeeper.ExpiredDatapoints(importCtx, dp.Expiry))
+}
+
+func (suite *InterChainQueryTestSuite) TestGenesisUnknownDatapoint() {
+	genState := types.DefaultGenesis()
+	genState.Datapoints = []types.DataPoint{{Id: "unknown", RemoteHeight: sdk.NewInt(1), LocalHeight: sdk.NewInt(1)}}
+	suite.ErrorContains(genState.Validate(), "unknown query")
+	suite.Panics(func() {
+		interchainquery.InitGenesis(suite.chainA.GetContext(), suite.GetSimApp(suite.chainA).InterchainQueryKeeper, *genState)
+	})
+}
+
 func newSimAppPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	path := ibctesting.NewPath(chainA, chainB)
 	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/hex"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -11,53 +13,148 @@ import (
 )
 
 const (
+	// RetryInterval is the default backoff of a query, in blocks.
 	RetryInterval = 25
+	// MaxRetries is the default number of times an unanswered query is retried before it times out.
+	MaxRetries = 3
 )
 
-// EndBlocker of interchainquery module
+// EndBlocker of interchainquery module. Queries are processed only when due, as found in the query schedule, and
+// datapoints and response records are removed only when expired, as found in their height indexes; no store is
+// scanned.
 func (k Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	_ = k.Logger(ctx)
 	events := sdk.Events{}
-	// emit events for periodic queries
-	k.IterateQueries(ctx, func(_ int64, queryInfo types.Query) (stop bool) {
-		if queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero() || queryInfo.LastEmission.Add(queryInfo.Period).Equal(sdk.NewInt(ctx.BlockHeight())) {
+	timedOut := []types.Query{}
+	// emit events for periodic queries, and retry unanswered queries whose deadline has passed
+	for _, id := range k.DueQueries(ctx, ctx.BlockHeight()) {
+		queryInfo, found := k.GetQuery(ctx, id)
+		if !found {
+			continue
+		}
+		switch {
+		case queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero() || (queryInfo.Period.IsPositive() && queryInfo.LastEmission.Add(queryInfo.Period).LTE(sdk.NewInt(ctx.BlockHeight()))):
 			k.Logger(ctx).Info("Interchainquery event emitted", "id", queryInfo.Id)
-			event := sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
-				sdk.NewAttribute(types.AttributeKeyQueryID, queryInfo.Id),
-				sdk.NewAttribute(types.AttributeKeyChainID, queryInfo.ChainId),
-				sdk.NewAttribute(types.AttributeKeyConnectionID, queryInfo.ConnectionId),
-				sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
-				// TODO: add height to request type
-				sdk.NewAttribute(types.AttributeKeyHeight, "0"),
-				sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
-			)
-
-			events = append(events, event)
+			events = append(events, queryEvent(queryInfo))
 			queryInfo.LastEmission = sdk.NewInt(ctx.BlockHeight())
+			queryInfo.Retries = 0
+			queryInfo.Deadline = nextDeadline(ctx, queryInfo)
 			k.SetQuery(ctx, queryInfo)
 
+		case queryInfo.Deadline > 0 && ctx.BlockHeight() > queryInfo.Deadline:
+			if queryInfo.Retries >= queryInfo.MaxRetries {
+				timedOut = append(timedOut, queryInfo)
+				continue
+			}
+			queryInfo.Retries++
+			k.Logger(ctx).Info("Interchainquery unanswered; event re-emitted", "id", queryInfo.Id, "retry", queryInfo.Retries)
+			events = append(events, queryEvent(queryInfo))
+			queryInfo.Deadline = nextDeadline(ctx, queryInfo)
+			k.SetQuery(ctx, queryInfo)
 		}
-		return false
-	})
+	}
 
 	if len(events) > 0 {
 		ctx.EventManager().EmitEvents(events)
 	}
 
-	k.IterateDatapoints(ctx, func(_ int64, dp types.DataPoint) bool {
-		q, found := k.GetQuery(ctx, dp.Id)
-		if !found {
-			// query was removed; delete datapoint
-			k.DeleteDatapoint(ctx, dp.Id)
-		} else if dp.LocalHeight.Int64()+int64(q.Ttl) > ctx.BlockHeader().Height {
-			// gc old data
-			k.DeleteDatapoint(ctx, dp.Id)
-		}
+	for _, query := range timedOut {
+		k.timeoutQuery(ctx, query)
+	}
+
+	k.pruneResponseRecords(ctx)
+	k.pruneResponseCounts(ctx)
 
-		return false
-	})
+	// gc old data
+	for _, id := range k.ExpiredDatapoints(ctx, ctx.BlockHeight()) {
+		k.DeleteDatapoint(ctx, id)
+	}
+}
+
+func queryEvent(queryInfo types.Query) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
+		sdk.NewAttribute(types.AttributeKeyQueryID, queryInfo.Id),
+		sdk.NewAttribute(types.AttributeKeyChainID, queryInfo.ChainId),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, queryInfo.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(queryInfo.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
+	)
+}
+
+// nextDeadline returns the deadline of a query emitted at the current height; the backoff doubles with each retry.
+// A query with no backoff has no deadline.
+func nextDeadline(ctx sdk.Context, queryInfo types.Query) int64 {
+	if queryInfo.Backoff == 0 {
+		return 0
+	}
+	return ctx.BlockHeight() + int64(queryInfo.Backoff<<queryInfo.Retries)
+}
+
+// nextDue returns the height at which a query is next due in the EndBlocker: its next emission, or the block after
+// its deadline, whichever is earlier. A query that is never emitted again, and has no deadline, is not due; nor is
+// a quarantined query.
+func nextDue(queryInfo types.Query) (int64, bool) {
+	height, due := int64(0), false
+	switch {
+	case queryInfo.Quarantined:
+		return 0, false
+	case queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero():
+		return 0, true
+	case queryInfo.Period.IsPositive():
+		height, due = queryInfo.LastEmission.Add(queryInfo.Period).Int64(), true
+	}
+	if queryInfo.Deadline > 0 && (!due || queryInfo.Deadline+1 < height) {
+		height, due = queryInfo.Deadline+1, true
+	}
+	return height, due
+}
+
+// timeoutQuery gives up on a query that remains unanswered after its final retry. A single query is removed, and a
+// periodic query awaits its next emission. The timeout callbacks of the modules handling the query's callback are
+// then invoked; a failing timeout callback is logged and its state changes discarded.
+func (k Keeper) timeoutQuery(ctx sdk.Context, query types.Query) {
+	k.Logger(ctx).Error("Interchainquery timed out", "id", query.Id, "chain_id", query.ChainId, "type", query.QueryType, "retries", query.Retries)
+
+	if query.Period.IsNegative() {
+		k.DeleteQuery(ctx, query.Id)
+	} else {
+		query.Deadline = 0
+		query.Retries = 0
+		k.SetQuery(ctx, query)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueTimeout),
+		sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, query.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+	))
+
+	keys := []string{}
+	for key := range k.callbacks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		module := k.callbacks[key]
+		if !module.Has(query.CallbackId) {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := module.CallTimeout(cacheCtx, query.CallbackId, query); err != nil {
+			k.Logger(ctx).Error("error in timeout callback", "error", err, "module", key, "id", query.Id, "callback", query.CallbackId)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
 }
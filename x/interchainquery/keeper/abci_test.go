@@ -1,10 +1,20 @@
 package keeper_test
 
 import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/ingenuity-build/quicksilver/app"
 	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlocker() {
@@ -54,3 +64,220 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	// call end blocker
 	suite.GetSimApp(suite.chainA).InterchainQueryKeeper.EndBlocker(suite.chainA.GetContext())
 }
+
+// timeoutCallbacks records the queries passed to its timeout callback.
+type timeoutCallbacks struct {
+	timedOut *[]icqtypes.Query
+}
+
+func (c timeoutCallbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallbacks {
+	return c
+}
+
+func (c timeoutCallbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
+	return c
+}
+
+func (c timeoutCallbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
+	return nil
+}
+
+func (c timeoutCallbacks) Has(id string) bool {
+	return id == "test"
+}
+
+func (c timeoutCallbacks) CallTimeout(ctx sdk.Context, id string, query icqtypes.Query) error {
+	*c.timedOut = append(*c.timedOut, query)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerRetriesAndTimesOut() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	timedOut := []icqtypes.Query{}
+	suite.NoError(icqKeeper.SetCallbackHandler("test", timeoutCallbacks{&timedOut}))
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "test")
+
+	ctx := suite.chainA.GetContext()
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(-1), "test", "test", 0, 0, sdk.Coin{})
+
+	emitted := func(ctx sdk.Context) bool {
+		for _, event := range ctx.EventManager().Events() {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == icqtypes.AttributeKeyQueryID && string(attr.Value) == id {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// the query is emitted with a deadline one backoff away.
+	height := ctx.BlockHeight()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	icqKeeper.EndBlocker(ctx)
+	suite.True(emitted(ctx))
+	query, found := icqKeeper.GetQuery(ctx, id)
+	suite.True(found)
+	suite.Equal(height+keeper.RetryInterval, query.Deadline)
+
+	// the unanswered query is re-emitted after each deadline, with the backoff doubling.
+	for retry := uint32(1); retry <= keeper.MaxRetries; retry++ {
+		ctx = ctx.WithBlockHeight(query.Deadline).WithEventManager(sdk.NewEventManager())
+		icqKeeper.EndBlocker(ctx)
+		suite.False(emitted(ctx))
+
+		height = query.Deadline + 1
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		icqKeeper.EndBlocker(ctx)
+		suite.True(emitted(ctx))
+		query, found = icqKeeper.GetQuery(ctx, id)
+		suite.True(found)
+		suite.Equal(retry, query.Retries)
+		suite.Equal(height+int64(keeper.RetryInterval<<retry), query.Deadline)
+	}
+	suite.Empty(timedOut)
+
+	// after the final retry the query times out; it is removed and the timeout callback invoked.
+	ctx = ctx.WithBlockHeight(query.Deadline + 1).WithEventManager(sdk.NewEventManager())
+	icqKeeper.EndBlocker(ctx)
+	_, found = icqKeeper.GetQuery(ctx, id)
+	suite.False(found)
+	suite.Len(timedOut, 1)
+	suite.Equal(id, timedOut[0].Id)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerSchedule() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	suite.NoError(icqKeeper.SetCallbackHandler("test", timeoutCallbacks{&[]icqtypes.Query{}}))
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "test")
+
+	ctx := suite.chainA.GetContext()
+	height := ctx.BlockHeight()
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(10), "test", "test", 5, 0, sdk.Coin{})
+
+	// 1. a new query is due immediately; once emitted it is next due at its next emission.
+	suite.Contains(icqKeeper.DueQueries(ctx, height), id)
+	icqKeeper.EndBlocker(ctx)
+	suite.NotContains(icqKeeper.DueQueries(ctx, height+9), id)
+	suite.Contains(icqKeeper.DueQueries(ctx, height+10), id)
+
+	// 2. an unanswered query is due the block after its deadline, if that precedes its next emission.
+	query, _ := icqKeeper.GetQuery(ctx, id)
+	query.Deadline = height + 5
+	icqKeeper.SetQuery(ctx, query)
+	suite.NotContains(icqKeeper.DueQueries(ctx, height+5), id)
+	suite.Contains(icqKeeper.DueQueries(ctx, height+6), id)
+
+	// 3. a datapoint expires once the ttl of its query has passed.
+	suite.NoError(icqKeeper.SetDatapointForID(ctx, id, []byte("result"), sdk.NewInt(1)))
+	suite.Empty(icqKeeper.ExpiredDatapoints(ctx, height+5))
+	icqKeeper.EndBlocker(ctx.WithBlockHeight(height + 5))
+	_, err = icqKeeper.GetDatapointForID(ctx, id)
+	suite.NoError(err)
+	icqKeeper.EndBlocker(ctx.WithBlockHeight(height + 6))
+	_, err = icqKeeper.GetDatapointForID(ctx, id)
+	suite.Error(err)
+
+	// 4. a removed query is unscheduled, and its datapoint removed.
+	suite.NoError(icqKeeper.SetDatapointForID(ctx, id, []byte("result"), sdk.NewInt(1)))
+	icqKeeper.DeleteQuery(ctx, id)
+	suite.NotContains(icqKeeper.DueQueries(ctx, height+100), id)
+	suite.Empty(icqKeeper.ExpiredDatapoints(ctx, height+100))
+	_, err = icqKeeper.GetDatapointForID(ctx, id)
+	suite.Error(err)
+}
+
+const (
+	benchmarkQueryCount = 10_000
+	benchmarkPeriod     = 100
+	benchmarkHeight     = 1_000
+)
+
+// setupBenchmarkQueries sets benchmarkQueryCount periodic queries, each with a datapoint, staggered such that one in
+// benchmarkPeriod queries is due, and one in benchmarkPeriod datapoints expired, at benchmarkHeight.
+func setupBenchmarkQueries(b *testing.B) (*app.Quicksilver, sdk.Context) {
+	b.Helper()
+
+	quicksilver := app.NewQuicksilver(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		io.Discard,
+		true,
+		map[int64]bool{},
+		b.TempDir(),
+		5,
+		app.MakeEncodingConfig(),
+		simapp.EmptyAppOptions{},
+	)
+	// write directly to the root store, as committed state, rather than to a cached context.
+	ctx := quicksilver.NewUncachedContext(false, tmproto.Header{Height: benchmarkHeight})
+	icqKeeper := quicksilver.InterchainQueryKeeper
+
+	for i := 0; i < benchmarkQueryCount; i++ {
+		offset := int64(i % benchmarkPeriod)
+		query := icqKeeper.NewQuery(ctx, "", "connection-0", "testchain-1", "cosmos.bank.v1beta1.Query/AllBalances", []byte(fmt.Sprintf("request-%d", i)), sdk.NewInt(benchmarkPeriod), "", benchmarkPeriod)
+		query.LastEmission = sdk.NewInt(benchmarkHeight - benchmarkPeriod + offset)
+		query.Deadline = 0
+		icqKeeper.SetQuery(ctx, *query)
+		if err := icqKeeper.SetDatapointForID(ctx.WithBlockHeight(benchmarkHeight-benchmarkPeriod-1+offset), query.Id, []byte("result"), sdk.NewInt(1)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return quicksilver, ctx
+}
+
+func BenchmarkEndBlocker(b *testing.B) {
+	quicksilver, ctx := setupBenchmarkQueries(b)
+	icqKeeper := quicksilver.InterchainQueryKeeper
+	if due, expired := len(icqKeeper.DueQueries(ctx, benchmarkHeight)), len(icqKeeper.ExpiredDatapoints(ctx, benchmarkHeight)); due != benchmarkQueryCount/benchmarkPeriod || expired != benchmarkQueryCount/benchmarkPeriod {
+		b.Fatalf("unexpected due queries %d or expired datapoints %d", due, expired)
+	}
+
+	b.Run("indexed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			icqKeeper.EndBlocker(cacheCtx)
+		}
+	})
+
+	// scan finds and processes the same entries by decoding every query and datapoint, as the EndBlocker did before
+	// the query schedule and datapoint expiry index.
+	b.Run("scan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			height := sdk.NewInt(cacheCtx.BlockHeight())
+			due := []icqtypes.Query{}
+			icqKeeper.IterateQueries(cacheCtx, func(_ int64, query icqtypes.Query) bool {
+				if query.LastEmission.IsZero() || query.LastEmission.Add(query.Period).Equal(height) {
+					due = append(due, query)
+				}
+				return false
+			})
+			for _, query := range due {
+				query.LastEmission = height
+				icqKeeper.SetQuery(cacheCtx, query)
+			}
+			expired := []string{}
+			icqKeeper.IterateDatapoints(cacheCtx, func(_ int64, dp icqtypes.DataPoint) bool {
+				query, found := icqKeeper.GetQuery(cacheCtx, dp.Id)
+				if !found || dp.LocalHeight.Int64()+int64(query.Ttl) < height.Int64() {
+					expired = append(expired, dp.Id)
+				}
+				return false
+			})
+			for _, id := range expired {
+				icqKeeper.DeleteDatapoint(cacheCtx, id)
+			}
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// BountyDenom returns the denom in which query bounties are paid: the denom of the relayer bond.
+func (k Keeper) BountyDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).MinRelayerBond.Denom
+}
+
+// validateBountyBudgetFunding checks that a module owns queries, and that only the bounty denom is added to its
+// budget; coins of any other denom could never be paid out.
+func (k Keeper) validateBountyBudgetFunding(ctx sdk.Context, module string, amount sdk.Coins) error {
+	if _, exists := k.callbacks[module]; !exists {
+		return fmt.Errorf("no callback handler registered for module %s", module)
+	}
+	denom := k.BountyDenom(ctx)
+	for _, coin := range amount {
+		if coin.Denom != denom {
+			return fmt.Errorf("invalid bounty denom %s, expected %s", coin.Denom, denom)
+		}
+	}
+	return nil
+}
+
+// FundBountyBudget moves coins from the account of a module to the interchainquery module account, and adds them
+// to the bounty budget from which the bounties of that module's queries are paid.
+func (k Keeper) FundBountyBudget(ctx sdk.Context, module string, amount sdk.Coins) error {
+	if err := k.validateBountyBudgetFunding(ctx, module, amount); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, module, types.ModuleName, amount); err != nil {
+		return err
+	}
+	k.addToBountyBudget(ctx, module, amount)
+	return nil
+}
+
+// FundBountyBudgetFromAccount moves coins from an account to the interchainquery module account, and adds them to
+// the bounty budget from which the bounties of the given module's queries are paid.
+func (k Keeper) FundBountyBudgetFromAccount(ctx sdk.Context, from sdk.AccAddress, module string, amount sdk.Coins) error {
+	if err := k.validateBountyBudgetFunding(ctx, module, amount); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, amount); err != nil {
+		return err
+	}
+	k.addToBountyBudget(ctx, module, amount)
+	return nil
+}
+
+func (k Keeper) addToBountyBudget(ctx sdk.Context, module string, amount sdk.Coins) {
+	budget := k.GetBountyBudget(ctx, module)
+	budget.Balance = budget.Balance.Add(amount...)
+	k.SetBountyBudget(ctx, budget)
+}
+
+// GetBountyBudget returns the bounty budget of a module.
+func (k Keeper) GetBountyBudget(ctx sdk.Context, module string) types.BountyBudget {
+	budget := types.BountyBudget{Module: module, Balance: sdk.Coins{}}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBountyBudget)
+	bz := store.Get([]byte(module))
+	if len(bz) == 0 {
+		return budget
+	}
+	k.cdc.MustUnmarshal(bz, &budget)
+	return budget
+}
+
+// SetBountyBudget sets the bounty budget of a module.
+func (k Keeper) SetBountyBudget(ctx sdk.Context, budget types.BountyBudget) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBountyBudget)
+	store.Set([]byte(budget.Module), k.cdc.MustMarshal(&budget))
+}
+
+// IterateBountyBudgets iterates through the bounty budgets of all modules.
+func (k Keeper) IterateBountyBudgets(ctx sdk.Context, fn func(budget types.BountyBudget) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBountyBudget)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		budget := types.BountyBudget{}
+		k.cdc.MustUnmarshal(iterator.Value(), &budget)
+		if fn(budget) {
+			break
+		}
+	}
+}
+
+// AllBountyBudgets returns the bounty budgets of all modules.
+func (k Keeper) AllBountyBudgets(ctx sdk.Context) []types.BountyBudget {
+	budgets := []types.BountyBudget{}
+	k.IterateBountyBudgets(ctx, func(budget types.BountyBudget) bool {
+		budgets = append(budgets, budget)
+		return false
+	})
+	return budgets
+}
+
+// GetRelayerPayout returns the bounties paid to a relayer.
+func (k Keeper) GetRelayerPayout(ctx sdk.Context, relayer sdk.AccAddress) (types.RelayerPayout, bool) {
+	payout := types.RelayerPayout{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerPayout)
+	bz := store.Get(relayer)
+	if len(bz) == 0 {
+		return payout, false
+	}
+	k.cdc.MustUnmarshal(bz, &payout)
+	return payout, true
+}
+
+// SetRelayerPayout sets the bounties paid to a relayer.
+func (k Keeper) SetRelayerPayout(ctx sdk.Context, payout types.RelayerPayout) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerPayout)
+	store.Set(sdk.MustAccAddressFromBech32(payout.Relayer), k.cdc.MustMarshal(&payout))
+}
+
+// IterateRelayerPayouts iterates through the bounties paid to all relayers.
+func (k Keeper) IterateRelayerPayouts(ctx sdk.Context, fn func(payout types.RelayerPayout) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerPayout)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		payout := types.RelayerPayout{}
+		k.cdc.MustUnmarshal(iterator.Value(), &payout)
+		if fn(payout) {
+			break
+		}
+	}
+}
+
+// AllRelayerPayouts returns the bounties paid to all relayers.
+func (k Keeper) AllRelayerPayouts(ctx sdk.Context) []types.RelayerPayout {
+	payouts := []types.RelayerPayout{}
+	k.IterateRelayerPayouts(ctx, func(payout types.RelayerPayout) bool {
+		payouts = append(payouts, payout)
+		return false
+	})
+	return payouts
+}
+
+// payBounty pays the bounty of a proven query to the relayer of an accepted response, if the response is the first
+// since the query was last emitted. Responses to unproven queries may be fabricated, and are never paid. An unfunded
+// bounty is not paid, and does not cause the response to be rejected.
+func (k Keeper) payBounty(ctx sdk.Context, query types.Query, relayer string) error {
+	if !query.HasBounty() || !query.AwaitingResponse() || !types.IsProven(query.QueryType) {
+		return nil
+	}
+
+	budget := k.GetBountyBudget(ctx, query.Module)
+	if budget.Balance.AmountOf(query.Bounty.Denom).LT(query.Bounty.Amount) {
+		k.Logger(ctx).Error("insufficient bounty budget; bounty not paid", "module", query.Module, "id", query.Id, "bounty", query.Bounty, "budget", budget.Balance)
+		return nil
+	}
+
+	relayerAddr, err := sdk.AccAddressFromBech32(relayer)
+	if err != nil {
+		return fmt.Errorf("invalid relayer address: %w", err)
+	}
+	bounty := sdk.NewCoins(query.Bounty)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayerAddr, bounty); err != nil {
+		return err
+	}
+	budget.Balance = budget.Balance.Sub(bounty)
+	k.SetBountyBudget(ctx, budget)
+
+	payout, found := k.GetRelayerPayout(ctx, relayerAddr)
+	if !found {
+		payout = types.RelayerPayout{Relayer: relayerAddr.String(), Paid: sdk.Coins{}}
+	}
+	payout.Paid = payout.Paid.Add(bounty...)
+	payout.Responses++
+	k.SetRelayerPayout(ctx, payout)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueBounty),
+		sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+		sdk.NewAttribute(types.AttributeKeyRelayer, relayerAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, query.Bounty.String()),
+	))
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryBounties() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqKeeper := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	// any module with an account may own queries and fund their bounties.
+	suite.NoError(icqKeeper.SetCallbackHandler(minttypes.ModuleName, timeoutCallbacks{&[]icqtypes.Query{}}))
+	suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uqck", 1000))))
+	suite.NoError(icqKeeper.FundBountyBudget(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uqck", 15))))
+
+	// the validator record of the host's first validator, proven at the latest committed height.
+	validator := suite.GetSimApp(suite.chainB).StakingKeeper.GetAllValidators(suite.chainB.GetContext())[0]
+	key := stakingtypes.GetValidatorKey(validator.GetOperator())
+	height := suite.chainB.LastHeader.Header.Height - 1
+	res := suite.chainB.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", stakingtypes.StoreKey),
+		Height: height,
+		Data:   key,
+		Prove:  true,
+	})
+	suite.NotEmpty(res.Value)
+
+	queryType := fmt.Sprintf("store/%s/key", stakingtypes.StoreKey)
+	bounty := sdk.NewInt64Coin("uqck", 10)
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, key, sdk.NewInt(200), minttypes.ModuleName, "test", 0, 0, bounty)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, key, minttypes.ModuleName)
+
+	querySrv := icqtypes.QuerySrvrServer(icqKeeper)
+	bounties := func() []icqtypes.Query {
+		res, err := querySrv.Bounties(sdk.WrapSDKContext(ctx), &icqtypes.QueryBountiesRequest{ChainId: suite.chainB.ChainID})
+		suite.NoError(err)
+		return res.Queries
+	}
+
+	// the bounty is outstanding once the query is emitted.
+	suite.Empty(bounties())
+	icqKeeper.EndBlocker(ctx)
+	suite.Len(bounties(), 1)
+	suite.Equal(bounty, bounties()[0].Bounty)
+
+	relayer := suite.bondedRelayer(ctx)
+	msgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	respond := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		_, err := msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     id,
+			Result:      res.Value,
+			ProofOps:    res.ProofOps,
+			Height:      height,
+			FromAddress: relayer.String(),
+		})
+		suite.NoError(err)
+	}
+
+	// 1. the first response to the emission is paid the bounty.
+	respond()
+	suite.Equal(bounty, quicksilver.BankKeeper.GetBalance(ctx, relayer, "uqck"))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("uqck", 5)), icqKeeper.GetBountyBudget(ctx, minttypes.ModuleName).Balance)
+	suite.Empty(bounties())
+
+	// 2. later responses to the same emission are not.
+	respond()
+	suite.Equal(bounty, quicksilver.BankKeeper.GetBalance(ctx, relayer, "uqck"))
+
+	// 3. a response to a re-emitted query is accepted, but not paid when the budget is exhausted.
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, key, sdk.NewInt(200), minttypes.ModuleName, "test", 0, 0, bounty)
+	icqKeeper.EndBlocker(ctx)
+	suite.Len(bounties(), 1)
+	respond()
+	suite.Equal(bounty, quicksilver.BankKeeper.GetBalance(ctx, relayer, "uqck"))
+
+	payouts, err := querySrv.RelayerPayouts(sdk.WrapSDKContext(ctx), &icqtypes.QueryRelayerPayoutsRequest{Relayer: relayer.String()})
+	suite.NoError(err)
+	suite.Equal([]icqtypes.RelayerPayout{{Relayer: relayer.String(), Paid: sdk.NewCoins(bounty), Responses: 1}}, payouts.Payouts)
+
+	// 4. a bounty is not attached to an unproven query, as its responses may be fabricated.
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+	unprovenType := "cosmos.staking.v1beta1.Query/Validators"
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, unprovenType, bz, sdk.NewInt(200), minttypes.ModuleName, "test", 0, 0, bounty)
+	query, found := icqKeeper.GetQuery(ctx, keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, unprovenType, bz, minttypes.ModuleName))
+	suite.True(found)
+	suite.False(query.HasBounty())
+}
+
+func (suite *KeeperTestSuite) TestFundBountyBudget() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqKeeper := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	msgSrv := keeper.NewMsgServerImpl(icqKeeper)
+
+	suite.NoError(icqKeeper.SetCallbackHandler(minttypes.ModuleName, timeoutCallbacks{&[]icqtypes.Query{}}))
+	funder := utils.GenerateAccAddressForTest()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uqck", 100), sdk.NewInt64Coin("uatom", 100))
+	suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, funder, funds))
+
+	fund := func(module string, amount sdk.Coins) error {
+		_, err := msgSrv.FundBountyBudget(sdk.WrapSDKContext(ctx), icqtypes.NewMsgFundBountyBudget(funder, module, amount))
+		return err
+	}
+
+	// 1. only the bounty denom may be added to a budget.
+	suite.ErrorContains(fund(minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))), "invalid bounty denom")
+
+	// 2. only a module that owns queries has a budget.
+	suite.ErrorContains(fund("nomodule", sdk.NewCoins(sdk.NewInt64Coin("uqck", 10))), "no callback handler")
+
+	// 3. the bounty denom is moved from the funder to the budget of the module.
+	suite.NoError(fund(minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uqck", 40))))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("uqck", 40)), icqKeeper.GetBountyBudget(ctx, minttypes.ModuleName).Balance)
+	suite.Equal(sdk.NewInt64Coin("uqck", 60), quicksilver.BankKeeper.GetBalance(ctx, funder, "uqck"))
+
+	// 4. a bounty in any other denom is not attached to a query.
+	bz := stakingtypes.ValidatorsKey
+	queryType := fmt.Sprintf("store/%s/subspace", stakingtypes.StoreKey)
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, bz, sdk.NewInt(200), minttypes.ModuleName, "test", 0, 0, sdk.NewInt64Coin("uatom", 10))
+	query, found := icqKeeper.GetQuery(ctx, keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, bz, minttypes.ModuleName))
+	suite.True(found)
+	suite.False(query.HasBounty())
+}
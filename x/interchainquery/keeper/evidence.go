@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// GetResponseRecord returns the record of a response to an unproven query at a remote height.
+func (k Keeper) GetResponseRecord(ctx sdk.Context, queryID string, height int64) (types.ResponseRecord, bool) {
+	record := types.ResponseRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseRecord)
+	bz := store.Get(types.GetResponseRecordKey(queryID, height))
+	if len(bz) == 0 {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetResponseRecord sets the record of a response to an unproven query, and indexes it by the local height at which
+// it was received.
+func (k Keeper) SetResponseRecord(ctx sdk.Context, record types.ResponseRecord) {
+	k.DeleteResponseRecord(ctx, record.Query.Id, record.Height)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseRecord)
+	key := types.GetResponseRecordKey(record.Query.Id, record.Height)
+	store.Set(key, k.cdc.MustMarshal(&record))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseExpiry)
+	indexStore.Set(types.GetHeightIndexKey(record.LocalHeight, string(key)), []byte{})
+}
+
+// DeleteResponseRecord removes the record of a response to an unproven query, and its index entry.
+func (k Keeper) DeleteResponseRecord(ctx sdk.Context, queryID string, height int64) {
+	record, found := k.GetResponseRecord(ctx, queryID, height)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseRecord)
+	key := types.GetResponseRecordKey(queryID, height)
+	store.Delete(key)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseExpiry)
+	indexStore.Delete(types.GetHeightIndexKey(record.LocalHeight, string(key)))
+}
+
+// IterateResponseRecords iterates through the records of responses to unproven queries.
+func (k Keeper) IterateResponseRecords(ctx sdk.Context, fn func(record types.ResponseRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseRecord)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ResponseRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// AllResponseRecords returns the records of responses to unproven queries.
+func (k Keeper) AllResponseRecords(ctx sdk.Context) []types.ResponseRecord {
+	records := []types.ResponseRecord{}
+	k.IterateResponseRecords(ctx, func(record types.ResponseRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// pruneResponseRecords removes the records of responses older than the evidence window, as found in the response
+// record index.
+func (k Keeper) pruneResponseRecords(ctx sdk.Context) {
+	window := int64(k.GetParams(ctx).EvidenceWindow)
+	for _, key := range k.dueEntries(ctx, types.KeyPrefixResponseExpiry, ctx.BlockHeight()-window-1) {
+		queryID, height := types.ParseResponseRecordKey([]byte(key))
+		k.DeleteResponseRecord(ctx, queryID, height)
+	}
+}
+
+// HandleResponseEvidence verifies a proven remote store value against the response to an unproven query at the
+// same remote height. The module owning the query decides whether the value contradicts the response; if it does,
+// the relayer that submitted the response is jailed and slashed.
+func (k Keeper) HandleResponseEvidence(ctx sdk.Context, evidence *types.MsgSubmitResponseEvidence) error {
+	record, found := k.GetResponseRecord(ctx, evidence.QueryId, evidence.Height)
+	if !found {
+		return fmt.Errorf("no response to query %s at height %d", evidence.QueryId, evidence.Height)
+	}
+
+	if err := utils.ValidateProofOps(ctx, k.IBCKeeper, record.Query.ConnectionId, record.Query.ChainId, evidence.Height, evidence.Store, evidence.Key, evidence.Value, evidence.ProofOps); err != nil {
+		return err
+	}
+
+	verifier, ok := k.callbacks[record.Query.Module].(types.ResponseVerifier)
+	if !ok {
+		return fmt.Errorf("module %q does not verify responses to its queries", record.Query.Module)
+	}
+	contradicted, err := verifier.Contradicts(ctx, record.Query, record.Result, evidence.Store, evidence.Key, evidence.Value)
+	if err != nil {
+		return err
+	}
+	if !contradicted {
+		return types.ErrNotContradicted
+	}
+
+	k.DeleteResponseRecord(ctx, record.Query.Id, record.Height)
+	return k.slashRelayer(ctx, record.Relayer, record.Query.Id)
+}
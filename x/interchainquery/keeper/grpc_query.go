@@ -14,7 +14,8 @@ import (
 
 var _ types.QuerySrvrServer = Keeper{}
 
-// Queries returns information about registered zones.
+// Queries returns the pending queries of a chain, optionally filtered by module, callback and type. An empty chain
+// id matches the queries of every chain.
 func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*types.QueryRequestsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -31,8 +32,45 @@ func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*ty
 			return false, err
 		}
 
-		if query.ChainId == req.ChainId && (query.LastEmission.IsNil() || query.LastEmission.IsZero() || query.LastEmission.GTE(query.LastHeight)) {
+		if !matchesQueriesRequest(query, req) {
+			return false, nil
+		}
+		if accumulate {
 			queries = append(queries, query)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRequestsResponse{
+		Queries:    queries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Bounties returns the emitted queries that carry a bounty and await a response.
+func (k Keeper) Bounties(c context.Context, req *types.QueryBountiesRequest) (*types.QueryBountiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var queries []types.Query
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var query types.Query
+		if err := k.cdc.Unmarshal(value, &query); err != nil {
+			return false, err
+		}
+
+		if (req.ChainId == "" || query.ChainId == req.ChainId) && query.HasBounty() && query.AwaitingResponse() && !query.Quarantined {
+			if accumulate {
+				queries = append(queries, query)
+			}
 			return true, nil
 		}
 
@@ -42,8 +80,195 @@ func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*ty
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryRequestsResponse{
+	return &types.QueryBountiesResponse{
 		Queries:    queries,
 		Pagination: pageRes,
 	}, nil
 }
+
+// RelayerPayouts returns the bounties paid to relayers.
+func (k Keeper) RelayerPayouts(c context.Context, req *types.QueryRelayerPayoutsRequest) (*types.QueryRelayerPayoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Relayer != "" {
+		relayer, err := sdk.AccAddressFromBech32(req.Relayer)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		payout, found := k.GetRelayerPayout(ctx, relayer)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no payouts for relayer %s", req.Relayer)
+		}
+		return &types.QueryRelayerPayoutsResponse{Payouts: []types.RelayerPayout{payout}}, nil
+	}
+
+	var payouts []types.RelayerPayout
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerPayout)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var payout types.RelayerPayout
+		if err := k.cdc.Unmarshal(value, &payout); err != nil {
+			return err
+		}
+		payouts = append(payouts, payout)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayerPayoutsResponse{
+		Payouts:    payouts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Relayers returns the relayers registered to submit responses to unproven queries.
+func (k Keeper) Relayers(c context.Context, req *types.QueryRelayersRequest) (*types.QueryRelayersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var relayers []types.Relayer
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var relayer types.Relayer
+		if err := k.cdc.Unmarshal(value, &relayer); err != nil {
+			return err
+		}
+		relayers = append(relayers, relayer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayersResponse{
+		Relayers:   relayers,
+		Pagination: pageRes,
+	}, nil
+}
+
+// matchesQueriesRequest returns true if the query matches the filters of a Queries request. Quarantined queries are
+// no longer emitted, so are never pending; they, and answered queries, are included only on request.
+func matchesQueriesRequest(query types.Query, req *types.QueryRequestsRequest) bool {
+	switch {
+	case req.ChainId != "" && query.ChainId != req.ChainId,
+		req.Module != "" && query.Module != req.Module,
+		req.CallbackId != "" && query.CallbackId != req.CallbackId,
+		req.QueryType != "" && query.QueryType != req.QueryType:
+		return false
+	case query.Quarantined:
+		return req.IncludeQuarantined
+	default:
+		return req.IncludeAnswered || query.IsPending()
+	}
+}
+
+// Query returns a single query by id.
+func (k Keeper) Query(c context.Context, req *types.QueryQueryRequest) (*types.QueryQueryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	q, found := k.GetQuery(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no query with id %s", req.Id)
+	}
+
+	return &types.QueryQueryResponse{Query: q}, nil
+}
+
+// Datapoints returns the stored results of queries.
+func (k Keeper) Datapoints(c context.Context, req *types.QueryDatapointsRequest) (*types.QueryDatapointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var datapoints []types.DataPoint
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var dp types.DataPoint
+		if err := k.cdc.Unmarshal(value, &dp); err != nil {
+			return err
+		}
+		datapoints = append(datapoints, dp)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDatapointsResponse{
+		Datapoints: datapoints,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Datapoint returns the stored result of a single query.
+func (k Keeper) Datapoint(c context.Context, req *types.QueryDatapointRequest) (*types.QueryDatapointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dp, err := k.GetDatapointForID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDatapointResponse{Datapoint: dp}, nil
+}
+
+// ChainStats returns statistics of the queries of a chain.
+func (k Keeper) ChainStats(c context.Context, req *types.QueryChainStatsRequest) (*types.QueryChainStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain id required")
+	}
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = ResponseStatsWindow
+	}
+	if blocks > ResponseStatsWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "responses are counted over at most %d blocks", ResponseStatsWindow)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats := types.ChainStats{ChainId: req.ChainId, Blocks: blocks}
+	k.IterateQueries(ctx, func(_ int64, q types.Query) (stop bool) {
+		if q.ChainId != req.ChainId {
+			return false
+		}
+		stats.Queries++
+		switch {
+		case q.Quarantined:
+			stats.Quarantined++
+		case q.IsPending():
+			stats.Pending++
+			if q.AwaitingResponse() && (stats.OldestPendingEmission == 0 || q.LastEmission.Int64() < stats.OldestPendingEmission) {
+				stats.OldestPendingEmission = q.LastEmission.Int64()
+			}
+		}
+		return false
+	})
+	stats.Responses = k.ResponseCount(ctx, req.ChainId, ctx.BlockHeight()-int64(blocks)+1)
+
+	return &types.QueryChainStatsResponse{Stats: stats}, nil
+}
@@ -3,7 +3,10 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 )
 
@@ -38,3 +41,80 @@ func (suite *KeeperTestSuite) TestQueries() {
 	suite.Equal(sdk.NewInt(200), res.Queries[0].Period)
 	suite.Equal("", res.Queries[0].CallbackId)
 }
+
+func (suite *KeeperTestSuite) TestQueryLookupAndChainStats() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	suite.NoError(icqKeeper.SetCallbackHandler("test", timeoutCallbacks{&[]icqtypes.Query{}}))
+	icqsrvSrv := icqtypes.QuerySrvrServer(icqKeeper)
+
+	connectionID, chainID := suite.path.EndpointB.ConnectionID, suite.chainB.ChainID
+	icqKeeper.MakeRequest(ctx, connectionID, chainID, "cosmos.staking.v1beta1.Query/Validators", []byte("validators"), sdk.NewInt(100), "test", "test", 100, 0, sdk.Coin{})
+	icqKeeper.MakeRequest(ctx, connectionID, chainID, "cosmos.bank.v1beta1.Query/AllBalances", []byte("balances"), sdk.NewInt(-1), "", "", 0, 0, sdk.Coin{})
+	id := keeper.GenerateQueryHash(connectionID, chainID, "cosmos.staking.v1beta1.Query/Validators", []byte("validators"), "test")
+	icqKeeper.EndBlocker(ctx)
+
+	// 1. a single query is returned by id.
+	queryRes, err := icqsrvSrv.Query(sdk.WrapSDKContext(ctx), &icqtypes.QueryQueryRequest{Id: id})
+	suite.NoError(err)
+	suite.Equal(id, queryRes.Query.Id)
+	_, err = icqsrvSrv.Query(sdk.WrapSDKContext(ctx), &icqtypes.QueryQueryRequest{Id: "unknown"})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// 2. queries are filtered by module, callback and type.
+	res, err := icqsrvSrv.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{ChainId: chainID})
+	suite.NoError(err)
+	suite.Len(res.Queries, 2)
+	res, err = icqsrvSrv.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{ChainId: chainID, Module: "test"})
+	suite.NoError(err)
+	suite.Len(res.Queries, 1)
+	res, err = icqsrvSrv.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{CallbackId: "test"})
+	suite.NoError(err)
+	suite.Len(res.Queries, 1)
+	res, err = icqsrvSrv.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{QueryType: "cosmos.staking.v1beta1.Query/Validators"})
+	suite.NoError(err)
+	suite.Len(res.Queries, 1)
+	suite.Equal(id, res.Queries[0].Id)
+
+	statsRes, err := icqsrvSrv.ChainStats(sdk.WrapSDKContext(ctx), &icqtypes.QueryChainStatsRequest{ChainId: chainID})
+	suite.NoError(err)
+	suite.Equal(uint64(2), statsRes.Stats.Queries)
+	suite.Equal(uint64(2), statsRes.Stats.Pending)
+	suite.Equal(ctx.BlockHeight(), statsRes.Stats.OldestPendingEmission)
+	suite.Equal(uint64(0), statsRes.Stats.Responses)
+
+	// 3. an answered query is no longer pending, but is counted as a response and stores a datapoint.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = keeper.NewMsgServerImpl(icqKeeper).SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     chainID,
+		QueryId:     id,
+		Result:      []byte("result"),
+		Height:      suite.chainB.LastHeader.Header.Height - 1,
+		FromAddress: suite.bondedRelayer(ctx).String(),
+	})
+	suite.NoError(err)
+
+	res, err = icqsrvSrv.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{ChainId: chainID})
+	suite.NoError(err)
+	suite.Len(res.Queries, 1)
+	res, err = icqsrvSrv.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{ChainId: chainID, IncludeAnswered: true})
+	suite.NoError(err)
+	suite.Len(res.Queries, 2)
+
+	statsRes, err = icqsrvSrv.ChainStats(sdk.WrapSDKContext(ctx), &icqtypes.QueryChainStatsRequest{ChainId: chainID, Blocks: 1})
+	suite.NoError(err)
+	suite.Equal(uint64(1), statsRes.Stats.Pending)
+	suite.Equal(uint64(1), statsRes.Stats.Responses)
+	statsRes, err = icqsrvSrv.ChainStats(sdk.WrapSDKContext(ctx.WithBlockHeight(ctx.BlockHeight()+1)), &icqtypes.QueryChainStatsRequest{ChainId: chainID, Blocks: 1})
+	suite.NoError(err)
+	suite.Equal(uint64(0), statsRes.Stats.Responses)
+	_, err = icqsrvSrv.ChainStats(sdk.WrapSDKContext(ctx), &icqtypes.QueryChainStatsRequest{ChainId: chainID, Blocks: keeper.ResponseStatsWindow + 1})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	dpRes, err := icqsrvSrv.Datapoint(sdk.WrapSDKContext(ctx), &icqtypes.QueryDatapointRequest{Id: id})
+	suite.NoError(err)
+	suite.Equal([]byte("result"), dpRes.Datapoint.Value)
+	dpsRes, err := icqsrvSrv.Datapoints(sdk.WrapSDKContext(ctx), &icqtypes.QueryDatapointsRequest{})
+	suite.NoError(err)
+	suite.Len(dpsRes.Datapoints, 1)
+}
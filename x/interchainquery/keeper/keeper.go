@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -14,22 +15,47 @@ import (
 
 // Keeper of this module maintains collections of registered zones.
 type Keeper struct {
-	cdc       codec.Codec
-	storeKey  sdk.StoreKey
-	callbacks map[string]types.QueryCallbacks
-	IBCKeeper *ibckeeper.Keeper
+	cdc        codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+	callbacks  map[string]types.QueryCallbacks
+	IBCKeeper  *ibckeeper.Keeper
+	bankKeeper types.BankKeeper
 }
 
 // NewKeeper returns a new instance of zones Keeper
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, ibckeeper *ibckeeper.Keeper) Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, ibckeeper *ibckeeper.Keeper, bankKeeper types.BankKeeper, ps paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		callbacks: make(map[string]types.QueryCallbacks),
-		IBCKeeper: ibckeeper,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: ps,
+		callbacks:  make(map[string]types.QueryCallbacks),
+		IBCKeeper:  ibckeeper,
+		bankKeeper: bankKeeper,
 	}
 }
 
+// GetParams returns the total set of interchainquery parameters. Parameters not yet set take their default values.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyMinRelayerBond, &params.MinRelayerBond)
+	k.paramSpace.GetIfExists(ctx, types.KeyRelayerSlashFraction, &params.RelayerSlashFraction)
+	k.paramSpace.GetIfExists(ctx, types.KeyEvidenceWindow, &params.EvidenceWindow)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxCallbackFailures, &params.MaxCallbackFailures)
+	k.paramSpace.GetIfExists(ctx, types.KeyUnbondedRelayersUntil, &params.UnbondedRelayersUntil)
+	return params
+}
+
+// SetParams sets the total set of interchainquery parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 func (k *Keeper) SetCallbackHandler(module string, handler types.QueryCallbacks) error {
 	_, found := k.callbacks[module]
 	if found {
@@ -44,14 +70,29 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetDatapointForID sets the datapoint of a query. The datapoint expires once the ttl of the query has passed.
 func (k *Keeper) SetDatapointForID(ctx sdk.Context, id string, result []byte, height sdk.Int) error {
 	mapping := types.DataPoint{Id: id, RemoteHeight: height, LocalHeight: sdk.NewInt(ctx.BlockHeight()), Value: result}
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
-	bz := k.cdc.MustMarshal(&mapping)
-	store.Set([]byte(id), bz)
+	if q, found := k.GetQuery(ctx, id); found && q.Ttl > 0 {
+		mapping.Expiry = ctx.BlockHeight() + int64(q.Ttl)
+	}
+	k.SetDatapoint(ctx, mapping)
 	return nil
 }
 
+// SetDatapoint sets a datapoint, replacing any existing datapoint of the same query, and indexes it by expiry.
+func (k Keeper) SetDatapoint(ctx sdk.Context, dp types.DataPoint) {
+	k.DeleteDatapoint(ctx, dp.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	bz := k.cdc.MustMarshal(&dp)
+	store.Set([]byte(dp.Id), bz)
+	if dp.Expiry > 0 {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDataExpiry)
+		expiryStore.Set(types.GetHeightIndexKey(dp.Expiry, dp.Id), []byte{})
+	}
+}
+
 func (k *Keeper) GetDatapointForID(ctx sdk.Context, id string) (types.DataPoint, error) {
 	mapping := types.DataPoint{}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
@@ -83,12 +124,37 @@ func (k Keeper) IterateDatapoints(ctx sdk.Context, fn func(index int64, dp types
 	}
 }
 
-// DeleteQuery delete datapoint
+// AllDatapoints returns every datapoint in the store
+func (k Keeper) AllDatapoints(ctx sdk.Context) []types.DataPoint {
+	datapoints := []types.DataPoint{}
+	k.IterateDatapoints(ctx, func(_ int64, dp types.DataPoint) bool {
+		datapoints = append(datapoints, dp)
+		return false
+	})
+	return datapoints
+}
+
+// DeleteDatapoint delete datapoint, and its expiry index entry
 func (k Keeper) DeleteDatapoint(ctx sdk.Context, id string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	bz := store.Get([]byte(id))
+	if len(bz) == 0 {
+		return
+	}
+	dp := types.DataPoint{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	if dp.Expiry > 0 {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDataExpiry)
+		expiryStore.Delete(types.GetHeightIndexKey(dp.Expiry, dp.Id))
+	}
 	store.Delete([]byte(id))
 }
 
+// ExpiredDatapoints returns the ids of the datapoints expiring before the given height.
+func (k Keeper) ExpiredDatapoints(ctx sdk.Context, height int64) []string {
+	return k.dueEntries(ctx, types.KeyPrefixDataExpiry, height-1)
+}
+
 func (k *Keeper) GetDatapoint(ctx sdk.Context, module string, connectionID string, chainID string, queryType string, request []byte) (types.DataPoint, error) {
 	id := GenerateQueryHash(connectionID, chainID, queryType, request, module)
 	return k.GetDatapointForID(ctx, id)
@@ -98,19 +164,25 @@ func (k *Keeper) GetDatapointOrRequest(ctx sdk.Context, module string, connectio
 	val, err := k.GetDatapoint(ctx, module, connectionID, chainID, queryType, request)
 	if err != nil {
 		// no datapoint
-		k.MakeRequest(ctx, connectionID, chainID, queryType, request, sdk.NewInt(-1), "", "", maxAge)
+		k.MakeRequest(ctx, connectionID, chainID, queryType, request, sdk.NewInt(-1), "", "", maxAge, 0, sdk.Coin{})
 		return types.DataPoint{}, fmt.Errorf("no data; query submitted")
 	}
 
 	if val.LocalHeight.LT(sdk.NewInt(ctx.BlockHeight() - int64(maxAge))) { // this is somewhat arbitrary; TODO: make this better
-		k.MakeRequest(ctx, connectionID, chainID, queryType, request, sdk.NewInt(-1), "", "", maxAge)
+		k.MakeRequest(ctx, connectionID, chainID, queryType, request, sdk.NewInt(-1), "", "", maxAge, 0, sdk.Coin{})
 		return types.DataPoint{}, fmt.Errorf("stale data; query submitted")
 	}
 	// check ttl
 	return val, nil
 }
 
-func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID string, queryType string, request []byte, period sdk.Int, module string, callbackID string, ttl uint64) {
+// MakeRequest registers a query with the given request, or re-requests an existing one. A non-zero height pins the
+// query to that remote height, and responses at any other height are rejected; a zero height accepts responses
+// at any height. A positive bounty, which must be in the bounty denom and is only attached to proven queries, is
+// paid from the bounty budget of the owning module to the relayer of the first accepted response to each emission.
+// Re-requesting an existing query replaces its height and bounty, and releases it from quarantine, as the owning
+// module expects its callback to succeed again.
+func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID string, queryType string, request []byte, period sdk.Int, module string, callbackID string, ttl uint64, height int64, bounty sdk.Coin) {
 	k.Logger(ctx).Info(
 		"MakeRequest",
 		"connection_id", connectionID,
@@ -121,7 +193,21 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID strin
 		"module", module,
 		"callback", callbackID,
 		"ttl", ttl,
+		"height", height,
+		"bounty", bounty,
 	)
+	if !bounty.Amount.IsNil() && bounty.IsPositive() && module == "" {
+		k.Logger(ctx).Error("query bounty requires an owning module; ignoring bounty", "chain_id", chainID, "query_type", queryType)
+		bounty = sdk.Coin{}
+	}
+	if !bounty.Amount.IsNil() && bounty.IsPositive() && !types.IsProven(queryType) {
+		k.Logger(ctx).Error("query bounty requires a proven query type; ignoring bounty", "chain_id", chainID, "query_type", queryType)
+		bounty = sdk.Coin{}
+	}
+	if !bounty.Amount.IsNil() && bounty.IsPositive() && bounty.Denom != k.BountyDenom(ctx) {
+		k.Logger(ctx).Error("query bounty must be paid in the bounty denom; ignoring bounty", "chain_id", chainID, "query_type", queryType, "bounty", bounty)
+		bounty = sdk.Coin{}
+	}
 	key := GenerateQueryHash(connectionID, chainID, queryType, request, module)
 	existingQuery, found := k.GetQuery(ctx, key)
 	if !found {
@@ -138,11 +224,31 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID strin
 			}
 		}
 		newQuery := k.NewQuery(ctx, module, connectionID, chainID, queryType, request, period, callbackID, ttl)
+		newQuery.Height = height
+		newQuery.Bounty = bounty
 		k.SetQuery(ctx, *newQuery)
 
 	} else {
-		// a re-request of an existing query triggers resetting of height to trigger immediately.
+		// a re-request of an existing query triggers resetting of height and emission to trigger immediately.
 		existingQuery.LastHeight = sdk.ZeroInt()
+		existingQuery.LastEmission = sdk.ZeroInt()
+		existingQuery.Deadline = 0
+		existingQuery.Retries = 0
+		existingQuery.Height = height
+		existingQuery.Bounty = bounty
+		existingQuery.Failures = 0
+		if existingQuery.Quarantined {
+			k.Logger(ctx).Info("Interchainquery released from quarantine", "id", existingQuery.Id, "chain_id", existingQuery.ChainId, "type", existingQuery.QueryType)
+			existingQuery.Quarantined = false
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRelease),
+				sdk.NewAttribute(types.AttributeKeyQueryID, existingQuery.Id),
+				sdk.NewAttribute(types.AttributeKeyChainID, existingQuery.ChainId),
+				sdk.NewAttribute(types.AttributeKeyType, existingQuery.QueryType),
+			))
+		}
 		k.SetQuery(ctx, existingQuery)
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,8 +15,6 @@ import (
 	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 )
 
-const TestOwnerAddress = "osmo1ad2w96t58m0drxcgkf5unm9tg3f9adhjw3m5j5"
-
 func init() {
 	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
 }
@@ -66,6 +66,8 @@ func (suite *KeeperTestSuite) TestMakeRequest() {
 		"",
 		"",
 		0,
+		0,
+		sdk.Coin{},
 	)
 
 	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "")
@@ -87,6 +89,8 @@ func (suite *KeeperTestSuite) TestMakeRequest() {
 		"",
 		"",
 		0,
+		0,
+		sdk.Coin{},
 	)
 }
 
@@ -170,6 +174,8 @@ func (suite *KeeperTestSuite) TestSubmitQueryResponse() {
 		},
 	}
 
+	relayer := suite.bondedRelayer(suite.chainA.GetContext())
+
 	for _, tc := range tests {
 		// set the query
 		if tc.setQuery {
@@ -182,8 +188,8 @@ func (suite *KeeperTestSuite) TestSubmitQueryResponse() {
 			ChainId:     suite.chainB.ChainID,
 			QueryId:     keeper.GenerateQueryHash(tc.query.ConnectionId, tc.query.ChainId, tc.query.QueryType, bz, ""),
 			Result:      suite.GetSimApp(suite.chainB).AppCodec().MustMarshalJSON(&qvr),
-			Height:      suite.chainB.CurrentHeader.Height,
-			FromAddress: TestOwnerAddress,
+			Height:      suite.chainB.LastHeader.Header.Height - 1,
+			FromAddress: relayer.String(),
 		}
 
 		_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(suite.chainA.GetContext()), &qmsg)
@@ -191,6 +197,96 @@ func (suite *KeeperTestSuite) TestSubmitQueryResponse() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitQueryResponsePinnedHeight() {
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	qvr := stakingtypes.QueryValidatorsResponse{
+		Validators: suite.GetSimApp(suite.chainB).StakingKeeper.GetBondedValidatorsByPower(suite.chainB.GetContext()),
+	}
+
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	height := suite.chainB.LastHeader.Header.Height - 1
+
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(-1), "", "", 0, height, sdk.Coin{})
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "")
+
+	// the emitted event carries the pinned height.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	icqKeeper.EndBlocker(ctx)
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == icqtypes.AttributeKeyHeight {
+				suite.Equal(fmt.Sprintf("%d", height), string(attr.Value))
+				found = true
+			}
+		}
+	}
+	suite.True(found)
+
+	relayer := suite.bondedRelayer(ctx)
+	icqmsgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	qmsg := icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     id,
+		Result:      suite.GetSimApp(suite.chainB).AppCodec().MustMarshalJSON(&qvr),
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: relayer.String(),
+	}
+
+	// a response at any other height is rejected.
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.ErrorIs(err, icqtypes.ErrUnexpectedHeight)
+	_, found = icqKeeper.GetQuery(ctx, id)
+	suite.True(found)
+
+	// a response at the pinned height is accepted.
+	qmsg.Height = height
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.NoError(err)
+	_, found = icqKeeper.GetQuery(ctx, id)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestSubmitQueryResponseUnprovableHeight() {
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	qvr := stakingtypes.QueryValidatorsResponse{
+		Validators: suite.GetSimApp(suite.chainB).StakingKeeper.GetBondedValidatorsByPower(suite.chainB.GetContext()),
+	}
+
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(-1), "", "", 0, 0, sdk.Coin{})
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "")
+
+	icqmsgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	qmsg := icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     id,
+		Result:      suite.GetSimApp(suite.chainB).AppCodec().MustMarshalJSON(&qvr),
+		Height:      suite.chainB.CurrentHeader.Height + 100,
+		FromAddress: suite.bondedRelayer(ctx).String(),
+	}
+
+	// a response at a height the light client holds no consensus state for could never be contradicted.
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.ErrorIs(err, icqtypes.ErrUnprovableHeight)
+	_, found := icqKeeper.GetQuery(ctx, id)
+	suite.True(found)
+
+	// a response at a height with a consensus state is accepted.
+	qmsg.Height = suite.chainB.LastHeader.Header.Height - 1
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestDataPoints() {
 	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
 	bz, err := bondedQuery.Marshal()
@@ -217,6 +313,132 @@ func (suite *KeeperTestSuite) TestDataPoints() {
 	suite.GetSimApp(suite.chainA).InterchainQueryKeeper.DeleteDatapoint(suite.chainA.GetContext(), id)
 }
 
+// failingCallbacks fails the callback of any query whose request is "fail", until recovered, and emits an event for
+// every other.
+type failingCallbacks struct {
+	timeoutCallbacks
+	recovered *bool
+}
+
+func (c failingCallbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
+	return c
+}
+
+func (c failingCallbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
+	ctx.EventManager().EmitEvent(sdk.NewEvent("callback", sdk.NewAttribute(icqtypes.AttributeKeyQueryID, query.Id)))
+	if string(query.Request) == "fail" && (c.recovered == nil || !*c.recovered) {
+		return errors.New("callback failed")
+	}
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestSubmitQueryResponseCallbackFailure() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	recovered := false
+	suite.NoError(icqKeeper.SetCallbackHandler("test", failingCallbacks{timeoutCallbacks{&[]icqtypes.Query{}}, &recovered}))
+	params := icqKeeper.GetParams(ctx)
+	params.MaxCallbackFailures = 2
+	icqKeeper.SetParams(ctx, params)
+
+	queryType := "cosmos.staking.v1beta1.Query/Validators"
+	ids := map[string]string{}
+	for _, request := range []string{"fail", "pass"} {
+		icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, []byte(request), sdk.NewInt(10), "test", "test", 0, 0, sdk.Coin{})
+		ids[request] = keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, []byte(request), "test")
+	}
+	icqKeeper.EndBlocker(ctx)
+
+	relayer := suite.bondedRelayer(ctx)
+	msgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	respond := func(request string) sdk.Events {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     ids[request],
+			Height:      suite.chainB.LastHeader.Header.Height - 1,
+			FromAddress: relayer.String(),
+		})
+		suite.NoError(err)
+		return ctx.EventManager().Events()
+	}
+	hasAction := func(events sdk.Events, action string) bool {
+		for _, event := range events {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == sdk.AttributeKeyAction && string(attr.Value) == action {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// 1. a failed callback is recorded against the query, without failing the message or keeping its events.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	events := respond("fail")
+	suite.True(hasAction(events, icqtypes.AttributeValueFailure))
+	for _, event := range events {
+		suite.NotEqual("callback", event.Type)
+	}
+	query, _ := icqKeeper.GetQuery(ctx, ids["fail"])
+	suite.Equal(uint32(1), query.Failures)
+	suite.Equal("callback failed", query.LastError)
+	suite.False(query.Quarantined)
+
+	// 2. further responses in the same block are ignored; other queries are unaffected.
+	suite.Empty(respond("fail"))
+	suite.NotEmpty(respond("pass"))
+	query, _ = icqKeeper.GetQuery(ctx, ids["pass"])
+	suite.Zero(query.Failures)
+	suite.Equal(ctx.BlockHeight(), query.LastHeight.Int64())
+
+	// 3. the query is quarantined once it reaches the max callback failures.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.True(hasAction(respond("fail"), icqtypes.AttributeValueQuarantine))
+	query, _ = icqKeeper.GetQuery(ctx, ids["fail"])
+	suite.Equal(uint32(2), query.Failures)
+	suite.True(query.Quarantined)
+	suite.NotContains(icqKeeper.DueQueries(ctx, ctx.BlockHeight()+100), ids["fail"])
+
+	// 4. responses to a quarantined query are ignored, and it is omitted from Queries unless requested.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.Empty(respond("fail"))
+
+	res, err := icqKeeper.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	for _, q := range res.Queries {
+		suite.NotEqual(ids["fail"], q.Id)
+	}
+	res, err = icqKeeper.Queries(sdk.WrapSDKContext(ctx), &icqtypes.QueryRequestsRequest{ChainId: suite.chainB.ChainID, IncludeQuarantined: true})
+	suite.NoError(err)
+	found := false
+	for _, q := range res.Queries {
+		if q.Id == ids["fail"] {
+			found = true
+			suite.Equal("callback failed", q.LastError)
+		}
+	}
+	suite.True(found)
+
+	// 5. an explicit re-request releases the query from quarantine, and it recovers once its callback succeeds.
+	recovered = true
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, []byte("fail"), sdk.NewInt(10), "test", "test", 0, 0, sdk.Coin{})
+	suite.True(hasAction(ctx.EventManager().Events(), icqtypes.AttributeValueRelease))
+	query, _ = icqKeeper.GetQuery(ctx, ids["fail"])
+	suite.False(query.Quarantined)
+	suite.Zero(query.Failures)
+	suite.Contains(icqKeeper.DueQueries(ctx, ctx.BlockHeight()), ids["fail"])
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	events = respond("fail")
+	suite.False(hasAction(events, icqtypes.AttributeValueFailure))
+	suite.NotEmpty(events)
+	query, _ = icqKeeper.GetQuery(ctx, ids["fail"])
+	suite.Zero(query.Failures)
+	suite.Equal(ctx.BlockHeight(), query.LastHeight.Int64())
+}
+
 func newSimAppPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	path := ibctesting.NewPath(chainA, chainB)
 	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
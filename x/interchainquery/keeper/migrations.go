@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, building the query schedule and the datapoint expiry index. Queries
+// predating retries are given the default backoff and retry count, and those awaiting a response a deadline.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, query := range m.keeper.AllQueries(ctx) {
+		if query.Backoff == 0 {
+			query.Backoff = RetryInterval
+		}
+		if query.MaxRetries == 0 {
+			query.MaxRetries = MaxRetries
+		}
+		if query.Deadline == 0 && query.AwaitingResponse() {
+			query.Deadline = nextDeadline(ctx, query)
+		}
+		m.keeper.SetQuery(ctx, query)
+	}
+
+	for _, dp := range m.keeper.AllDatapoints(ctx) {
+		q, found := m.keeper.GetQuery(ctx, dp.Id)
+		if !found {
+			// query was removed; delete datapoint
+			m.keeper.DeleteDatapoint(ctx, dp.Id)
+			continue
+		}
+		if q.Ttl > 0 {
+			dp.Expiry = dp.LocalHeight.Int64() + int64(q.Ttl)
+		}
+		m.keeper.SetDatapoint(ctx, dp)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqKeeper := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	// a v1 query, emitted and unanswered, without backoff, retries or a schedule entry.
+	query := icqtypes.Query{
+		Id:           "v1",
+		ConnectionId: suite.path.EndpointB.ConnectionID,
+		ChainId:      suite.chainB.ChainID,
+		QueryType:    "cosmos.staking.v1beta1.Query/Validators",
+		Period:       sdk.NewInt(-1),
+		LastHeight:   sdk.ZeroInt(),
+		LastEmission: sdk.NewInt(ctx.BlockHeight()),
+	}
+	store := prefix.NewStore(ctx.KVStore(quicksilver.GetKey(icqtypes.StoreKey)), icqtypes.KeyPrefixQuery)
+	store.Set([]byte(query.Id), quicksilver.AppCodec().MustMarshal(&query))
+	suite.Empty(icqKeeper.DueQueries(ctx, ctx.BlockHeight()+keeper.RetryInterval+1))
+
+	suite.NoError(keeper.NewMigrator(icqKeeper).Migrate1to2(ctx))
+
+	migrated, found := icqKeeper.GetQuery(ctx, query.Id)
+	suite.True(found)
+	suite.Equal(uint64(keeper.RetryInterval), migrated.Backoff)
+	suite.Equal(uint32(keeper.MaxRetries), migrated.MaxRetries)
+	suite.Equal(ctx.BlockHeight()+keeper.RetryInterval, migrated.Deadline)
+
+	// the query is retried once its deadline has passed.
+	suite.Equal([]string{query.Id}, icqKeeper.DueQueries(ctx, migrated.Deadline+1))
+	ctx = ctx.WithBlockHeight(migrated.Deadline + 1)
+	icqKeeper.EndBlocker(ctx)
+	migrated, found = icqKeeper.GetQuery(ctx, query.Id)
+	suite.True(found)
+	suite.Equal(uint32(1), migrated.Retries)
+}
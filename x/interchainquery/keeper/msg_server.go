@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/ingenuity-build/quicksilver/utils"
 	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
@@ -33,6 +35,11 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
 
+	if q.Quarantined {
+		k.Logger(ctx).Info("ignoring response to quarantined query", "QueryID", msg.QueryId)
+		return &types.MsgSubmitQueryResponseResponse{}, nil
+	}
+
 	// check if query was previously processed
 	// - indicated by query.LastHeight matching current Block Height;
 	if q.LastHeight.Int64() == ctx.BlockHeader().Height {
@@ -42,8 +49,37 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
 
+	// a query pinned to a remote height must be answered at that height.
+	if q.Height != 0 && msg.Height != q.Height {
+		return nil, fmt.Errorf("%w: query %s expects height %d, got %d", types.ErrUnexpectedHeight, msg.QueryId, q.Height, msg.Height)
+	}
+
+	// responses to queries without a proof are only accepted from bonded registered relayers, outside of any window
+	// opened by governance.
+	if !types.IsProven(q.QueryType) && k.RequiresBondedRelayer(ctx) && !k.IsBondedRelayer(ctx, msg.FromAddress) {
+		return nil, fmt.Errorf("%w: %s", types.ErrUnbondedRelayer, msg.FromAddress)
+	}
+
+	// a response to a query without a proof is only contradicted by proofs at the same remote height, so that height
+	// must be one the light client can already verify proofs against.
+	if !types.IsProven(q.QueryType) {
+		if err := utils.ValidateConsensusHeight(ctx, k.IBCKeeper, q.ConnectionId, q.ChainId, msg.Height); err != nil {
+			return nil, fmt.Errorf("%w: %d: %s", types.ErrUnprovableHeight, msg.Height, err)
+		}
+	}
+
 	pathParts := strings.Split(q.QueryType, "/")
-	if pathParts[len(pathParts)-1] == "key" {
+	switch {
+	case types.IsSubspace(q.QueryType):
+		// the result of a subspace query is every key/value pair under the requested prefix.
+		var pairs kv.Pairs
+		if err := pairs.Unmarshal(msg.Result); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal subspace result: %w", err)
+		}
+		if err := utils.ValidateSubspaceProofOps(ctx, k.IBCKeeper, q.ConnectionId, q.ChainId, msg.Height, pathParts[1], q.Request, pairs.Pairs, msg.SubspaceProofOps); err != nil {
+			return nil, err
+		}
+	case types.IsProven(q.QueryType):
 		if err := utils.ValidateProofOps(ctx, k.IBCKeeper, q.ConnectionId, q.ChainId, msg.Height, pathParts[1], q.Request, msg.Result, msg.ProofOps); err != nil {
 			return nil, err
 		}
@@ -59,15 +95,19 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 
 	sort.Strings(keys)
 
+	// callbacks run in a cached context; if any fails, the state changes of all are discarded, the failure is
+	// recorded against the query, and the message succeeds so that other responses in the same tx are kept.
+	cacheCtx, write := ctx.CacheContext()
 	for _, key := range keys {
 		module := k.callbacks[key]
 		if module.Has(q.CallbackId) {
-			err := module.Call(ctx, q.CallbackId, msg.Result, q)
+			err := module.Call(cacheCtx, q.CallbackId, msg.Result, q)
 			if err != nil {
 				// not edge case: proceed with regular error handling!
 				if err != types.ErrSucceededNoDelete {
 					k.Logger(ctx).Error("error in callback", "error", err, "msg", msg.QueryId, "result", msg.Result, "type", q.QueryType, "params", q.Request)
-					return nil, err
+					k.recordCallbackFailure(ctx, q, key, err)
+					return &types.MsgSubmitQueryResponseResponse{}, nil
 				}
 				// edge case: the callback has resent the same query (re-query)!
 				// action:    set noDelete to true and continue (short circuit error handling)!
@@ -75,6 +115,18 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 			}
 		}
 	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	k.incrementResponseCount(ctx, q.ChainId)
+
+	// keep unproven responses for the evidence window, so they may be contradicted by proven state.
+	if !types.IsProven(q.QueryType) {
+		k.SetResponseRecord(ctx, types.ResponseRecord{Query: q, Relayer: msg.FromAddress, Height: msg.Height, LocalHeight: ctx.BlockHeight(), Result: msg.Result})
+	}
+
+	if err := k.payBounty(ctx, q, msg.FromAddress); err != nil {
+		return nil, err
+	}
 
 	if q.Ttl > 0 {
 		// don't store if ttl is 0
@@ -91,6 +143,10 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 	} else {
 		// logic condition: !q.Period.IsNegative() || noDelete == true
 		q.LastHeight = sdk.NewInt(ctx.BlockHeight())
+		// the query has been answered; clear its deadline until it is next emitted.
+		q.Deadline = 0
+		q.Retries = 0
+		q.Failures = 0
 		k.SetQuery(ctx, q)
 	}
 
@@ -103,3 +159,62 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 
 	return &types.MsgSubmitQueryResponseResponse{}, nil
 }
+
+func (k msgServer) BondRelayer(goCtx context.Context, msg *types.MsgBondRelayer) (*types.MsgBondRelayerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.BondRelayer(ctx, relayer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgBondRelayerResponse{}, nil
+}
+
+func (k msgServer) FundBountyBudget(goCtx context.Context, msg *types.MsgFundBountyBudget) (*types.MsgFundBountyBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.FundBountyBudgetFromAccount(ctx, from, msg.Module, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgFundBountyBudgetResponse{}, nil
+}
+
+func (k msgServer) SubmitResponseEvidence(goCtx context.Context, msg *types.MsgSubmitResponseEvidence) (*types.MsgSubmitResponseEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.HandleResponseEvidence(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgSubmitResponseEvidenceResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/ingenuity-build/quicksilver/relayer/icq"
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+func (suite *KeeperTestSuite) TestSubmitSubspaceQueryResponse() {
+	// the controller's client of the host must hold the consensus state of the latest host block to verify proofs.
+	suite.NoError(suite.path.EndpointA.UpdateClient())
+
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	msgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	ctx := suite.chainA.GetContext()
+
+	connectionID, chainID := suite.path.EndpointB.ConnectionID, suite.chainB.ChainID
+	queryType := "store/staking/subspace"
+	r := icq.NewRelayer(icq.NewLocalHost(chainID, suite.chainB.App), nil, utils.GenerateAccAddressForTest(), log.NewNopLogger())
+
+	respond := func(request []byte) (string, *icqtypes.MsgSubmitQueryResponse, []kv.Pair) {
+		icqKeeper.MakeRequest(ctx, connectionID, chainID, queryType, request, sdk.NewInt(100), "", "", 100, 0, sdk.Coin{})
+		id := keeper.GenerateQueryHash(connectionID, chainID, queryType, request, "")
+		msg, err := r.Respond(context.Background(), icq.Query{ID: id, ConnectionID: connectionID, ChainID: chainID, Type: queryType, Request: request})
+		suite.NoError(err)
+		var pairs kv.Pairs
+		suite.NoError(pairs.Unmarshal(msg.Result))
+		return id, msg, pairs.Pairs
+	}
+	tampered := func(msg *icqtypes.MsgSubmitQueryResponse, pairs []kv.Pair, proofOps []*crypto.ProofOps) *icqtypes.MsgSubmitQueryResponse {
+		result, err := (&kv.Pairs{Pairs: pairs}).Marshal()
+		suite.NoError(err)
+		forged := *msg
+		forged.Result, forged.SubspaceProofOps = result, proofOps
+		return &forged
+	}
+
+	id, msg, pairs := respond(stakingtypes.ValidatorsKey)
+	suite.NotEmpty(pairs)
+	suite.Len(msg.SubspaceProofOps, len(pairs)+1)
+
+	// 1. an omitted pair is detected, even with its proof also omitted.
+	omitted := append(append([]*crypto.ProofOps{}, msg.SubspaceProofOps[:1]...), msg.SubspaceProofOps[2:]...)
+	_, err := msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), tampered(msg, pairs[1:], omitted))
+	suite.ErrorContains(err, "is not the next key")
+
+	// 2. an empty result is not a proof that the prefix is empty.
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), tampered(msg, nil, msg.SubspaceProofOps[:1]))
+	suite.ErrorContains(err, "omitted key")
+
+	// 3. an altered value is detected.
+	altered := append([]kv.Pair{}, pairs...)
+	altered[0] = kv.Pair{Key: pairs[0].Key, Value: []byte("forged")}
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), tampered(msg, altered, msg.SubspaceProofOps))
+	suite.ErrorContains(err, "unable to verify value")
+
+	// 4. a proof is required for each gap.
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), tampered(msg, pairs, msg.SubspaceProofOps[1:]))
+	suite.ErrorContains(err, "Expected")
+
+	// 5. the complete set of pairs is accepted.
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), msg)
+	suite.NoError(err)
+	datapoint, err := icqKeeper.GetDatapointForID(ctx, id)
+	suite.NoError(err)
+	suite.Equal(msg.Result, datapoint.Value)
+
+	// 6. an empty prefix is proven by a single proof.
+	id, msg, pairs = respond(stakingtypes.GetDelegationsKey(utils.GenerateAccAddressForTest()))
+	suite.Empty(pairs)
+	suite.Len(msg.SubspaceProofOps, 1)
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), msg)
+	suite.NoError(err)
+	_, err = icqKeeper.GetDatapointForID(ctx, id)
+	suite.NoError(err)
+}
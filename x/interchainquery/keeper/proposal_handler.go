@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// HandleRegisterRelayerProposal is a handler for executing a passed relayer registration proposal.
+func HandleRegisterRelayerProposal(ctx sdk.Context, k Keeper, p *types.RegisterRelayerProposal) error {
+	relayer, err := sdk.AccAddressFromBech32(p.Relayer)
+	if err != nil {
+		return err
+	}
+	k.RegisterRelayer(ctx, relayer)
+	k.Logger(ctx).Info("registered relayer", "relayer", p.Relayer)
+	return nil
+}
+
+// HandleDeregisterRelayerProposal is a handler for executing a passed relayer deregistration proposal.
+func HandleDeregisterRelayerProposal(ctx sdk.Context, k Keeper, p *types.DeregisterRelayerProposal) error {
+	relayer, err := sdk.AccAddressFromBech32(p.Relayer)
+	if err != nil {
+		return err
+	}
+	if err := k.DeregisterRelayer(ctx, relayer); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("deregistered relayer", "relayer", p.Relayer)
+	return nil
+}
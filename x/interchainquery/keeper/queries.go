@@ -17,7 +17,7 @@ func GenerateQueryHash(connectionID string, chainID string, queryType string, re
 // ----------------------------------------------------------------
 
 func (k Keeper) NewQuery(ctx sdk.Context, module string, connectionID string, chainID string, queryType string, request []byte, period sdk.Int, callbackID string, ttl uint64) *types.Query {
-	return &types.Query{Id: GenerateQueryHash(connectionID, chainID, queryType, request, module), ConnectionId: connectionID, ChainId: chainID, QueryType: queryType, Request: request, Period: period, LastHeight: sdk.ZeroInt(), CallbackId: callbackID, Ttl: ttl}
+	return &types.Query{Id: GenerateQueryHash(connectionID, chainID, queryType, request, module), ConnectionId: connectionID, ChainId: chainID, QueryType: queryType, Request: request, Period: period, LastHeight: sdk.ZeroInt(), CallbackId: callbackID, Ttl: ttl, Backoff: RetryInterval, MaxRetries: MaxRetries, Module: module}
 }
 
 // GetQuery returns query
@@ -32,17 +32,63 @@ func (k Keeper) GetQuery(ctx sdk.Context, id string) (types.Query, bool) {
 	return query, true
 }
 
-// SetQuery set query info
+// SetQuery set query info, and reschedules the query
 func (k Keeper) SetQuery(ctx sdk.Context, query types.Query) {
+	if existing, found := k.GetQuery(ctx, query.Id); found {
+		k.unscheduleQuery(ctx, existing)
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
 	bz := k.cdc.MustMarshal(&query)
 	store.Set([]byte(query.Id), bz)
+	k.scheduleQuery(ctx, query)
 }
 
-// DeleteQuery delete query info
+// DeleteQuery delete query info, its schedule entry and its datapoint
 func (k Keeper) DeleteQuery(ctx sdk.Context, id string) {
+	if existing, found := k.GetQuery(ctx, id); found {
+		k.unscheduleQuery(ctx, existing)
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
 	store.Delete([]byte(id))
+	k.DeleteDatapoint(ctx, id)
+}
+
+// scheduleQuery adds a query to the schedule at the height it is next due, if any.
+func (k Keeper) scheduleQuery(ctx sdk.Context, query types.Query) {
+	if height, due := nextDue(query); due {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuerySchedule)
+		store.Set(types.GetHeightIndexKey(height, query.Id), []byte{})
+	}
+}
+
+// unscheduleQuery removes a query from the schedule.
+func (k Keeper) unscheduleQuery(ctx sdk.Context, query types.Query) {
+	if height, due := nextDue(query); due {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuerySchedule)
+		store.Delete(types.GetHeightIndexKey(height, query.Id))
+	}
+}
+
+// DueQueries returns the ids of the queries due at or before the given height, in the order they fell due.
+func (k Keeper) DueQueries(ctx sdk.Context, height int64) []string {
+	return k.dueEntries(ctx, types.KeyPrefixQuerySchedule, height)
+}
+
+// dueEntries returns the ids of the entries of an index keyed by height at or before the given height.
+func (k Keeper) dueEntries(ctx sdk.Context, keyPrefix []byte, height int64) []string {
+	if height < 0 {
+		return []string{}
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+
+	ids := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.ParseHeightIndexKey(iterator.Key())
+		ids = append(ids, id)
+	}
+	return ids
 }
 
 // IterateQueries iterate through queries
@@ -73,3 +119,41 @@ func (k Keeper) AllQueries(ctx sdk.Context) []types.Query {
 	})
 	return queries
 }
+
+// recordCallbackFailure records a failed callback against the query that the response answered. The query is
+// marked answered at the current height, so that further responses in the same block are ignored, and is
+// quarantined once it reaches the max callback failures.
+func (k Keeper) recordCallbackFailure(ctx sdk.Context, query types.Query, module string, err error) {
+	query.Failures++
+	query.LastError = err.Error()
+	query.LastHeight = sdk.NewInt(ctx.BlockHeight())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueFailure),
+		sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+		sdk.NewAttribute(types.AttributeKeyCallback, module),
+		sdk.NewAttribute(types.AttributeKeyError, query.LastError),
+		sdk.NewAttribute(types.AttributeKeyFailures, fmt.Sprintf("%d", query.Failures)),
+	))
+
+	maxFailures := k.GetParams(ctx).MaxCallbackFailures
+	if maxFailures > 0 && query.Failures >= maxFailures {
+		k.Logger(ctx).Error("Interchainquery quarantined", "id", query.Id, "chain_id", query.ChainId, "type", query.QueryType, "failures", query.Failures, "error", query.LastError)
+		query.Quarantined = true
+		query.Deadline = 0
+		query.Retries = 0
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuarantine),
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+		))
+	}
+	k.SetQuery(ctx, query)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// Responses to queries without a proof are accepted only from relayers registered by governance, that have bonded
+// at least the minimum relayer bond. A registered relayer whose response is contradicted by proven state is jailed,
+// and forfeits a fraction of its bond.
+
+// GetRelayer returns a registered relayer.
+func (k Keeper) GetRelayer(ctx sdk.Context, relayer sdk.AccAddress) (types.Relayer, bool) {
+	r := types.Relayer{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	bz := store.Get(relayer)
+	if len(bz) == 0 {
+		return r, false
+	}
+	k.cdc.MustUnmarshal(bz, &r)
+	return r, true
+}
+
+// SetRelayer sets a registered relayer.
+func (k Keeper) SetRelayer(ctx sdk.Context, relayer types.Relayer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	store.Set(sdk.MustAccAddressFromBech32(relayer.Address), k.cdc.MustMarshal(&relayer))
+}
+
+// DeleteRelayer removes a relayer from the registry.
+func (k Keeper) DeleteRelayer(ctx sdk.Context, relayer sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	store.Delete(relayer)
+}
+
+// IterateRelayers iterates through the registered relayers.
+func (k Keeper) IterateRelayers(ctx sdk.Context, fn func(relayer types.Relayer) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		relayer := types.Relayer{}
+		k.cdc.MustUnmarshal(iterator.Value(), &relayer)
+		if fn(relayer) {
+			break
+		}
+	}
+}
+
+// AllRelayers returns the registered relayers.
+func (k Keeper) AllRelayers(ctx sdk.Context) []types.Relayer {
+	relayers := []types.Relayer{}
+	k.IterateRelayers(ctx, func(relayer types.Relayer) bool {
+		relayers = append(relayers, relayer)
+		return false
+	})
+	return relayers
+}
+
+// IsBondedRelayer returns true if the address is a registered relayer, not jailed, holding the minimum bond.
+func (k Keeper) IsBondedRelayer(ctx sdk.Context, address string) bool {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false
+	}
+	relayer, found := k.GetRelayer(ctx, addr)
+	return found && relayer.IsBonded(k.GetParams(ctx).MinRelayerBond)
+}
+
+// RequiresBondedRelayer returns true if responses to unproven queries are only accepted from bonded registered
+// relayers; that is, unless governance has opened a window, ending at the UnbondedRelayersUntil height, in which
+// relayers may register and bond after an upgrade.
+func (k Keeper) RequiresBondedRelayer(ctx sdk.Context) bool {
+	return ctx.BlockHeight() > k.GetParams(ctx).UnbondedRelayersUntil
+}
+
+// RegisterRelayer adds a relayer to the registry, or clears the jailed state of a registered relayer.
+func (k Keeper) RegisterRelayer(ctx sdk.Context, address sdk.AccAddress) {
+	relayer, found := k.GetRelayer(ctx, address)
+	if !found {
+		relayer = types.Relayer{Address: address.String(), Bond: sdk.Coins{}}
+	}
+	relayer.Jailed = false
+	k.SetRelayer(ctx, relayer)
+}
+
+// DeregisterRelayer removes a relayer from the registry, and returns its remaining bond.
+func (k Keeper) DeregisterRelayer(ctx sdk.Context, address sdk.AccAddress) error {
+	relayer, found := k.GetRelayer(ctx, address)
+	if !found {
+		return fmt.Errorf("relayer %s is not registered", address)
+	}
+	if !relayer.Bond.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, relayer.Bond); err != nil {
+			return err
+		}
+	}
+	k.DeleteRelayer(ctx, address)
+	return nil
+}
+
+// BondRelayer moves coins from a registered relayer to the interchainquery module account, and adds them to its bond.
+func (k Keeper) BondRelayer(ctx sdk.Context, address sdk.AccAddress, amount sdk.Coins) error {
+	relayer, found := k.GetRelayer(ctx, address)
+	if !found {
+		return fmt.Errorf("relayer %s is not registered", address)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, amount); err != nil {
+		return err
+	}
+	relayer.Bond = relayer.Bond.Add(amount...)
+	k.SetRelayer(ctx, relayer)
+	return nil
+}
+
+// slashRelayer jails a registered relayer whose response to the query was contradicted, and burns the slash fraction
+// of its bond.
+func (k Keeper) slashRelayer(ctx sdk.Context, address string, queryID string) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	relayer, found := k.GetRelayer(ctx, addr)
+	if !found {
+		// the relayer has been deregistered since the response was submitted; there is no bond to slash.
+		k.Logger(ctx).Error("contradicted response from deregistered relayer", "relayer", address, "id", queryID)
+		return nil
+	}
+
+	fraction := k.GetParams(ctx).RelayerSlashFraction
+	slashed := sdk.Coins{}
+	for _, coin := range relayer.Bond {
+		amount := fraction.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			slashed = slashed.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	if !slashed.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, slashed); err != nil {
+			return err
+		}
+	}
+	relayer.Bond = relayer.Bond.Sub(slashed)
+	relayer.Jailed = true
+	k.SetRelayer(ctx, relayer)
+
+	k.Logger(ctx).Error("relayer response contradicted; relayer jailed and slashed", "relayer", address, "id", queryID, "slashed", slashed)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSlash),
+		sdk.NewAttribute(types.AttributeKeyQueryID, queryID),
+		sdk.NewAttribute(types.AttributeKeyRelayer, address),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, slashed.String()),
+	))
+	return nil
+}
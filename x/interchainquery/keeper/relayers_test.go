@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// bondedRelayer returns a new relayer, registered and holding the minimum relayer bond.
+func (suite *KeeperTestSuite) bondedRelayer(ctx sdk.Context) sdk.AccAddress {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqKeeper := quicksilver.InterchainQueryKeeper
+
+	relayer := utils.GenerateAccAddressForTest()
+	bond := sdk.NewCoins(icqKeeper.GetParams(ctx).MinRelayerBond)
+	suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, bond))
+	suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, relayer, bond))
+
+	icqKeeper.RegisterRelayer(ctx, relayer)
+	suite.NoError(icqKeeper.BondRelayer(ctx, relayer, bond))
+	return relayer
+}
+
+// verifyingCallbacks contradicts a response if it differs from the proven value.
+type verifyingCallbacks struct {
+	timeoutCallbacks
+}
+
+func (c verifyingCallbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
+	return c
+}
+
+func (c verifyingCallbacks) Contradicts(ctx sdk.Context, query icqtypes.Query, result []byte, store string, key []byte, value []byte) (bool, error) {
+	return !bytes.Equal(result, value), nil
+}
+
+func (suite *KeeperTestSuite) TestRelayerRegistry() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqKeeper := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	suite.NoError(icqKeeper.SetCallbackHandler("test", verifyingCallbacks{timeoutCallbacks{&[]icqtypes.Query{}}}))
+
+	// the validator record of the host's first validator, proven at the latest committed height.
+	validator := suite.GetSimApp(suite.chainB).StakingKeeper.GetAllValidators(suite.chainB.GetContext())[0]
+	key := stakingtypes.GetValidatorKey(validator.GetOperator())
+	height := suite.chainB.LastHeader.Header.Height - 1
+	res := suite.chainB.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", stakingtypes.StoreKey),
+		Height: height,
+		Data:   key,
+		Prove:  true,
+	})
+	suite.NotEmpty(res.Value)
+
+	queryType := "cosmos.staking.v1beta1.Query/Validator"
+	request := []byte(validator.OperatorAddress)
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, request, sdk.NewInt(200), "test", "test", 0, 0, sdk.Coin{})
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, queryType, request, "test")
+
+	msgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	respond := func(relayer sdk.AccAddress, result []byte) error {
+		_, err := msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     id,
+			Result:      result,
+			Height:      height,
+			FromAddress: relayer.String(),
+		})
+		return err
+	}
+
+	// 1. responses to unproven queries are rejected from unregistered relayers, even while none are registered.
+	unregistered := utils.GenerateAccAddressForTest()
+	suite.True(icqKeeper.RequiresBondedRelayer(ctx))
+	suite.ErrorIs(respond(unregistered, res.Value), icqtypes.ErrUnbondedRelayer)
+
+	// 2. within a window opened by governance, they are accepted from any relayer.
+	params := icqKeeper.GetParams(ctx)
+	params.UnbondedRelayersUntil = ctx.BlockHeight() + 1
+	icqKeeper.SetParams(ctx, params)
+	suite.False(icqKeeper.RequiresBondedRelayer(ctx))
+	suite.NoError(respond(unregistered, res.Value))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+	suite.True(icqKeeper.RequiresBondedRelayer(ctx))
+
+	// once the window ends, responses from unregistered or unbonded relayers are rejected.
+	relayer := suite.bondedRelayer(ctx)
+	suite.ErrorIs(respond(unregistered, res.Value), icqtypes.ErrUnbondedRelayer)
+	suite.NoError(keeper.HandleRegisterRelayerProposal(ctx, icqKeeper, icqtypes.NewRegisterRelayerProposal("register", "register", unregistered.String())))
+	suite.ErrorIs(respond(unregistered, res.Value), icqtypes.ErrUnbondedRelayer)
+
+	// 3. a bonded registered relayer's response is accepted and recorded.
+	suite.NoError(respond(relayer, []byte("forged")))
+	record, found := icqKeeper.GetResponseRecord(ctx, id, height)
+	suite.True(found)
+	suite.Equal(relayer.String(), record.Relayer)
+
+	relayersRes, err := icqtypes.QuerySrvrServer(icqKeeper).Relayers(sdk.WrapSDKContext(ctx), &icqtypes.QueryRelayersRequest{})
+	suite.NoError(err)
+	suite.Len(relayersRes.Relayers, 2)
+
+	// 4. evidence must be proven against the host state at the response height.
+	evidence := &icqtypes.MsgSubmitResponseEvidence{
+		QueryId:     id,
+		Height:      height,
+		Store:       stakingtypes.StoreKey,
+		Key:         key,
+		Value:       []byte("forged"),
+		ProofOps:    res.ProofOps,
+		FromAddress: relayer.String(),
+	}
+	suite.ErrorContains(icqKeeper.HandleResponseEvidence(ctx, evidence), "unable to verify inclusion proof")
+
+	// 5. a contradicted response jails the relayer and slashes its bond.
+	evidence.Value = res.Value
+	suite.NoError(icqKeeper.HandleResponseEvidence(ctx, evidence))
+	bonded, _ := icqKeeper.GetRelayer(ctx, relayer)
+	suite.True(bonded.Jailed)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("uqck", 500_000_000)), bonded.Bond)
+	_, found = icqKeeper.GetResponseRecord(ctx, id, height)
+	suite.False(found)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	suite.True(icqKeeper.RequiresBondedRelayer(ctx))
+	suite.ErrorIs(respond(relayer, res.Value), icqtypes.ErrUnbondedRelayer)
+
+	// 6. a deregistered relayer is returned its remaining bond.
+	suite.NoError(keeper.HandleDeregisterRelayerProposal(ctx, icqKeeper, icqtypes.NewDeregisterRelayerProposal("deregister", "deregister", relayer.String())))
+	suite.Equal(bonded.Bond, quicksilver.BankKeeper.GetAllBalances(ctx, relayer))
+}
+
+func (suite *KeeperTestSuite) TestPruneResponseRecords() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	window := int64(icqKeeper.GetParams(ctx).EvidenceWindow)
+	query := icqtypes.Query{Id: "test"}
+	icqKeeper.SetResponseRecord(ctx, icqtypes.ResponseRecord{Query: query, Height: 1, LocalHeight: ctx.BlockHeight()})
+	icqKeeper.SetResponseRecord(ctx, icqtypes.ResponseRecord{Query: query, Height: 2, LocalHeight: ctx.BlockHeight()})
+	// a record replaced at a later local height is pruned by its new height only.
+	icqKeeper.SetResponseRecord(ctx, icqtypes.ResponseRecord{Query: query, Height: 2, LocalHeight: ctx.BlockHeight() + 1})
+
+	icqKeeper.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight() + window))
+	suite.Len(icqKeeper.AllResponseRecords(ctx), 2)
+
+	icqKeeper.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight() + window + 1))
+	suite.Len(icqKeeper.AllResponseRecords(ctx), 1)
+	_, found := icqKeeper.GetResponseRecord(ctx, query.Id, 2)
+	suite.True(found)
+
+	icqKeeper.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight() + window + 2))
+	suite.Empty(icqKeeper.AllResponseRecords(ctx))
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// ResponseStatsWindow is the number of blocks for which the accepted responses of each chain are counted.
+const ResponseStatsWindow = 1000
+
+// incrementResponseCount counts an accepted response to a query of a chain at the current height.
+func (k Keeper) incrementResponseCount(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseCount)
+	key := types.GetHeightIndexKey(ctx.BlockHeight(), chainID)
+	count := uint64(0)
+	if bz := store.Get(key); len(bz) != 0 {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+}
+
+// ResponseCount returns the number of accepted responses to queries of a chain from the given height to the
+// current height.
+func (k Keeper) ResponseCount(ctx sdk.Context, chainID string, fromHeight int64) uint64 {
+	if fromHeight < 0 {
+		fromHeight = 0
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseCount)
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(uint64(fromHeight)), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		if _, id := types.ParseHeightIndexKey(iterator.Key()); id == chainID {
+			count += sdk.BigEndianToUint64(iterator.Value())
+		}
+	}
+	return count
+}
+
+// pruneResponseCounts removes the response counts of heights outside the response stats window.
+func (k Keeper) pruneResponseCounts(ctx sdk.Context) {
+	end := ctx.BlockHeight() - ResponseStatsWindow + 1
+	if end <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixResponseCount)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(end)))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+// HasBounty returns true if the query carries a positive bounty.
+func (q Query) HasBounty() bool {
+	return !q.Bounty.Amount.IsNil() && q.Bounty.IsPositive()
+}
+
+// AwaitingResponse returns true if the query has been emitted and not answered since.
+func (q Query) AwaitingResponse() bool {
+	return !q.LastEmission.IsNil() && !q.LastEmission.IsZero() && q.LastHeight.LTE(q.LastEmission)
+}
@@ -8,5 +8,15 @@ type QueryCallbacks interface {
 	AddCallback(id string, fn interface{}) QueryCallbacks
 	RegisterCallbacks() QueryCallbacks
 	Call(ctx sdk.Context, id string, args []byte, query Query) error
+	// CallTimeout is invoked when a query with the given callback id remains unanswered after its final retry.
+	CallTimeout(ctx sdk.Context, id string, query Query) error
 	Has(id string) bool
 }
+
+// ResponseVerifier is implemented by the QueryCallbacks of modules able to check responses to their unproven queries
+// against proven state.
+type ResponseVerifier interface {
+	// Contradicts returns true if the proven value of a key in a remote store contradicts a response to the query at
+	// the same remote height. An empty value is a proof that the key is absent.
+	Contradicts(ctx sdk.Context, query Query, result []byte, store string, key []byte, value []byte) (bool, error)
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -15,11 +16,25 @@ var (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitQueryResponse{}, "quicksilver/MsgSubmitQueryResponse", nil)
+	cdc.RegisterConcrete(&MsgBondRelayer{}, "quicksilver/MsgBondRelayer", nil)
+	cdc.RegisterConcrete(&MsgSubmitResponseEvidence{}, "quicksilver/MsgSubmitResponseEvidence", nil)
+	cdc.RegisterConcrete(&MsgFundBountyBudget{}, "quicksilver/MsgFundBountyBudget", nil)
+	cdc.RegisterConcrete(&RegisterRelayerProposal{}, "quicksilver/RegisterRelayerProposal", nil)
+	cdc.RegisterConcrete(&DeregisterRelayerProposal{}, "quicksilver/DeregisterRelayerProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitQueryResponse{},
+		&MsgBondRelayer{},
+		&MsgSubmitResponseEvidence{},
+		&MsgFundBountyBudget{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&RegisterRelayerProposal{},
+		&DeregisterRelayerProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,5 +43,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
+	govtypes.RegisterProposalType(ProposalTypeRegisterRelayer)
+	govtypes.RegisterProposalTypeCodec(&RegisterRelayerProposal{}, "quicksilver/RegisterRelayerProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeregisterRelayer)
+	govtypes.RegisterProposalTypeCodec(&DeregisterRelayerProposal{}, "quicksilver/DeregisterRelayerProposal")
 	amino.Seal()
 }
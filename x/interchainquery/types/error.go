@@ -5,4 +5,8 @@ import "errors"
 var (
 	ErrAlreadyFulfilled  = errors.New("query already fulfilled")
 	ErrSucceededNoDelete = errors.New("query succeeded; do not not execute default behavior")
+	ErrUnexpectedHeight  = errors.New("query response at unexpected height")
+	ErrUnbondedRelayer   = errors.New("responses to unproven queries require a bonded registered relayer")
+	ErrNotContradicted   = errors.New("evidence does not contradict response")
+	ErrUnprovableHeight  = errors.New("responses to unproven queries require a remote height with a consensus state")
 )
@@ -8,7 +8,17 @@ const (
 	AttributeKeyParams       = "parameters"
 	AttributeKeyRequest      = "request"
 	AttributeKeyHeight       = "height"
+	AttributeKeyRelayer      = "relayer"
+	AttributeKeyError        = "error"
+	AttributeKeyCallback     = "callback_module"
+	AttributeKeyFailures     = "failures"
 
-	AttributeValueCategory = ModuleName
-	AttributeValueQuery    = "query"
+	AttributeValueCategory   = ModuleName
+	AttributeValueQuery      = "query"
+	AttributeValueTimeout    = "query_timeout"
+	AttributeValueBounty     = "bounty_paid"
+	AttributeValueSlash      = "relayer_slashed"
+	AttributeValueFailure    = "callback_failed"
+	AttributeValueQuarantine = "query_quarantined"
+	AttributeValueRelease    = "query_released"
 )
@@ -1,18 +1,38 @@
 package types
 
-func NewGenesisState(queries []Query) *GenesisState {
-	return &GenesisState{Queries: queries}
+import "fmt"
+
+func NewGenesisState(queries []Query, params Params) *GenesisState {
+	return &GenesisState{Queries: queries, Params: params}
 }
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	queries := []Query{}
-	return NewGenesisState(queries)
+	return NewGenesisState(queries, DefaultParams())
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
-	return nil
+	queries := make(map[string]bool, len(gs.Queries))
+	for _, query := range gs.Queries {
+		if queries[query.Id] {
+			return fmt.Errorf("duplicate query %s", query.Id)
+		}
+		queries[query.Id] = true
+	}
+
+	datapoints := make(map[string]bool, len(gs.Datapoints))
+	for _, dp := range gs.Datapoints {
+		if !queries[dp.Id] {
+			return fmt.Errorf("datapoint refers to unknown query %s", dp.Id)
+		}
+		if datapoints[dp.Id] {
+			return fmt.Errorf("duplicate datapoint for query %s", dp.Id)
+		}
+		datapoints[dp.Id] = true
+	}
+
+	return gs.Params.Validate()
 }
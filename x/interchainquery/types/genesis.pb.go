@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -37,6 +38,35 @@ type Query struct {
 	CallbackId   string                                 `protobuf:"bytes,8,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	Ttl          uint64                                 `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	LastEmission github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=last_emission,json=lastEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_emission"`
+	// deadline is the local height after which an emitted query that remains
+	// unanswered is retried; zero if the query is not awaiting a response.
+	Deadline int64 `protobuf:"varint,11,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// backoff is the number of blocks an emitted query awaits a response, and
+	// doubles with each retry; zero disables retries and timeouts.
+	Backoff uint64 `protobuf:"varint,12,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// max_retries is the number of times an unanswered query is retried before
+	// it times out.
+	MaxRetries uint32 `protobuf:"varint,13,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// retries is the number of times the query has been retried since it was
+	// last emitted.
+	Retries uint32 `protobuf:"varint,14,opt,name=retries,proto3" json:"retries,omitempty"`
+	// height is the remote height at which the query must be answered; zero if
+	// the query may be answered at any height.
+	Height int64 `protobuf:"varint,15,opt,name=height,proto3" json:"height,omitempty"`
+	// module is the module that owns the query, and funds its bounty.
+	Module string `protobuf:"bytes,16,opt,name=module,proto3" json:"module,omitempty"`
+	// bounty is paid to the relayer submitting the first accepted response to
+	// each emission of the query, from the bounty budget of its module.
+	Bounty types.Coin `protobuf:"bytes,17,opt,name=bounty,proto3" json:"bounty"`
+	// failures is the number of consecutive responses to the query whose
+	// callback failed.
+	Failures uint32 `protobuf:"varint,18,opt,name=failures,proto3" json:"failures,omitempty"`
+	// last_error is the error returned by the last failed callback.
+	LastError string `protobuf:"bytes,19,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// quarantined is set once failures reaches the max callback failures; a
+	// quarantined query is no longer emitted, and responses to it are ignored,
+	// until it is re-requested by its owning module.
+	Quarantined bool `protobuf:"varint,20,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -121,12 +151,85 @@ func (m *Query) GetTtl() uint64 {
 	return 0
 }
 
+func (m *Query) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Query) GetBackoff() uint64 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *Query) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *Query) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Query) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Query) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *Query) GetBounty() types.Coin {
+	if m != nil {
+		return m.Bounty
+	}
+	return types.Coin{}
+}
+
+func (m *Query) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *Query) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Query) GetQuarantined() bool {
+	if m != nil {
+		return m.Quarantined
+	}
+	return false
+}
+
 type DataPoint struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// change these to uint64 in v0.5.0
 	RemoteHeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remote_height,json=remoteHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remote_height"`
 	LocalHeight  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=local_height,json=localHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"local_height"`
 	Value        []byte                                 `protobuf:"bytes,4,opt,name=value,proto3" json:"result,omitempty"`
+	// expiry is the local height after which the datapoint is removed; zero if
+	// the datapoint is kept until its query is removed.
+	Expiry int64 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
 func (m *DataPoint) Reset()         { *m = DataPoint{} }
@@ -176,23 +279,32 @@ func (m *DataPoint) GetValue() []byte {
 	return nil
 }
 
-// GenesisState defines the epochs module's genesis state.
-type GenesisState struct {
-	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+func (m *DataPoint) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
+// BountyBudget is the balance a module has set aside to pay the bounties of its
+// queries.
+type BountyBudget struct {
+	Module  string                                   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *BountyBudget) Reset()         { *m = BountyBudget{} }
+func (m *BountyBudget) String() string { return proto.CompactTextString(m) }
+func (*BountyBudget) ProtoMessage()    {}
+func (*BountyBudget) Descriptor() ([]byte, []int) {
 	return fileDescriptor_90232048b76e95cc, []int{2}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *BountyBudget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BountyBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BountyBudget.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -202,362 +314,2315 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *BountyBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyBudget.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *BountyBudget) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *BountyBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyBudget.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_BountyBudget proto.InternalMessageInfo
 
-func (m *GenesisState) GetQueries() []Query {
+func (m *BountyBudget) GetModule() string {
 	if m != nil {
-		return m.Queries
+		return m.Module
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*Query)(nil), "quicksilver.interchainquery.v1.Query")
-	proto.RegisterType((*DataPoint)(nil), "quicksilver.interchainquery.v1.DataPoint")
-	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainquery.v1.GenesisState")
+func (m *BountyBudget) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainquery/v1/genesis.proto", fileDescriptor_90232048b76e95cc)
+// RelayerPayout is the total of the bounties paid to a relayer.
+type RelayerPayout struct {
+	Relayer string                                   `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Paid    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+	// responses is the number of responses for which a bounty was paid.
+	Responses uint64 `protobuf:"varint,3,opt,name=responses,proto3" json:"responses,omitempty"`
 }
 
-var fileDescriptor_90232048b76e95cc = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0xda, 0xdd, 0xed, 0xf6, 0x35, 0x95, 0x65, 0xd8, 0x43, 0x76, 0xc1, 0xb4, 0x54, 0x94,
-	0x22, 0xdb, 0x84, 0xd5, 0xab, 0x78, 0x28, 0x2e, 0xda, 0x9b, 0xc6, 0x15, 0x44, 0x90, 0x32, 0x4d,
-	0x86, 0x74, 0x68, 0x32, 0xd3, 0x66, 0x5e, 0x8a, 0xfd, 0x0d, 0x5e, 0xfc, 0x31, 0xfe, 0x88, 0x3d,
-	0x2e, 0x9e, 0x44, 0xa1, 0x48, 0x7b, 0xf3, 0x57, 0xc8, 0x4c, 0x52, 0x2c, 0x2e, 0x78, 0xea, 0x29,
-	0x33, 0xef, 0x7b, 0xef, 0x7b, 0xdf, 0x7b, 0x99, 0x0f, 0x2e, 0xe6, 0x39, 0x0f, 0xa7, 0x8a, 0x27,
-	0x0b, 0x96, 0xf9, 0x5c, 0x20, 0xcb, 0xc2, 0x09, 0xe5, 0x62, 0x9e, 0xb3, 0x6c, 0xe9, 0x2f, 0x2e,
-	0xfd, 0x98, 0x09, 0xa6, 0xb8, 0xf2, 0x66, 0x99, 0x44, 0x49, 0xdc, 0x9d, 0x6c, 0xef, 0x9f, 0x6c,
-	0x6f, 0x71, 0x79, 0x7e, 0x1a, 0xcb, 0x58, 0x9a, 0x54, 0x5f, 0x9f, 0x8a, 0xaa, 0xf3, 0xb3, 0x50,
-	0xaa, 0x54, 0xaa, 0x51, 0x01, 0x14, 0x97, 0x02, 0xea, 0xfe, 0xac, 0xc1, 0xe1, 0x1b, 0x5d, 0x4d,
-	0xee, 0x41, 0x95, 0x47, 0x8e, 0xd5, 0xb1, 0x7a, 0x8d, 0xa0, 0xca, 0x23, 0xf2, 0x00, 0x5a, 0xa1,
-	0x14, 0x82, 0x85, 0xc8, 0xa5, 0x18, 0xf1, 0xc8, 0xa9, 0x1a, 0xc8, 0xfe, 0x1b, 0x1c, 0x46, 0xe4,
-	0x0c, 0x8e, 0x8d, 0x00, 0x8d, 0xd7, 0x0c, 0x5e, 0x37, 0xf7, 0x61, 0x44, 0xee, 0x03, 0x18, 0x59,
-	0x23, 0x5c, 0xce, 0x98, 0x73, 0x60, 0xc0, 0x86, 0x89, 0x5c, 0x2f, 0x67, 0x8c, 0x38, 0x50, 0xcf,
-	0xd8, 0x3c, 0x67, 0x0a, 0x9d, 0xc3, 0x8e, 0xd5, 0xb3, 0x83, 0xed, 0x95, 0x5c, 0xc3, 0xd1, 0x8c,
-	0x65, 0x5c, 0x46, 0xce, 0x91, 0x2e, 0x1a, 0x3c, 0xbb, 0x59, 0xb5, 0x2b, 0x3f, 0x56, 0xed, 0x47,
-	0x31, 0xc7, 0x49, 0x3e, 0xf6, 0x42, 0x99, 0x96, 0x33, 0x94, 0x9f, 0xbe, 0x8a, 0xa6, 0xbe, 0xee,
-	0xa2, 0xbc, 0xa1, 0xc0, 0x6f, 0x5f, 0xfb, 0x50, 0x8e, 0x38, 0x14, 0x18, 0x94, 0x5c, 0xe4, 0x23,
-	0x34, 0x13, 0xaa, 0x70, 0x34, 0x61, 0x3c, 0x9e, 0xa0, 0x53, 0xdf, 0x03, 0x35, 0x68, 0xc2, 0x57,
-	0x86, 0x8f, 0xb4, 0xa1, 0x19, 0xd2, 0x24, 0x19, 0xd3, 0x70, 0xaa, 0x77, 0x71, 0x6c, 0xc6, 0x85,
-	0x6d, 0x68, 0x18, 0x91, 0x13, 0xa8, 0x21, 0x26, 0x4e, 0xa3, 0x63, 0xf5, 0x0e, 0x02, 0x7d, 0x24,
-	0x14, 0x5a, 0x46, 0x11, 0x4b, 0xb9, 0x52, 0x5c, 0x0a, 0x07, 0xf6, 0xa0, 0xc9, 0xd6, 0x94, 0x57,
-	0x25, 0x63, 0xf7, 0x73, 0x15, 0x1a, 0x2f, 0x28, 0xd2, 0xd7, 0x92, 0x0b, 0xbc, 0xf3, 0x87, 0x29,
-	0xb4, 0x32, 0x96, 0x4a, 0x64, 0xdb, 0xa5, 0x54, 0xf7, 0x21, 0xa0, 0xa0, 0x2c, 0xd7, 0x32, 0x02,
-	0x3b, 0x91, 0x21, 0x4d, 0xb6, 0x1d, 0x6a, 0x7b, 0xe8, 0xd0, 0x34, 0x8c, 0x65, 0x83, 0xc7, 0x70,
-	0xb8, 0xa0, 0x49, 0x5e, 0x3c, 0x30, 0x7b, 0x70, 0xfa, 0x7b, 0xd5, 0x3e, 0xc9, 0x98, 0xca, 0x13,
-	0xbc, 0x90, 0x29, 0x47, 0x96, 0xce, 0x70, 0x19, 0x14, 0x29, 0xdd, 0x77, 0x60, 0xbf, 0x2c, 0xdc,
-	0xf4, 0x16, 0x29, 0x32, 0x72, 0x05, 0x75, 0xfd, 0x1e, 0x39, 0x53, 0x8e, 0xd5, 0xa9, 0xf5, 0x9a,
-	0x4f, 0x1e, 0x7a, 0xff, 0xb7, 0x97, 0x67, 0x9c, 0x32, 0x38, 0xd0, 0xf2, 0x83, 0x6d, 0xed, 0xe0,
-	0xfd, 0xcd, 0xda, 0xb5, 0x6e, 0xd7, 0xae, 0xf5, 0x6b, 0xed, 0x5a, 0x5f, 0x36, 0x6e, 0xe5, 0x76,
-	0xe3, 0x56, 0xbe, 0x6f, 0xdc, 0xca, 0x87, 0xe7, 0x3b, 0xf3, 0x71, 0x11, 0x33, 0x91, 0x73, 0x5c,
-	0xf6, 0xc7, 0x39, 0x4f, 0x22, 0x7f, 0xd7, 0xf6, 0x9f, 0xee, 0x18, 0xdf, 0xcc, 0x3e, 0x3e, 0x32,
-	0x1e, 0x7d, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x1b, 0x7a, 0xd4, 0x24, 0x04, 0x00, 0x00,
+func (m *RelayerPayout) Reset()         { *m = RelayerPayout{} }
+func (m *RelayerPayout) String() string { return proto.CompactTextString(m) }
+func (*RelayerPayout) ProtoMessage()    {}
+func (*RelayerPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90232048b76e95cc, []int{3}
 }
-
-func (m *Query) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RelayerPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Query) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RelayerPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerPayout.Merge(m, src)
+}
+func (m *RelayerPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerPayout.DiscardUnknown(m)
 }
 
-func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LastEmission.Size()
-		i -= size
-		if _, err := m.LastEmission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if m.Ttl != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Ttl))
-		i--
-		dAtA[i] = 0x48
+var xxx_messageInfo_RelayerPayout proto.InternalMessageInfo
+
+func (m *RelayerPayout) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	if len(m.CallbackId) > 0 {
-		i -= len(m.CallbackId)
-		copy(dAtA[i:], m.CallbackId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackId)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *RelayerPayout) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
 	}
-	{
-		size := m.LastHeight.Size()
-		i -= size
-		if _, err := m.LastHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *RelayerPayout) GetResponses() uint64 {
+	if m != nil {
+		return m.Responses
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.Period.Size()
-		i -= size
-		if _, err := m.Period.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// Params defines the parameters of the interchainquery module.
+type Params struct {
+	// min_relayer_bond is the bond a registered relayer must hold to submit
+	// responses to unproven queries.
+	MinRelayerBond types.Coin `protobuf:"bytes,1,opt,name=min_relayer_bond,json=minRelayerBond,proto3" json:"min_relayer_bond" yaml:"min_relayer_bond"`
+	// relayer_slash_fraction is the fraction of its bond a relayer forfeits
+	// when a response it submitted is contradicted by proven state.
+	RelayerSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=relayer_slash_fraction,json=relayerSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"relayer_slash_fraction" yaml:"relayer_slash_fraction"`
+	// evidence_window is the number of blocks for which responses to unproven
+	// queries are kept, and may be contradicted.
+	EvidenceWindow uint64 `protobuf:"varint,3,opt,name=evidence_window,json=evidenceWindow,proto3" json:"evidence_window,omitempty" yaml:"evidence_window"`
+	// max_callback_failures is the number of consecutive callback failures
+	// after which a query is quarantined; zero disables quarantine.
+	MaxCallbackFailures uint32 `protobuf:"varint,4,opt,name=max_callback_failures,json=maxCallbackFailures,proto3" json:"max_callback_failures,omitempty" yaml:"max_callback_failures"`
+	// unbonded_relayers_until is the local block height until which responses
+	// to unproven queries are accepted from relayers that are not bonded, to
+	// allow relayers to register and bond after an upgrade; zero accepts them
+	// only from bonded registered relayers.
+	UnbondedRelayersUntil int64 `protobuf:"varint,5,opt,name=unbonded_relayers_until,json=unbondedRelayersUntil,proto3" json:"unbonded_relayers_until,omitempty" yaml:"unbonded_relayers_until"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90232048b76e95cc, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Request) > 0 {
-		i -= len(m.Request)
-		copy(dAtA[i:], m.Request)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Request)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.QueryType) > 0 {
-		i -= len(m.QueryType)
-		copy(dAtA[i:], m.QueryType)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryType)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinRelayerBond() types.Coin {
+	if m != nil {
+		return m.MinRelayerBond
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
+	return types.Coin{}
+}
+
+func (m *Params) GetEvidenceWindow() uint64 {
+	if m != nil {
+		return m.EvidenceWindow
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetMaxCallbackFailures() uint32 {
+	if m != nil {
+		return m.MaxCallbackFailures
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DataPoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetUnbondedRelayersUntil() int64 {
+	if m != nil {
+		return m.UnbondedRelayersUntil
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Relayer is a relayer registered by governance to submit responses to
+// unproven queries.
+type Relayer struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Bond    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+	// jailed is set when a response submitted by the relayer is contradicted by
+	// proven state; a jailed relayer may not submit responses to unproven
+	// queries until it is registered again.
+	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
-func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.LocalHeight.Size()
-		i -= size
-		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RemoteHeight.Size()
-		i -= size
-		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *Relayer) Reset()         { *m = Relayer{} }
+func (m *Relayer) String() string { return proto.CompactTextString(m) }
+func (*Relayer) ProtoMessage()    {}
+func (*Relayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90232048b76e95cc, []int{5}
+}
+func (m *Relayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Relayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relayer.Merge(m, src)
+}
+func (m *Relayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Relayer proto.InternalMessageInfo
+
+func (m *Relayer) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Relayer) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Relayer) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queries) > 0 {
-		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// ResponseRecord is a response to an unproven query, kept so that it may be
+// contradicted by proven state at the same remote height.
+type ResponseRecord struct {
+	Query   Query  `protobuf:"bytes,1,opt,name=query,proto3" json:"query"`
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// height is the remote height of the response.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// local_height is the height at which the response was accepted.
+	LocalHeight int64  `protobuf:"varint,4,opt,name=local_height,json=localHeight,proto3" json:"local_height,omitempty"`
+	Result      []byte `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *ResponseRecord) Reset()         { *m = ResponseRecord{} }
+func (m *ResponseRecord) String() string { return proto.CompactTextString(m) }
+func (*ResponseRecord) ProtoMessage()    {}
+func (*ResponseRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90232048b76e95cc, []int{6}
+}
+func (m *ResponseRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ResponseRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseRecord.Merge(m, src)
+}
+func (m *ResponseRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseRecord.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ResponseRecord proto.InternalMessageInfo
+
+func (m *ResponseRecord) GetQuery() Query {
+	if m != nil {
+		return m.Query
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return Query{}
 }
-func (m *Query) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ResponseRecord) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ResponseRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+func (m *ResponseRecord) GetLocalHeight() int64 {
+	if m != nil {
+		return m.LocalHeight
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+func (m *ResponseRecord) GetResult() []byte {
+	if m != nil {
+		return m.Result
 	}
-	l = len(m.QueryType)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+// GenesisState defines the epochs module's genesis state.
+type GenesisState struct {
+	Queries         []Query          `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	BountyBudgets   []BountyBudget   `protobuf:"bytes,2,rep,name=bounty_budgets,json=bountyBudgets,proto3" json:"bounty_budgets"`
+	RelayerPayouts  []RelayerPayout  `protobuf:"bytes,3,rep,name=relayer_payouts,json=relayerPayouts,proto3" json:"relayer_payouts"`
+	Params          Params           `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	Relayers        []Relayer        `protobuf:"bytes,5,rep,name=relayers,proto3" json:"relayers"`
+	ResponseRecords []ResponseRecord `protobuf:"bytes,6,rep,name=response_records,json=responseRecords,proto3" json:"response_records"`
+	// datapoints are the stored results of queries; each must refer to a query
+	// in queries.
+	Datapoints []DataPoint `protobuf:"bytes,7,rep,name=datapoints,proto3" json:"datapoints"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90232048b76e95cc, []int{7}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Request)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetQueries() []Query {
+	if m != nil {
+		return m.Queries
 	}
-	l = m.Period.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.LastHeight.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.CallbackId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetBountyBudgets() []BountyBudget {
+	if m != nil {
+		return m.BountyBudgets
 	}
-	if m.Ttl != 0 {
-		n += 1 + sovGenesis(uint64(m.Ttl))
+	return nil
+}
+
+func (m *GenesisState) GetRelayerPayouts() []RelayerPayout {
+	if m != nil {
+		return m.RelayerPayouts
 	}
-	l = m.LastEmission.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return nil
 }
 
-func (m *DataPoint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return Params{}
+}
+
+func (m *GenesisState) GetRelayers() []Relayer {
+	if m != nil {
+		return m.Relayers
 	}
-	l = m.RemoteHeight.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.LocalHeight.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetResponseRecords() []ResponseRecord {
+	if m != nil {
+		return m.ResponseRecords
 	}
-	return n
+	return nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetDatapoints() []DataPoint {
+	if m != nil {
+		return m.Datapoints
 	}
-	var l int
-	_ = l
-	if len(m.Queries) > 0 {
-		for _, e := range m.Queries {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Query)(nil), "quicksilver.interchainquery.v1.Query")
+	proto.RegisterType((*DataPoint)(nil), "quicksilver.interchainquery.v1.DataPoint")
+	proto.RegisterType((*BountyBudget)(nil), "quicksilver.interchainquery.v1.BountyBudget")
+	proto.RegisterType((*RelayerPayout)(nil), "quicksilver.interchainquery.v1.RelayerPayout")
+	proto.RegisterType((*Params)(nil), "quicksilver.interchainquery.v1.Params")
+	proto.RegisterType((*Relayer)(nil), "quicksilver.interchainquery.v1.Relayer")
+	proto.RegisterType((*ResponseRecord)(nil), "quicksilver.interchainquery.v1.ResponseRecord")
+	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainquery.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/interchainquery/v1/genesis.proto", fileDescriptor_90232048b76e95cc)
+}
+
+var fileDescriptor_90232048b76e95cc = []byte{
+	// 1241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0x93, 0x3c, 0x7f, 0x24, 0x4c, 0xd3, 0x74, 0x1b, 0xb5, 0xb6, 0x59, 0x44,
+	0x31, 0xa8, 0xb1, 0x49, 0x39, 0x20, 0x21, 0x84, 0x84, 0x9b, 0x16, 0x72, 0xa2, 0x6c, 0x8b, 0x80,
+	0x8a, 0x6a, 0x35, 0xde, 0x9d, 0x38, 0x43, 0x77, 0x67, 0x9c, 0x99, 0xd9, 0x34, 0xfe, 0x23, 0x90,
+	0x10, 0x5c, 0xb9, 0x71, 0x41, 0x9c, 0xf9, 0x03, 0x38, 0xf6, 0x58, 0x38, 0x21, 0x0e, 0x06, 0xb5,
+	0x37, 0x8e, 0xb9, 0x72, 0x41, 0xf3, 0xb1, 0xa9, 0x93, 0x96, 0xa6, 0x95, 0xd2, 0x93, 0xfd, 0x3e,
+	0xe6, 0xb7, 0x6f, 0xde, 0xfb, 0xbd, 0xf7, 0x06, 0x2e, 0xef, 0xe6, 0x34, 0xbe, 0x2b, 0x69, 0xba,
+	0x47, 0x44, 0x8f, 0x32, 0x45, 0x44, 0xbc, 0x83, 0x29, 0xdb, 0xcd, 0x89, 0x18, 0xf7, 0xf6, 0x36,
+	0x7a, 0x43, 0xc2, 0x88, 0xa4, 0xb2, 0x3b, 0x12, 0x5c, 0x71, 0xd4, 0x9c, 0xf2, 0xee, 0x1e, 0xf3,
+	0xee, 0xee, 0x6d, 0xac, 0xad, 0x0c, 0xf9, 0x90, 0x1b, 0xd7, 0x9e, 0xfe, 0x67, 0x4f, 0xad, 0x9d,
+	0x8f, 0xb9, 0xcc, 0xb8, 0x8c, 0xac, 0xc1, 0x0a, 0xce, 0xd4, 0xb4, 0x52, 0x6f, 0x80, 0x25, 0xe9,
+	0xed, 0x6d, 0x0c, 0x88, 0xc2, 0x1b, 0xbd, 0x98, 0x53, 0x66, 0xed, 0xc1, 0x77, 0x15, 0x98, 0xfb,
+	0x54, 0xa3, 0xa3, 0x06, 0xcc, 0xd2, 0xc4, 0xf7, 0xda, 0x5e, 0x67, 0x31, 0x9c, 0xa5, 0x09, 0x7a,
+	0x0d, 0xea, 0x31, 0x67, 0x8c, 0xc4, 0x8a, 0x72, 0x16, 0xd1, 0xc4, 0x9f, 0x35, 0xa6, 0xda, 0x63,
+	0xe5, 0x56, 0x82, 0xce, 0xc3, 0x82, 0x09, 0x50, 0xdb, 0x4b, 0xc6, 0x3e, 0x6f, 0xe4, 0xad, 0x04,
+	0x5d, 0x04, 0x30, 0x61, 0x47, 0x6a, 0x3c, 0x22, 0x7e, 0xd9, 0x18, 0x17, 0x8d, 0xe6, 0xd6, 0x78,
+	0x44, 0x90, 0x0f, 0xf3, 0x82, 0xec, 0xe6, 0x44, 0x2a, 0x7f, 0xae, 0xed, 0x75, 0x6a, 0x61, 0x21,
+	0xa2, 0x5b, 0x50, 0x19, 0x11, 0x41, 0x79, 0xe2, 0x57, 0xf4, 0xa1, 0xfe, 0xfb, 0xf7, 0x27, 0xad,
+	0x99, 0x3f, 0x27, 0xad, 0x4b, 0x43, 0xaa, 0x76, 0xf2, 0x41, 0x37, 0xe6, 0x99, 0xbb, 0xa3, 0xfb,
+	0x59, 0x97, 0xc9, 0xdd, 0x9e, 0xfe, 0x8a, 0xec, 0x6e, 0x31, 0xf5, 0xfb, 0x2f, 0xeb, 0xe0, 0x52,
+	0xb0, 0xc5, 0x54, 0xe8, 0xb0, 0xd0, 0x1d, 0xa8, 0xa6, 0x58, 0xaa, 0x68, 0x87, 0xd0, 0xe1, 0x8e,
+	0xf2, 0xe7, 0x4f, 0x01, 0x1a, 0x34, 0xe0, 0xc7, 0x06, 0x0f, 0xb5, 0xa0, 0x1a, 0xe3, 0x34, 0x1d,
+	0xe0, 0xf8, 0xae, 0xce, 0xc5, 0x82, 0xb9, 0x2e, 0x14, 0xaa, 0xad, 0x04, 0x2d, 0x43, 0x49, 0xa9,
+	0xd4, 0x5f, 0x6c, 0x7b, 0x9d, 0x72, 0xa8, 0xff, 0x22, 0x0c, 0x75, 0x13, 0x11, 0xc9, 0xa8, 0x94,
+	0x94, 0x33, 0x1f, 0x4e, 0x21, 0xa6, 0x9a, 0x86, 0xbc, 0xe6, 0x10, 0xd1, 0x1a, 0x2c, 0x24, 0x04,
+	0x27, 0x29, 0x65, 0xc4, 0xaf, 0xb6, 0xbd, 0x4e, 0x29, 0x3c, 0x94, 0x75, 0x01, 0x74, 0x68, 0x7c,
+	0x7b, 0xdb, 0xaf, 0x99, 0xa0, 0x0a, 0x51, 0xdf, 0x25, 0xc3, 0xfb, 0x91, 0x20, 0x4a, 0x50, 0x22,
+	0xfd, 0x7a, 0xdb, 0xeb, 0xd4, 0x43, 0xc8, 0xf0, 0x7e, 0x68, 0x35, 0xb6, 0x76, 0xd6, 0xd8, 0x30,
+	0xc6, 0x42, 0x44, 0xab, 0x50, 0x71, 0x09, 0x5e, 0x32, 0x9f, 0x73, 0x92, 0xd6, 0x67, 0x3c, 0xc9,
+	0x53, 0xe2, 0x2f, 0x9b, 0xcc, 0x38, 0x09, 0xbd, 0x0b, 0x95, 0x01, 0xcf, 0x99, 0x1a, 0xfb, 0xaf,
+	0xb4, 0xbd, 0x4e, 0xf5, 0xca, 0xf9, 0xae, 0xbb, 0x8b, 0xe6, 0x6b, 0xd7, 0xf1, 0xb5, 0x7b, 0x95,
+	0x53, 0xd6, 0x2f, 0xeb, 0xbc, 0x84, 0xce, 0x5d, 0xdf, 0x6c, 0x1b, 0xd3, 0x34, 0x17, 0x44, 0xfa,
+	0xc8, 0xc4, 0x70, 0x28, 0x6b, 0xe6, 0xd9, 0xc4, 0x0a, 0xc1, 0x85, 0x7f, 0xc6, 0x32, 0xcf, 0xe4,
+	0x45, 0x2b, 0x50, 0x1b, 0xaa, 0xbb, 0x39, 0x16, 0x98, 0x29, 0xca, 0x48, 0xe2, 0xaf, 0xb4, 0xbd,
+	0xce, 0x42, 0x38, 0xad, 0x0a, 0x7e, 0x9c, 0x85, 0xc5, 0x4d, 0xac, 0xf0, 0x0d, 0x4e, 0x99, 0x7a,
+	0xa2, 0x31, 0x30, 0xd4, 0x05, 0xc9, 0xb8, 0x22, 0x05, 0x97, 0x66, 0x4f, 0xa3, 0x6e, 0x16, 0xd2,
+	0xb1, 0x29, 0x82, 0x5a, 0xca, 0x63, 0x9c, 0x16, 0x5f, 0x28, 0x9d, 0xc2, 0x17, 0xaa, 0x06, 0xd1,
+	0x7d, 0xe0, 0x2d, 0x98, 0xdb, 0xc3, 0x69, 0x6e, 0xfb, 0xb2, 0xd6, 0x5f, 0xf9, 0x67, 0xd2, 0x5a,
+	0x16, 0x44, 0xe6, 0xa9, 0xba, 0xcc, 0x33, 0xaa, 0x48, 0x36, 0x52, 0xe3, 0xd0, 0xba, 0xe8, 0xda,
+	0x91, 0xfd, 0x11, 0x15, 0x63, 0xd3, 0xa8, 0xa5, 0xd0, 0x49, 0xc1, 0x37, 0x1e, 0xd4, 0xfa, 0xa6,
+	0x1a, 0xfd, 0x3c, 0x19, 0x92, 0xe9, 0x22, 0x7b, 0x47, 0x8a, 0x4c, 0x34, 0xd3, 0x52, 0xcc, 0x62,
+	0xe2, 0xcf, 0xb6, 0x4b, 0xcf, 0xae, 0xf2, 0xdb, 0xfa, 0x8e, 0x3f, 0xff, 0xd5, 0xea, 0x3c, 0xc7,
+	0x1d, 0xf5, 0x01, 0x19, 0x16, 0xd8, 0xc1, 0x4f, 0x1e, 0xd4, 0x43, 0x92, 0xe2, 0x31, 0x11, 0x37,
+	0xf0, 0x98, 0xe7, 0xca, 0xf2, 0xd4, 0x28, 0x5c, 0x44, 0x85, 0x88, 0x22, 0x28, 0x8f, 0x30, 0x4d,
+	0x5e, 0x46, 0x3c, 0x06, 0x18, 0x5d, 0x80, 0x45, 0x41, 0xe4, 0x88, 0x33, 0x49, 0xa4, 0x29, 0x5f,
+	0x39, 0x7c, 0xac, 0x08, 0xfe, 0x2d, 0x41, 0xe5, 0x06, 0x16, 0x38, 0x93, 0x28, 0x81, 0xe5, 0x8c,
+	0xb2, 0xc8, 0x05, 0x16, 0x0d, 0x38, 0xb3, 0x5c, 0x7b, 0x66, 0x54, 0x2d, 0x1d, 0xd5, 0xc1, 0xa4,
+	0x75, 0x6e, 0x8c, 0xb3, 0xf4, 0xbd, 0xe0, 0x38, 0x40, 0x10, 0x36, 0x32, 0xca, 0x5c, 0x2e, 0xfa,
+	0x9c, 0x25, 0xe8, 0x7b, 0x0f, 0x56, 0x0b, 0x0f, 0x99, 0x62, 0xb9, 0x13, 0x6d, 0x0b, 0x6c, 0x86,
+	0xb8, 0x63, 0xef, 0x9d, 0x17, 0xe0, 0xd6, 0x26, 0x89, 0x0f, 0x26, 0xad, 0x8b, 0xf6, 0xdb, 0x4f,
+	0x47, 0x0d, 0xa6, 0xc8, 0xb7, 0x49, 0xe2, 0x70, 0xc5, 0xb9, 0xdd, 0xd4, 0x5e, 0xd7, 0x9d, 0x13,
+	0xba, 0x0a, 0x4b, 0x64, 0x8f, 0x26, 0x84, 0xc5, 0x24, 0xba, 0x47, 0x59, 0xc2, 0xef, 0xd9, 0x54,
+	0xf5, 0xd7, 0x0e, 0x26, 0xad, 0x55, 0x8b, 0x7f, 0xcc, 0x21, 0x08, 0x1b, 0x85, 0xe6, 0x73, 0xa3,
+	0x40, 0xb7, 0xe0, 0xac, 0x9e, 0x56, 0x87, 0xd3, 0xf7, 0x70, 0x2c, 0x68, 0x6a, 0xd7, 0xfb, 0xed,
+	0x83, 0x49, 0xeb, 0x82, 0x4b, 0xd3, 0xd3, 0xdc, 0x82, 0xf0, 0x4c, 0x86, 0xf7, 0xaf, 0x3a, 0xf5,
+	0x75, 0xa7, 0x45, 0xb7, 0xe1, 0x5c, 0xce, 0x74, 0x2e, 0x49, 0x52, 0xa4, 0x56, 0x46, 0x39, 0x53,
+	0x34, 0xb5, 0x5d, 0xd0, 0x0f, 0x0e, 0x26, 0xad, 0xa6, 0xc5, 0xfd, 0x1f, 0xc7, 0x20, 0x3c, 0x5b,
+	0x58, 0x5c, 0x29, 0xe4, 0x67, 0x46, 0xff, 0x83, 0x07, 0xf3, 0x4e, 0xa3, 0x29, 0x8a, 0x93, 0x44,
+	0x10, 0x29, 0x0b, 0x8a, 0x3a, 0x51, 0x53, 0xd4, 0x90, 0xe1, 0x65, 0x50, 0x54, 0x03, 0xeb, 0x76,
+	0xfd, 0x1a, 0xd3, 0x94, 0xd8, 0xcd, 0xbd, 0x10, 0x3a, 0x29, 0xf8, 0xd5, 0x83, 0x46, 0xe8, 0xa8,
+	0x1a, 0x92, 0x98, 0x8b, 0x04, 0x7d, 0x08, 0x73, 0x66, 0x73, 0x3b, 0x66, 0xbe, 0xde, 0x7d, 0xf6,
+	0x33, 0xa5, 0x6b, 0x5e, 0x14, 0x6e, 0x62, 0xdb, 0x93, 0xd3, 0xbd, 0x38, 0x7b, 0xb4, 0x17, 0x1f,
+	0xef, 0x8c, 0xd2, 0x91, 0x9d, 0xf1, 0xea, 0xb1, 0x21, 0x58, 0x36, 0xd6, 0x23, 0x63, 0x6c, 0x15,
+	0x2a, 0x76, 0x6a, 0xb9, 0x37, 0x84, 0x93, 0x82, 0xdf, 0xca, 0x50, 0xfb, 0xc8, 0x3e, 0xac, 0x6e,
+	0x2a, 0xac, 0x08, 0xba, 0x06, 0xf3, 0x3a, 0x0c, 0xbd, 0xb1, 0xbc, 0x76, 0xe9, 0x45, 0xaf, 0x50,
+	0x9c, 0x45, 0x5f, 0x42, 0xc3, 0xee, 0x9f, 0x68, 0x60, 0x46, 0x9e, 0x74, 0xd5, 0xb9, 0x7c, 0x12,
+	0xda, 0xf4, 0x9c, 0x74, 0xa0, 0xf5, 0xc1, 0x94, 0x4e, 0xa2, 0xaf, 0x60, 0xa9, 0x68, 0xa5, 0x91,
+	0x99, 0x5e, 0x7a, 0x6c, 0x68, 0xec, 0xf5, 0x93, 0xb0, 0x8f, 0xcc, 0x3c, 0x07, 0xde, 0x10, 0xd3,
+	0x4a, 0x89, 0x36, 0xa1, 0x32, 0x32, 0xf3, 0xc6, 0x64, 0xb1, 0x7a, 0xe5, 0xd2, 0x49, 0xa0, 0x76,
+	0x3a, 0x15, 0x4b, 0xd7, 0x9e, 0x45, 0x5b, 0xb0, 0x50, 0x50, 0xdc, 0x9f, 0x33, 0xc1, 0xbd, 0xf1,
+	0x9c, 0xc1, 0x39, 0xa0, 0xc3, 0xe3, 0x28, 0x82, 0xe5, 0x62, 0x1c, 0x46, 0xc2, 0x90, 0x4c, 0xfa,
+	0x15, 0x03, 0xd9, 0x3d, 0x19, 0x72, 0x9a, 0x9b, 0x0e, 0x79, 0x49, 0x1c, 0xd1, 0x4a, 0xf4, 0x09,
+	0x40, 0x82, 0x15, 0x1e, 0xe9, 0x15, 0x2e, 0xfd, 0x79, 0x03, 0xfd, 0xe6, 0x49, 0xd0, 0x87, 0x4b,
+	0xdf, 0xa1, 0x4e, 0x41, 0xf4, 0xbf, 0xb8, 0xff, 0xb0, 0xe9, 0x3d, 0x78, 0xd8, 0xf4, 0xfe, 0x7e,
+	0xd8, 0xf4, 0xbe, 0x7d, 0xd4, 0x9c, 0x79, 0xf0, 0xa8, 0x39, 0xf3, 0xc7, 0xa3, 0xe6, 0xcc, 0xed,
+	0x0f, 0xa6, 0x1a, 0x8f, 0xb2, 0x21, 0x61, 0x39, 0x55, 0xe3, 0xf5, 0x41, 0x4e, 0xd3, 0xa4, 0x37,
+	0xfd, 0xfa, 0xdf, 0x7f, 0xe2, 0xfd, 0x6f, 0x9a, 0x72, 0x50, 0x31, 0x4f, 0xf1, 0x77, 0xfe, 0x1b,
+	0x00, 0xbc, 0x8c, 0xda, 0x09, 0x2b, 0x0c, 0x00, 0x00,
+}
+
+func (m *Query) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozGenesis(x uint64) (n int) {
+
+func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quarantined {
+		i--
+		if m.Quarantined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Failures != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.Bounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Retries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Backoff != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.LastEmission.Size()
+		i -= size
+		if _, err := m.LastEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Ttl != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.LastHeight.Size()
+		i -= size
+		if _, err := m.LastHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Period.Size()
+		i -= size
+		if _, err := m.Period.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Request) > 0 {
+		i -= len(m.Request)
+		copy(dAtA[i:], m.Request)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Request)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.LocalHeight.Size()
+		i -= size
+		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RemoteHeight.Size()
+		i -= size
+		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountyBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Responses != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Responses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondedRelayersUntil != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondedRelayersUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxCallbackFailures != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxCallbackFailures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EvidenceWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EvidenceWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RelayerSlashFraction.Size()
+		i -= size
+		if _, err := m.RelayerSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MinRelayerBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Relayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Relayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Relayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LocalHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LocalHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Datapoints) > 0 {
+		for iNdEx := len(m.Datapoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datapoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ResponseRecords) > 0 {
+		for iNdEx := len(m.ResponseRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResponseRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RelayerPayouts) > 0 {
+		for iNdEx := len(m.RelayerPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BountyBudgets) > 0 {
+		for iNdEx := len(m.BountyBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BountyBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Query) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Period.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LastHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovGenesis(uint64(m.Ttl))
+	}
+	l = m.LastEmission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovGenesis(uint64(m.Deadline))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovGenesis(uint64(m.Backoff))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRetries))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovGenesis(uint64(m.Retries))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.Bounty.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.Failures != 0 {
+		n += 2 + sovGenesis(uint64(m.Failures))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.Quarantined {
+		n += 3
+	}
+	return n
+}
+
+func (m *DataPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RemoteHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LocalHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovGenesis(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *BountyBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelayerPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Responses != 0 {
+		n += 1 + sovGenesis(uint64(m.Responses))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinRelayerBond.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RelayerSlashFraction.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EvidenceWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.EvidenceWindow))
+	}
+	if m.MaxCallbackFailures != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxCallbackFailures))
+	}
+	if m.UnbondedRelayersUntil != 0 {
+		n += 1 + sovGenesis(uint64(m.UnbondedRelayersUntil))
+	}
+	return n
+}
+
+func (m *Relayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ResponseRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Query.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.LocalHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LocalHeight))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BountyBudgets) > 0 {
+		for _, e := range m.BountyBudgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerPayouts) > 0 {
+		for _, e := range m.RelayerPayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Relayers) > 0 {
+		for _, e := range m.Relayers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ResponseRecords) > 0 {
+		for _, e := range m.ResponseRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Datapoints) > 0 {
+		for _, e := range m.Datapoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			m.Backoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backoff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quarantined = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoteHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LocalHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerPayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -580,15 +2645,15 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerPayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerPayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -616,13 +2681,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -632,27 +2697,131 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			m.Responses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Responses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRelayerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRelayerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerSlashFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -680,11 +2849,120 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.RelayerSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceWindow", wireType)
+			}
+			m.EvidenceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackFailures", wireType)
+			}
+			m.MaxCallbackFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondedRelayersUntil", wireType)
+			}
+			m.UnbondedRelayersUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondedRelayersUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Relayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -712,13 +2990,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryType = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -728,31 +3006,101 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
-			if m.Request == nil {
-				m.Request = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -762,29 +3110,28 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -812,15 +3159,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -830,29 +3175,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CallbackId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalHeight", wireType)
 			}
-			m.Ttl = 0
+			m.LocalHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -862,16 +3194,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ttl |= uint64(b&0x7F) << shift
+				m.LocalHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -881,24 +3213,24 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -922,7 +3254,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataPoint) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -945,17 +3277,17 @@ func (m *DataPoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataPoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -965,29 +3297,31 @@ func (m *DataPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Queries = append(m.Queries, Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyBudgets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -997,31 +3331,31 @@ func (m *DataPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoteHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BountyBudgets = append(m.BountyBudgets, BountyBudget{})
+			if err := m.BountyBudgets[len(m.BountyBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerPayouts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1031,31 +3365,31 @@ func (m *DataPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LocalHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RelayerPayouts = append(m.RelayerPayouts, RelayerPayout{})
+			if err := m.RelayerPayouts[len(m.RelayerPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1065,79 +3399,96 @@ func (m *DataPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.Relayers = append(m.Relayers, Relayer{})
+			if err := m.Relayers[len(m.Relayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ResponseRecords = append(m.ResponseRecords, ResponseRecord{})
+			if err := m.ResponseRecords[len(m.ResponseRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1164,8 +3515,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queries = append(m.Queries, Query{})
-			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Datapoints = append(m.Datapoints, DataPoint{})
+			if err := m.Datapoints[len(m.Datapoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
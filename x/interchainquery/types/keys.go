@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "interchainquery"
@@ -16,15 +18,52 @@ const (
 
 // prefix bytes for the interchainquery persistent store
 const (
-	prefixData  = iota + 1
-	prefixQuery = iota + 1
+	prefixData           = iota + 1
+	prefixQuery          = iota + 1
+	prefixBountyBudget   = iota + 1
+	prefixRelayerPayout  = iota + 1
+	prefixRelayer        = iota + 1
+	prefixResponseRecord = iota + 1
+	prefixQuerySchedule  = iota + 1
+	prefixDataExpiry     = iota + 1
+	prefixResponseCount  = iota + 1
+	prefixResponseExpiry = iota + 1
 )
 
 var (
-	KeyPrefixData  = []byte{prefixData}
-	KeyPrefixQuery = []byte{prefixQuery}
+	KeyPrefixData           = []byte{prefixData}
+	KeyPrefixQuery          = []byte{prefixQuery}
+	KeyPrefixBountyBudget   = []byte{prefixBountyBudget}
+	KeyPrefixRelayerPayout  = []byte{prefixRelayerPayout}
+	KeyPrefixRelayer        = []byte{prefixRelayer}
+	KeyPrefixResponseRecord = []byte{prefixResponseRecord}
+	KeyPrefixQuerySchedule  = []byte{prefixQuerySchedule}
+	KeyPrefixDataExpiry     = []byte{prefixDataExpiry}
+	KeyPrefixResponseCount  = []byte{prefixResponseCount}
+	KeyPrefixResponseExpiry = []byte{prefixResponseExpiry}
 )
 
+// GetResponseRecordKey returns the key of the record of a response to a query at a remote height.
+func GetResponseRecordKey(queryID string, height int64) []byte {
+	return append([]byte(queryID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ParseResponseRecordKey returns the query id and remote height of the key of a response record.
+func ParseResponseRecordKey(key []byte) (string, int64) {
+	return string(key[:len(key)-8]), int64(sdk.BigEndianToUint64(key[len(key)-8:]))
+}
+
+// GetHeightIndexKey returns the key of an entry in an index keyed by height, such as the query schedule, the
+// datapoint expiry index or the response record index. Entries are ordered by height, then by id.
+func GetHeightIndexKey(height int64, id string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), []byte(id)...)
+}
+
+// ParseHeightIndexKey returns the height and id of an entry in an index keyed by height.
+func ParseHeightIndexKey(key []byte) (int64, string) {
+	return int64(sdk.BigEndianToUint64(key[:8])), string(key[8:])
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -39,6 +41,10 @@ type MsgSubmitQueryResponse struct {
 	ProofOps    *crypto.ProofOps `protobuf:"bytes,4,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty" yaml:"proof_ops"`
 	Height      int64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
 	FromAddress string           `protobuf:"bytes,6,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// subspace_proof_ops prove the result of a store/<module>/subspace query
+	// complete: one proof for the prefix, and one for the immediate successor
+	// of each returned key.
+	SubspaceProofOps []*crypto.ProofOps `protobuf:"bytes,7,rep,name=subspace_proof_ops,json=subspaceProofOps,proto3" json:"subspace_proof_ops,omitempty" yaml:"subspace_proof_ops"`
 }
 
 func (m *MsgSubmitQueryResponse) Reset()         { *m = MsgSubmitQueryResponse{} }
@@ -112,9 +118,257 @@ func (m *MsgSubmitQueryResponseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitQueryResponseResponse proto.InternalMessageInfo
 
+// MsgBondRelayer represents a message to add to the bond of a registered
+// relayer.
+type MsgBondRelayer struct {
+	Relayer string                                   `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgBondRelayer) Reset()         { *m = MsgBondRelayer{} }
+func (m *MsgBondRelayer) String() string { return proto.CompactTextString(m) }
+func (*MsgBondRelayer) ProtoMessage()    {}
+func (*MsgBondRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{2}
+}
+func (m *MsgBondRelayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondRelayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondRelayer.Merge(m, src)
+}
+func (m *MsgBondRelayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondRelayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondRelayer proto.InternalMessageInfo
+
+// MsgBondRelayerResponse defines the MsgBondRelayer response type.
+type MsgBondRelayerResponse struct {
+}
+
+func (m *MsgBondRelayerResponse) Reset()         { *m = MsgBondRelayerResponse{} }
+func (m *MsgBondRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondRelayerResponse) ProtoMessage()    {}
+func (*MsgBondRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{3}
+}
+func (m *MsgBondRelayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondRelayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondRelayerResponse.Merge(m, src)
+}
+func (m *MsgBondRelayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondRelayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondRelayerResponse proto.InternalMessageInfo
+
+// MsgSubmitResponseEvidence represents a message to submit a proven store
+// value, at the remote height of a response to an unproven query, that
+// contradicts the response.
+type MsgSubmitResponseEvidence struct {
+	QueryId string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty" yaml:"query_id"`
+	// height is the remote height of the contradicted response.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// store is the name of the remote store holding the key.
+	Store string `protobuf:"bytes,3,opt,name=store,proto3" json:"store,omitempty" yaml:"store"`
+	Key   []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty" yaml:"key"`
+	// value is the proven value of the key; empty if the key is proven absent.
+	Value       []byte           `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+	ProofOps    *crypto.ProofOps `protobuf:"bytes,6,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty" yaml:"proof_ops"`
+	FromAddress string           `protobuf:"bytes,7,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgSubmitResponseEvidence) Reset()         { *m = MsgSubmitResponseEvidence{} }
+func (m *MsgSubmitResponseEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitResponseEvidence) ProtoMessage()    {}
+func (*MsgSubmitResponseEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{4}
+}
+func (m *MsgSubmitResponseEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitResponseEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitResponseEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitResponseEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitResponseEvidence.Merge(m, src)
+}
+func (m *MsgSubmitResponseEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitResponseEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitResponseEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitResponseEvidence proto.InternalMessageInfo
+
+// MsgSubmitResponseEvidenceResponse defines the MsgSubmitResponseEvidence
+// response type.
+type MsgSubmitResponseEvidenceResponse struct {
+}
+
+func (m *MsgSubmitResponseEvidenceResponse) Reset()         { *m = MsgSubmitResponseEvidenceResponse{} }
+func (m *MsgSubmitResponseEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitResponseEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitResponseEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{5}
+}
+func (m *MsgSubmitResponseEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitResponseEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitResponseEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitResponseEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitResponseEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitResponseEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitResponseEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitResponseEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitResponseEvidenceResponse proto.InternalMessageInfo
+
+// MsgFundBountyBudget represents a message to add to the bounty budget from
+// which the query bounties of a module are paid.
+type MsgFundBountyBudget struct {
+	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Module      string                                   `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty" yaml:"module"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundBountyBudget) Reset()         { *m = MsgFundBountyBudget{} }
+func (m *MsgFundBountyBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgFundBountyBudget) ProtoMessage()    {}
+func (*MsgFundBountyBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{6}
+}
+func (m *MsgFundBountyBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundBountyBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundBountyBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundBountyBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundBountyBudget.Merge(m, src)
+}
+func (m *MsgFundBountyBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundBountyBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundBountyBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundBountyBudget proto.InternalMessageInfo
+
+// MsgFundBountyBudgetResponse defines the MsgFundBountyBudget response type.
+type MsgFundBountyBudgetResponse struct {
+}
+
+func (m *MsgFundBountyBudgetResponse) Reset()         { *m = MsgFundBountyBudgetResponse{} }
+func (m *MsgFundBountyBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundBountyBudgetResponse) ProtoMessage()    {}
+func (*MsgFundBountyBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{7}
+}
+func (m *MsgFundBountyBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundBountyBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundBountyBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundBountyBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundBountyBudgetResponse.Merge(m, src)
+}
+func (m *MsgFundBountyBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundBountyBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundBountyBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundBountyBudgetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitQueryResponse)(nil), "quicksilver.interchainquery.v1.MsgSubmitQueryResponse")
 	proto.RegisterType((*MsgSubmitQueryResponseResponse)(nil), "quicksilver.interchainquery.v1.MsgSubmitQueryResponseResponse")
+	proto.RegisterType((*MsgBondRelayer)(nil), "quicksilver.interchainquery.v1.MsgBondRelayer")
+	proto.RegisterType((*MsgBondRelayerResponse)(nil), "quicksilver.interchainquery.v1.MsgBondRelayerResponse")
+	proto.RegisterType((*MsgSubmitResponseEvidence)(nil), "quicksilver.interchainquery.v1.MsgSubmitResponseEvidence")
+	proto.RegisterType((*MsgSubmitResponseEvidenceResponse)(nil), "quicksilver.interchainquery.v1.MsgSubmitResponseEvidenceResponse")
+	proto.RegisterType((*MsgFundBountyBudget)(nil), "quicksilver.interchainquery.v1.MsgFundBountyBudget")
+	proto.RegisterType((*MsgFundBountyBudgetResponse)(nil), "quicksilver.interchainquery.v1.MsgFundBountyBudgetResponse")
 }
 
 func init() {
@@ -122,40 +376,65 @@ func init() {
 }
 
 var fileDescriptor_0640fcbc3e895a79 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3f, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc7, 0xeb, 0xf6, 0xf7, 0xeb, 0xdd, 0xe5, 0x8a, 0x80, 0x5c, 0x85, 0x42, 0x81, 0xa4, 0xca,
-	0x42, 0x41, 0xd4, 0x56, 0x8b, 0xc4, 0x50, 0xa4, 0x93, 0xe8, 0x76, 0xc3, 0xf1, 0x27, 0xb7, 0x20,
-	0x96, 0x2a, 0x6d, 0x7c, 0xae, 0x45, 0x63, 0xe7, 0x6c, 0xa7, 0xba, 0xae, 0x4c, 0x8c, 0x48, 0x2c,
-	0x8c, 0x7d, 0x11, 0xbc, 0x01, 0x36, 0xd8, 0x4e, 0xb0, 0x30, 0x55, 0xa8, 0x65, 0x80, 0xb5, 0xaf,
-	0x00, 0xc5, 0x4e, 0xa1, 0xba, 0xab, 0x18, 0x98, 0xfc, 0xe4, 0xf9, 0x7e, 0x9e, 0x7f, 0xf1, 0x63,
-	0xab, 0x79, 0x92, 0xd2, 0xc1, 0x4b, 0x49, 0x47, 0x63, 0x2c, 0x10, 0x65, 0x0a, 0x8b, 0xc1, 0x30,
-	0xa4, 0xec, 0x24, 0xc5, 0x62, 0x82, 0xc6, 0x2d, 0x14, 0x63, 0x29, 0x43, 0x82, 0x25, 0x4c, 0x04,
-	0x57, 0xdc, 0x76, 0xd7, 0x70, 0x78, 0x0e, 0x87, 0xe3, 0x56, 0xad, 0x4a, 0x38, 0xe1, 0x1a, 0x45,
-	0x99, 0x65, 0xa2, 0x6a, 0xd7, 0x07, 0x5c, 0xc6, 0x5c, 0xf6, 0x8c, 0x60, 0x3e, 0x72, 0xe9, 0x26,
-	0xe1, 0x9c, 0x8c, 0x30, 0x0a, 0x13, 0x8a, 0x42, 0xc6, 0xb8, 0x0a, 0x15, 0xe5, 0x6c, 0xa5, 0xde,
-	0x52, 0x98, 0x45, 0x58, 0xc4, 0x94, 0x29, 0x34, 0x10, 0x93, 0x44, 0x71, 0x94, 0x08, 0xce, 0x8f,
-	0x8d, 0xec, 0xff, 0x2c, 0x5a, 0xd7, 0x0e, 0x25, 0x39, 0x4a, 0xfb, 0x31, 0x55, 0xcf, 0xb2, 0x1e,
-	0x02, 0x2c, 0x13, 0xce, 0x24, 0xb6, 0xa1, 0xb5, 0xad, 0x3b, 0xeb, 0xd1, 0xc8, 0x01, 0x75, 0xd0,
-	0xd8, 0xe9, 0xee, 0x2d, 0x67, 0xde, 0xe5, 0x49, 0x18, 0x8f, 0x3a, 0xfe, 0x4a, 0xf1, 0x83, 0x2d,
-	0x6d, 0x1e, 0x44, 0x19, 0xaf, 0x87, 0xc8, 0xf8, 0xe2, 0x79, 0x7e, 0xa5, 0xf8, 0xc1, 0x96, 0x36,
-	0x0f, 0x22, 0xfb, 0x8e, 0x55, 0x16, 0x58, 0xa6, 0x23, 0xe5, 0x94, 0xea, 0xa0, 0x51, 0xe9, 0x5e,
-	0x5d, 0xce, 0xbc, 0x4b, 0x86, 0x36, 0x7e, 0x3f, 0xc8, 0x01, 0xfb, 0xb1, 0xb5, 0xa3, 0x9b, 0xee,
-	0xf1, 0x44, 0x3a, 0xff, 0xd5, 0x41, 0x63, 0xb7, 0x7d, 0x03, 0xfe, 0x19, 0x0c, 0x9a, 0xc1, 0xe0,
-	0xd3, 0x8c, 0x79, 0x92, 0xc8, 0x6e, 0x75, 0x39, 0xf3, 0xae, 0x98, 0x54, 0xbf, 0xe3, 0xfc, 0x60,
-	0x3b, 0xc9, 0xf5, 0xac, 0xf4, 0x10, 0x53, 0x32, 0x54, 0xce, 0xff, 0x75, 0xd0, 0x28, 0xad, 0x97,
-	0x36, 0x7e, 0x3f, 0xc8, 0x01, 0xfb, 0xa1, 0x55, 0x39, 0x16, 0x3c, 0xee, 0x85, 0x51, 0x24, 0xb0,
-	0x94, 0x4e, 0x59, 0x4f, 0xe6, 0x7c, 0x7e, 0xdf, 0xac, 0xe6, 0xb7, 0xf0, 0xc8, 0x28, 0x47, 0x4a,
-	0x50, 0x46, 0x82, 0xdd, 0x8c, 0xce, 0x5d, 0x9d, 0xca, 0xeb, 0xa9, 0x57, 0x78, 0x37, 0xf5, 0xc0,
-	0x8f, 0xa9, 0x57, 0xf0, 0xeb, 0x96, 0xbb, 0xf9, 0x57, 0xaf, 0xce, 0xf6, 0x27, 0x60, 0x95, 0x0e,
-	0x25, 0xb1, 0x3f, 0x00, 0x6b, 0x6f, 0xd3, 0x95, 0x3c, 0x80, 0x7f, 0x5f, 0x1e, 0xb8, 0x39, 0x7f,
-	0x6d, 0xff, 0xdf, 0xe2, 0x56, 0xa7, 0xdf, 0x7e, 0xf5, 0xe5, 0xfb, 0xdb, 0xe2, 0x3d, 0xff, 0xf6,
-	0x85, 0xfd, 0x56, 0xa7, 0x68, 0xdc, 0xea, 0x63, 0x15, 0xb6, 0x90, 0xd4, 0x09, 0xb4, 0xbb, 0x03,
-	0xee, 0x76, 0x9f, 0x7f, 0x9c, 0xbb, 0xe0, 0x6c, 0xee, 0x82, 0x6f, 0x73, 0x17, 0xbc, 0x59, 0xb8,
-	0x85, 0xb3, 0x85, 0x5b, 0xf8, 0xba, 0x70, 0x0b, 0x2f, 0xf6, 0x09, 0x55, 0xc3, 0xb4, 0x0f, 0x07,
-	0x3c, 0x46, 0x94, 0x11, 0xcc, 0x52, 0xaa, 0x26, 0xcd, 0x7e, 0x4a, 0x47, 0x11, 0x5a, 0x7f, 0x4b,
-	0xa7, 0x17, 0xab, 0x4d, 0x12, 0x2c, 0xfb, 0x65, 0xbd, 0xba, 0xf7, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x04, 0x25, 0xca, 0x43, 0x79, 0x03, 0x00, 0x00,
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xd8, 0xd4, 0x4e, 0x26, 0xa6, 0x84, 0x4d, 0x14, 0x6d, 0x5c, 0xb2, 0x6b, 0x16, 0x09,
+	0xdc, 0xaa, 0xd9, 0xc5, 0xa9, 0x28, 0x22, 0x95, 0x2a, 0x75, 0x11, 0x48, 0x3d, 0x84, 0x8f, 0xed,
+	0x05, 0x71, 0xb1, 0xf6, 0x63, 0xb2, 0x1e, 0xc5, 0x3b, 0xb3, 0xdd, 0x99, 0xb5, 0xba, 0x57, 0x24,
+	0x24, 0x8e, 0x48, 0x5c, 0x38, 0xe6, 0x0a, 0x57, 0xb8, 0x72, 0xe0, 0xd6, 0x0b, 0x52, 0x45, 0x2f,
+	0x9c, 0x0c, 0x4a, 0x38, 0x70, 0xe0, 0xe4, 0x7f, 0x00, 0xb4, 0x33, 0xbb, 0xee, 0xc6, 0x4e, 0x9b,
+	0x0f, 0x24, 0x4e, 0x1e, 0xbf, 0xf7, 0x7b, 0x6f, 0xde, 0x6f, 0xde, 0xef, 0xcd, 0x2c, 0xdc, 0x7e,
+	0x98, 0x62, 0xff, 0x80, 0xe1, 0xd1, 0x18, 0x25, 0x16, 0x26, 0x1c, 0x25, 0xfe, 0xd0, 0xc5, 0xe4,
+	0x61, 0x8a, 0x92, 0xcc, 0x1a, 0xf7, 0xad, 0x08, 0x31, 0xe6, 0x86, 0x88, 0x99, 0x71, 0x42, 0x39,
+	0x55, 0xb4, 0x0a, 0xdc, 0x9c, 0x83, 0x9b, 0xe3, 0x7e, 0x67, 0x3d, 0xa4, 0x21, 0x15, 0x50, 0x2b,
+	0x5f, 0xc9, 0xa8, 0xce, 0xa6, 0x4f, 0x59, 0x44, 0xd9, 0x40, 0x3a, 0xe4, 0x9f, 0xc2, 0xf5, 0x5a,
+	0x48, 0x69, 0x38, 0x42, 0x96, 0x1b, 0x63, 0xcb, 0x25, 0x84, 0x72, 0x97, 0x63, 0x4a, 0x4a, 0xef,
+	0x16, 0x47, 0x24, 0x40, 0x49, 0x84, 0x09, 0xb7, 0xfc, 0x24, 0x8b, 0x39, 0xb5, 0xe2, 0x84, 0xd2,
+	0xfd, 0xc2, 0xad, 0xc9, 0x54, 0x96, 0xe7, 0x32, 0x64, 0x8d, 0xfb, 0x1e, 0xe2, 0x6e, 0xdf, 0xf2,
+	0x29, 0x26, 0xd2, 0x6f, 0x3c, 0x6d, 0xc0, 0x8d, 0x3d, 0x16, 0x3e, 0x48, 0xbd, 0x08, 0xf3, 0x4f,
+	0xf3, 0x1a, 0x1d, 0xc4, 0x62, 0x4a, 0x18, 0x52, 0x4c, 0xb8, 0x24, 0x2a, 0x1f, 0xe0, 0x40, 0x05,
+	0x5d, 0xd0, 0x5b, 0xb6, 0xd7, 0xa6, 0x13, 0xfd, 0x95, 0xcc, 0x8d, 0x46, 0xbb, 0x46, 0xe9, 0x31,
+	0x9c, 0x96, 0x58, 0xde, 0x0f, 0x72, 0xbc, 0x20, 0x99, 0xe3, 0xeb, 0xf3, 0xf8, 0xd2, 0x63, 0x38,
+	0x2d, 0xb1, 0xbc, 0x1f, 0x28, 0xd7, 0x61, 0x33, 0x41, 0x2c, 0x1d, 0x71, 0xb5, 0xd1, 0x05, 0xbd,
+	0xb6, 0xfd, 0xea, 0x74, 0xa2, 0xbf, 0x2c, 0xd1, 0xd2, 0x6e, 0x38, 0x05, 0x40, 0xf9, 0x08, 0x2e,
+	0x0b, 0x52, 0x03, 0x1a, 0x33, 0xf5, 0xa5, 0x2e, 0xe8, 0xad, 0xec, 0x5c, 0x33, 0x9f, 0x11, 0x37,
+	0x25, 0x71, 0xf3, 0x93, 0x1c, 0xf3, 0x71, 0xcc, 0xec, 0xf5, 0xe9, 0x44, 0x5f, 0x95, 0xa9, 0x66,
+	0x71, 0x86, 0xb3, 0x14, 0x17, 0xfe, 0x7c, 0xeb, 0x21, 0xc2, 0xe1, 0x90, 0xab, 0x57, 0xba, 0xa0,
+	0xd7, 0xa8, 0x6e, 0x2d, 0xed, 0x86, 0x53, 0x00, 0x94, 0x3b, 0xb0, 0xbd, 0x9f, 0xd0, 0x68, 0xe0,
+	0x06, 0x41, 0x82, 0x18, 0x53, 0x9b, 0x82, 0x99, 0xfa, 0xeb, 0x8f, 0xdb, 0xeb, 0x45, 0x97, 0xee,
+	0x49, 0xcf, 0x03, 0x9e, 0x60, 0x12, 0x3a, 0x2b, 0x39, 0xba, 0x30, 0x29, 0x43, 0xa8, 0xb0, 0xd4,
+	0x63, 0xb1, 0xeb, 0xa3, 0xc1, 0x33, 0x02, 0xad, 0x6e, 0xe3, 0x2c, 0x02, 0x5b, 0xd3, 0x89, 0xbe,
+	0x29, 0x0b, 0x5a, 0x4c, 0x60, 0x38, 0xab, 0xa5, 0xb1, 0x0c, 0xd8, 0x6d, 0x7f, 0x75, 0xa8, 0xd7,
+	0xbe, 0x3d, 0xd4, 0xc1, 0x5f, 0x87, 0x7a, 0xcd, 0xe8, 0x42, 0xed, 0xf4, 0xa6, 0x96, 0xbf, 0xc6,
+	0x0f, 0x00, 0x5e, 0xdd, 0x63, 0xa1, 0x4d, 0x49, 0xe0, 0xa0, 0x91, 0x9b, 0xa1, 0x44, 0xd9, 0x81,
+	0xad, 0x44, 0x2e, 0x55, 0x70, 0x06, 0xc9, 0x12, 0xa8, 0xf8, 0xb0, 0xe9, 0x46, 0x34, 0x25, 0x5c,
+	0xad, 0x0b, 0x52, 0x9b, 0x66, 0x81, 0xcf, 0xf5, 0x66, 0x16, 0x7a, 0x33, 0xdf, 0xa7, 0x98, 0xd8,
+	0x6f, 0x3f, 0x9e, 0xe8, 0xb5, 0xef, 0x7f, 0xd7, 0x7b, 0x21, 0xe6, 0xc3, 0xd4, 0x33, 0x7d, 0x1a,
+	0x15, 0x3a, 0x2f, 0x7e, 0xb6, 0x59, 0x70, 0x60, 0xf1, 0x2c, 0x46, 0x4c, 0x04, 0x30, 0xa7, 0x48,
+	0xbd, 0xbb, 0x94, 0x73, 0x13, 0xbc, 0x54, 0xb8, 0x71, 0xb2, 0xe8, 0x19, 0x9f, 0x7f, 0xea, 0x70,
+	0x73, 0x46, 0xb9, 0xb4, 0x7e, 0x30, 0xc6, 0x01, 0x22, 0x3e, 0x3a, 0x21, 0x4d, 0x70, 0x3e, 0x69,
+	0x16, 0xfa, 0xa8, 0x9f, 0xa5, 0x8f, 0x37, 0xe1, 0x15, 0xc6, 0x69, 0x82, 0x84, 0x88, 0x97, 0xed,
+	0xd5, 0xe9, 0x44, 0x6f, 0x17, 0x8d, 0xcb, 0xcd, 0x86, 0x23, 0xdd, 0x4a, 0x17, 0x36, 0x0e, 0x50,
+	0x26, 0xc4, 0xdb, 0xb6, 0xaf, 0x4e, 0x27, 0x3a, 0x94, 0xa8, 0x03, 0x94, 0x19, 0x4e, 0xee, 0xca,
+	0x33, 0x8d, 0xdd, 0x51, 0x8a, 0x84, 0x26, 0xdb, 0xd5, 0x4c, 0xc2, 0x6c, 0x38, 0xd2, 0x7d, 0x72,
+	0x18, 0x9a, 0xff, 0x7d, 0x18, 0xe6, 0x15, 0xde, 0xba, 0x80, 0xc2, 0x2b, 0xbd, 0x79, 0x03, 0xbe,
+	0xfe, 0xdc, 0x06, 0xcc, 0xda, 0xf4, 0x37, 0x80, 0x6b, 0x7b, 0x2c, 0xfc, 0x30, 0x25, 0x81, 0x9d,
+	0xf7, 0x36, 0xb3, 0xd3, 0x20, 0x44, 0x8b, 0x53, 0x06, 0x2e, 0x32, 0x65, 0xd7, 0x61, 0x33, 0xa2,
+	0x41, 0x3a, 0x42, 0xc5, 0xb5, 0x53, 0xe9, 0x96, 0xb4, 0x1b, 0x4e, 0x01, 0xa8, 0xe8, 0xb5, 0xf1,
+	0x7f, 0xe8, 0x75, 0x0b, 0x5e, 0x3b, 0x85, 0x6d, 0x79, 0x1a, 0x3b, 0x5f, 0x36, 0x61, 0x63, 0x8f,
+	0x85, 0xca, 0xcf, 0x00, 0xae, 0x9d, 0x76, 0x03, 0xdf, 0x36, 0x5f, 0xfc, 0x96, 0x98, 0xa7, 0x0f,
+	0x79, 0xe7, 0xee, 0xe5, 0xe2, 0x66, 0x5d, 0xda, 0xf9, 0xe2, 0xe9, 0x9f, 0xdf, 0xd4, 0x6f, 0xee,
+	0x82, 0x1b, 0xc6, 0x5b, 0x0b, 0x2f, 0x1e, 0x7f, 0x34, 0x7b, 0x47, 0x98, 0xc8, 0x21, 0xcc, 0xca,
+	0x77, 0x00, 0xae, 0x54, 0x6f, 0x13, 0xf3, 0x1c, 0x35, 0x54, 0xf0, 0x9d, 0xdb, 0x17, 0xc3, 0x5f,
+	0xb8, 0x56, 0x8f, 0x92, 0xa0, 0xbc, 0xb5, 0x7e, 0x01, 0x70, 0xe3, 0x39, 0x37, 0xc5, 0x7b, 0xe7,
+	0x3e, 0xba, 0xf9, 0xd0, 0xce, 0xbd, 0x4b, 0x87, 0xce, 0xc8, 0xbc, 0x23, 0xc8, 0x58, 0x39, 0x99,
+	0x1b, 0x67, 0x1f, 0x3c, 0x2a, 0x8b, 0xfe, 0x09, 0xc0, 0xd5, 0x85, 0x91, 0xba, 0x75, 0x8e, 0x72,
+	0xe6, 0x83, 0x3a, 0x77, 0x2e, 0x11, 0x34, 0xab, 0xfe, 0x5d, 0x51, 0x7d, 0x3f, 0xaf, 0xfe, 0xe6,
+	0x8b, 0xaa, 0xdf, 0x4f, 0x49, 0xe0, 0x89, 0x04, 0x9e, 0x48, 0x60, 0x7f, 0xf6, 0xf8, 0x48, 0x03,
+	0x4f, 0x8e, 0x34, 0xf0, 0xc7, 0x91, 0x06, 0xbe, 0x3e, 0xd6, 0x6a, 0x4f, 0x8e, 0xb5, 0xda, 0x6f,
+	0xc7, 0x5a, 0xed, 0xf3, 0xbb, 0x95, 0xe1, 0xc3, 0x24, 0x44, 0x24, 0xc5, 0x3c, 0xdb, 0xf6, 0x52,
+	0x3c, 0x0a, 0xac, 0xea, 0x67, 0xd9, 0xa3, 0xc5, 0xfd, 0xf2, 0xc1, 0xf4, 0x9a, 0xe2, 0x2b, 0xe7,
+	0xd6, 0xbf, 0x03, 0x00, 0xd2, 0x93, 0x6b, 0xd9, 0xc4, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +451,13 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryResponse, opts ...grpc.CallOption) (*MsgSubmitQueryResponseResponse, error)
+	// BondRelayer adds to the bond of a registered relayer.
+	BondRelayer(ctx context.Context, in *MsgBondRelayer, opts ...grpc.CallOption) (*MsgBondRelayerResponse, error)
+	// SubmitResponseEvidence submits proven state contradicting a response to an
+	// unproven query.
+	SubmitResponseEvidence(ctx context.Context, in *MsgSubmitResponseEvidence, opts ...grpc.CallOption) (*MsgSubmitResponseEvidenceResponse, error)
+	// FundBountyBudget adds to the bounty budget of a module.
+	FundBountyBudget(ctx context.Context, in *MsgFundBountyBudget, opts ...grpc.CallOption) (*MsgFundBountyBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -191,10 +477,44 @@ func (c *msgClient) SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryR
 	return out, nil
 }
 
+func (c *msgClient) BondRelayer(ctx context.Context, in *MsgBondRelayer, opts ...grpc.CallOption) (*MsgBondRelayerResponse, error) {
+	out := new(MsgBondRelayerResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.Msg/BondRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitResponseEvidence(ctx context.Context, in *MsgSubmitResponseEvidence, opts ...grpc.CallOption) (*MsgSubmitResponseEvidenceResponse, error) {
+	out := new(MsgSubmitResponseEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.Msg/SubmitResponseEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundBountyBudget(ctx context.Context, in *MsgFundBountyBudget, opts ...grpc.CallOption) (*MsgFundBountyBudgetResponse, error) {
+	out := new(MsgFundBountyBudgetResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.Msg/FundBountyBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(context.Context, *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error)
+	// BondRelayer adds to the bond of a registered relayer.
+	BondRelayer(context.Context, *MsgBondRelayer) (*MsgBondRelayerResponse, error)
+	// SubmitResponseEvidence submits proven state contradicting a response to an
+	// unproven query.
+	SubmitResponseEvidence(context.Context, *MsgSubmitResponseEvidence) (*MsgSubmitResponseEvidenceResponse, error)
+	// FundBountyBudget adds to the bounty budget of a module.
+	FundBountyBudget(context.Context, *MsgFundBountyBudget) (*MsgFundBountyBudgetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -204,6 +524,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitQueryResponse(ctx context.Context, req *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResponse not implemented")
 }
+func (*UnimplementedMsgServer) BondRelayer(ctx context.Context, req *MsgBondRelayer) (*MsgBondRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondRelayer not implemented")
+}
+func (*UnimplementedMsgServer) SubmitResponseEvidence(ctx context.Context, req *MsgSubmitResponseEvidence) (*MsgSubmitResponseEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitResponseEvidence not implemented")
+}
+func (*UnimplementedMsgServer) FundBountyBudget(ctx context.Context, req *MsgFundBountyBudget) (*MsgFundBountyBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundBountyBudget not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -227,6 +556,60 @@ func _Msg_SubmitQueryResponse_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BondRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.Msg/BondRelayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondRelayer(ctx, req.(*MsgBondRelayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitResponseEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitResponseEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitResponseEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.Msg/SubmitResponseEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitResponseEvidence(ctx, req.(*MsgSubmitResponseEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundBountyBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundBountyBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundBountyBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.Msg/FundBountyBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundBountyBudget(ctx, req.(*MsgFundBountyBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.interchainquery.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -235,6 +618,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitQueryResponse",
 			Handler:    _Msg_SubmitQueryResponse_Handler,
 		},
+		{
+			MethodName: "BondRelayer",
+			Handler:    _Msg_BondRelayer_Handler,
+		},
+		{
+			MethodName: "SubmitResponseEvidence",
+			Handler:    _Msg_SubmitResponseEvidence_Handler,
+		},
+		{
+			MethodName: "FundBountyBudget",
+			Handler:    _Msg_FundBountyBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/interchainquery/v1/messages.proto",
@@ -260,6 +655,20 @@ func (m *MsgSubmitQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SubspaceProofOps) > 0 {
+		for iNdEx := len(m.SubspaceProofOps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubspaceProofOps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.FromAddress) > 0 {
 		i -= len(m.FromAddress)
 		copy(dAtA[i:], m.FromAddress)
@@ -331,65 +740,900 @@ func (m *MsgSubmitQueryResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgBondRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitQueryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgBondRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	l = len(m.QueryId)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	if m.ProofOps != nil {
-		l = m.ProofOps.Size()
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovMessages(uint64(m.Height))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitQueryResponseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MsgBondRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitResponseEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitResponseEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitResponseEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ProofOps != nil {
+		{
+			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitResponseEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitResponseEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitResponseEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundBountyBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundBountyBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundBountyBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundBountyBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundBountyBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundBountyBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessages(uint64(m.Height))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.SubspaceProofOps) > 0 {
+		for _, e := range m.SubspaceProofOps {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovMessages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSubmitQueryResponseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgBondRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBondRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitResponseEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessages(uint64(m.Height))
+	}
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitResponseEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundBountyBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundBountyBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofOps == nil {
+				m.ProofOps = &crypto.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubspaceProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubspaceProofOps = append(m.SubspaceProofOps, &crypto.ProofOps{})
+			if err := m.SubspaceProofOps[len(m.SubspaceProofOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitResponseEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -412,15 +1656,15 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitResponseEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitResponseEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -448,11 +1692,30 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.QueryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -480,11 +1743,11 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryId = string(dAtA[iNdEx:postIndex])
+			m.Store = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -511,12 +1774,46 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
 			}
@@ -552,11 +1849,11 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -566,12 +1863,125 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitResponseEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitResponseEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitResponseEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundBountyBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundBountyBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundBountyBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
@@ -603,6 +2013,72 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -624,7 +2100,7 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundBountyBudgetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -647,10 +2123,10 @@ func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundBountyBudgetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundBountyBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9,10 +9,18 @@ import (
 
 // interchainquery message types
 const (
-	TypeMsgSubmitQueryResponse = "submitqueryresponse"
+	TypeMsgSubmitQueryResponse    = "submitqueryresponse"
+	TypeMsgBondRelayer            = "bondrelayer"
+	TypeMsgSubmitResponseEvidence = "submitresponseevidence"
+	TypeMsgFundBountyBudget       = "fundbountybudget"
 )
 
-var _ sdk.Msg = &MsgSubmitQueryResponse{}
+var (
+	_ sdk.Msg = &MsgSubmitQueryResponse{}
+	_ sdk.Msg = &MsgBondRelayer{}
+	_ sdk.Msg = &MsgSubmitResponseEvidence{}
+	_ sdk.Msg = &MsgFundBountyBudget{}
+)
 
 // Route Implements Msg.
 func (msg MsgSubmitQueryResponse) Route() string { return RouterKey }
@@ -54,3 +62,109 @@ func (msg MsgSubmitQueryResponse) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
 	return []sdk.AccAddress{fromAddress}
 }
+
+// NewMsgBondRelayer - construct a msg to add to the bond of a registered relayer.
+func NewMsgBondRelayer(relayer sdk.AccAddress, amount sdk.Coins) *MsgBondRelayer {
+	return &MsgBondRelayer{Relayer: relayer.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBondRelayer) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBondRelayer) Type() string { return TypeMsgBondRelayer }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBondRelayer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return err
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return fmt.Errorf("invalid bond amount: %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBondRelayer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBondRelayer) GetSigners() []sdk.AccAddress {
+	relayer, _ := sdk.AccAddressFromBech32(msg.Relayer)
+	return []sdk.AccAddress{relayer}
+}
+
+// Route Implements Msg.
+func (msg MsgSubmitResponseEvidence) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSubmitResponseEvidence) Type() string { return TypeMsgSubmitResponseEvidence }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSubmitResponseEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return err
+	}
+	if len(msg.QueryId) != 64 {
+		return fmt.Errorf("invalid query id")
+	}
+	if msg.Height <= 0 {
+		return fmt.Errorf("height must be positive")
+	}
+	if msg.Store == "" || len(msg.Key) == 0 {
+		return fmt.Errorf("store and key must be specified")
+	}
+	if msg.ProofOps == nil {
+		return fmt.Errorf("proof must be specified")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSubmitResponseEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSubmitResponseEvidence) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// NewMsgFundBountyBudget - construct a msg to add to the bounty budget of a module.
+func NewMsgFundBountyBudget(from sdk.AccAddress, module string, amount sdk.Coins) *MsgFundBountyBudget {
+	return &MsgFundBountyBudget{FromAddress: from.String(), Module: module, Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgFundBountyBudget) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgFundBountyBudget) Type() string { return TypeMsgFundBountyBudget }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFundBountyBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return err
+	}
+	if msg.Module == "" {
+		return fmt.Errorf("module must be specified")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return fmt.Errorf("invalid budget amount: %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFundBountyBudget) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFundBountyBudget) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
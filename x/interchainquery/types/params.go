@@ -0,0 +1,119 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	KeyMinRelayerBond        = []byte("MinRelayerBond")
+	KeyRelayerSlashFraction  = []byte("RelayerSlashFraction")
+	KeyEvidenceWindow        = []byte("EvidenceWindow")
+	KeyMaxCallbackFailures   = []byte("MaxCallbackFailures")
+	KeyUnbondedRelayersUntil = []byte("UnbondedRelayersUntil")
+
+	DefaultMinRelayerBond               = sdk.NewInt64Coin("uqck", 1_000_000_000)
+	DefaultRelayerSlashFraction         = sdk.NewDecWithPrec(5, 1)
+	DefaultEvidenceWindow        uint64 = 3600
+	DefaultMaxCallbackFailures   uint32 = 5
+	DefaultUnbondedRelayersUntil int64  = 0
+)
+
+// ParamKeyTable for interchainquery module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new interchainquery Params instance
+func NewParams(minRelayerBond sdk.Coin, relayerSlashFraction sdk.Dec, evidenceWindow uint64, maxCallbackFailures uint32, unbondedRelayersUntil int64) Params {
+	return Params{
+		MinRelayerBond:        minRelayerBond,
+		RelayerSlashFraction:  relayerSlashFraction,
+		EvidenceWindow:        evidenceWindow,
+		MaxCallbackFailures:   maxCallbackFailures,
+		UnbondedRelayersUntil: unbondedRelayersUntil,
+	}
+}
+
+// DefaultParams default interchainquery params
+func DefaultParams() Params {
+	return NewParams(DefaultMinRelayerBond, DefaultRelayerSlashFraction, DefaultEvidenceWindow, DefaultMaxCallbackFailures, DefaultUnbondedRelayersUntil)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinRelayerBond, &p.MinRelayerBond, validateMinRelayerBond),
+		paramtypes.NewParamSetPair(KeyRelayerSlashFraction, &p.RelayerSlashFraction, validateRelayerSlashFraction),
+		paramtypes.NewParamSetPair(KeyEvidenceWindow, &p.EvidenceWindow, validateEvidenceWindow),
+		paramtypes.NewParamSetPair(KeyMaxCallbackFailures, &p.MaxCallbackFailures, validateMaxCallbackFailures),
+		paramtypes.NewParamSetPair(KeyUnbondedRelayersUntil, &p.UnbondedRelayersUntil, validateUnbondedRelayersUntil),
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateMinRelayerBond(p.MinRelayerBond); err != nil {
+		return err
+	}
+	if err := validateRelayerSlashFraction(p.RelayerSlashFraction); err != nil {
+		return err
+	}
+	if err := validateEvidenceWindow(p.EvidenceWindow); err != nil {
+		return err
+	}
+	if err := validateMaxCallbackFailures(p.MaxCallbackFailures); err != nil {
+		return err
+	}
+	return validateUnbondedRelayersUntil(p.UnbondedRelayersUntil)
+}
+
+func validateMinRelayerBond(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateRelayerSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("relayer slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateEvidenceWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("evidence window must be positive")
+	}
+	return nil
+}
+
+func validateMaxCallbackFailures(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUnbondedRelayersUntil(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("unbonded relayers until height must be non-negative: %d", v)
+	}
+	return nil
+}
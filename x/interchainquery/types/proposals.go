@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeRegisterRelayer   = "RegisterRelayer"
+	ProposalTypeDeregisterRelayer = "DeregisterRelayer"
+)
+
+var (
+	_ govtypes.Content = &RegisterRelayerProposal{}
+	_ govtypes.Content = &DeregisterRelayerProposal{}
+)
+
+func NewRegisterRelayerProposal(title string, description string, relayer string) *RegisterRelayerProposal {
+	return &RegisterRelayerProposal{Title: title, Description: description, Relayer: relayer}
+}
+
+func (m RegisterRelayerProposal) GetDescription() string { return m.Description }
+func (m RegisterRelayerProposal) GetTitle() string       { return m.Title }
+func (m RegisterRelayerProposal) ProposalRoute() string  { return RouterKey }
+func (m RegisterRelayerProposal) ProposalType() string   { return ProposalTypeRegisterRelayer }
+
+// ValidateBasic runs basic stateless validity checks
+func (m RegisterRelayerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(m.Relayer)
+	return err
+}
+
+// String implements the Stringer interface.
+func (m RegisterRelayerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Interchain Query Relayer Registration Proposal:
+  Title:       %s
+  Description: %s
+  Relayer:     %s
+`, m.Title, m.Description, m.Relayer))
+	return b.String()
+}
+
+func NewDeregisterRelayerProposal(title string, description string, relayer string) *DeregisterRelayerProposal {
+	return &DeregisterRelayerProposal{Title: title, Description: description, Relayer: relayer}
+}
+
+func (m DeregisterRelayerProposal) GetDescription() string { return m.Description }
+func (m DeregisterRelayerProposal) GetTitle() string       { return m.Title }
+func (m DeregisterRelayerProposal) ProposalRoute() string  { return RouterKey }
+func (m DeregisterRelayerProposal) ProposalType() string   { return ProposalTypeDeregisterRelayer }
+
+// ValidateBasic runs basic stateless validity checks
+func (m DeregisterRelayerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(m.Relayer)
+	return err
+}
+
+// String implements the Stringer interface.
+func (m DeregisterRelayerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Interchain Query Relayer Deregistration Proposal:
+  Title:       %s
+  Description: %s
+  Relayer:     %s
+`, m.Title, m.Description, m.Relayer))
+	return b.String()
+}
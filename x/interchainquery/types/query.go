@@ -0,0 +1,10 @@
+package types
+
+// IsPending returns true if the query is due to be emitted, or has been emitted and not answered since. A
+// quarantined query is never pending.
+func (q Query) IsPending() bool {
+	if q.Quarantined {
+		return false
+	}
+	return q.LastEmission.IsNil() || q.LastEmission.IsZero() || q.LastEmission.GTE(q.LastHeight)
+}
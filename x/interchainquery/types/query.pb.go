@@ -38,6 +38,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryRequestsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// include_quarantined includes quarantined queries, which are otherwise
+	// omitted as they are no longer emitted.
+	IncludeQuarantined bool `protobuf:"varint,3,opt,name=include_quarantined,json=includeQuarantined,proto3" json:"include_quarantined,omitempty"`
+	// module optionally restricts the queries to those owned by a module.
+	Module string `protobuf:"bytes,4,opt,name=module,proto3" json:"module,omitempty"`
+	// callback_id optionally restricts the queries to those with a callback.
+	CallbackId string `protobuf:"bytes,5,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	// query_type optionally restricts the queries to those of a type.
+	QueryType string `protobuf:"bytes,6,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	// include_answered includes queries that have been answered since they were
+	// last emitted, which are otherwise omitted as they await no response.
+	IncludeAnswered bool `protobuf:"varint,7,opt,name=include_answered,json=includeAnswered,proto3" json:"include_answered,omitempty"`
 }
 
 func (m *QueryRequestsRequest) Reset()         { *m = QueryRequestsRequest{} }
@@ -87,6 +99,41 @@ func (m *QueryRequestsRequest) GetChainId() string {
 	return ""
 }
 
+func (m *QueryRequestsRequest) GetIncludeQuarantined() bool {
+	if m != nil {
+		return m.IncludeQuarantined
+	}
+	return false
+}
+
+func (m *QueryRequestsRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QueryRequestsRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryRequestsRequest) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
+	}
+	return ""
+}
+
+func (m *QueryRequestsRequest) GetIncludeAnswered() bool {
+	if m != nil {
+		return m.IncludeAnswered
+	}
+	return false
+}
+
 // QueryParamsResponse is the response type for the Query/Params RPC method.
 type QueryRequestsResponse struct {
 	// params defines the parameters of the module.
@@ -141,30 +188,25 @@ func (m *QueryRequestsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// GetTxResponse is the response type for the Service.GetTx method.
-type GetTxWithProofResponse struct {
-	// tx is the queried transaction.
-	Tx *tx.Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// tx_response is the queried TxResponses.
-	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
-	// proof is the tmproto.TxProof for the queried tx
-	Proof *types1.TxProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
-	// ibc-go header to validate txs
-	Header *types2.Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+// QueryBountiesRequest is the request type for the Query/Bounties RPC method.
+type QueryBountiesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// chain_id optionally restricts the bounties to queries of a single chain.
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *GetTxWithProofResponse) Reset()         { *m = GetTxWithProofResponse{} }
-func (m *GetTxWithProofResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTxWithProofResponse) ProtoMessage()    {}
-func (*GetTxWithProofResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBountiesRequest) Reset()         { *m = QueryBountiesRequest{} }
+func (m *QueryBountiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBountiesRequest) ProtoMessage()    {}
+func (*QueryBountiesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e4aadfdae61bcbb1, []int{2}
 }
-func (m *GetTxWithProofResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBountiesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBountiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTxWithProofResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBountiesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -174,419 +216,3964 @@ func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetTxWithProofResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTxWithProofResponse.Merge(m, src)
+func (m *QueryBountiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBountiesRequest.Merge(m, src)
 }
-func (m *GetTxWithProofResponse) XXX_Size() int {
+func (m *QueryBountiesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTxWithProofResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTxWithProofResponse.DiscardUnknown(m)
+func (m *QueryBountiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBountiesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTxWithProofResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBountiesRequest proto.InternalMessageInfo
 
-func (m *GetTxWithProofResponse) GetTx() *tx.Tx {
+func (m *QueryBountiesRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Tx
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *GetTxWithProofResponse) GetTxResponse() *types.TxResponse {
+func (m *QueryBountiesRequest) GetChainId() string {
 	if m != nil {
-		return m.TxResponse
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetTxWithProofResponse) GetProof() *types1.TxProof {
+// QueryBountiesResponse is the response type for the Query/Bounties RPC
+// method.
+type QueryBountiesResponse struct {
+	Queries    []Query             `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBountiesResponse) Reset()         { *m = QueryBountiesResponse{} }
+func (m *QueryBountiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBountiesResponse) ProtoMessage()    {}
+func (*QueryBountiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{3}
+}
+func (m *QueryBountiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBountiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBountiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBountiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBountiesResponse.Merge(m, src)
+}
+func (m *QueryBountiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBountiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBountiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBountiesResponse proto.InternalMessageInfo
+
+func (m *QueryBountiesResponse) GetQueries() []Query {
 	if m != nil {
-		return m.Proof
+		return m.Queries
 	}
 	return nil
 }
 
-func (m *GetTxWithProofResponse) GetHeader() *types2.Header {
+func (m *QueryBountiesResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Header
+		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryRequestsRequest)(nil), "quicksilver.interchainquery.v1.QueryRequestsRequest")
-	proto.RegisterType((*QueryRequestsResponse)(nil), "quicksilver.interchainquery.v1.QueryRequestsResponse")
-	proto.RegisterType((*GetTxWithProofResponse)(nil), "quicksilver.interchainquery.v1.GetTxWithProofResponse")
+// QueryRelayerPayoutsRequest is the request type for the Query/RelayerPayouts
+// RPC method.
+type QueryRelayerPayoutsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// relayer optionally restricts the payouts to a single relayer.
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainquery/v1/query.proto", fileDescriptor_e4aadfdae61bcbb1)
+func (m *QueryRelayerPayoutsRequest) Reset()         { *m = QueryRelayerPayoutsRequest{} }
+func (m *QueryRelayerPayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerPayoutsRequest) ProtoMessage()    {}
+func (*QueryRelayerPayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{4}
 }
-
-var fileDescriptor_e4aadfdae61bcbb1 = []byte{
-	// 575 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xce, 0xa5, 0xa5, 0xa5, 0xd7, 0xcd, 0x6a, 0x51, 0x1a, 0x21, 0x13, 0x05, 0x0a, 0x51, 0x05,
-	0x77, 0x4a, 0x28, 0x0b, 0x43, 0x87, 0x4a, 0xa5, 0xb0, 0xb5, 0x26, 0x12, 0x88, 0xa5, 0xb2, 0x9d,
-	0xc3, 0x39, 0x91, 0xdc, 0x39, 0xbe, 0xd7, 0x96, 0x23, 0xc4, 0xc2, 0x2f, 0x40, 0xe2, 0x47, 0xb0,
-	0xf3, 0x0b, 0x18, 0x3b, 0x56, 0x62, 0x61, 0x42, 0x28, 0xe1, 0x3f, 0xb0, 0x22, 0x9f, 0xcf, 0xc6,
-	0x09, 0x12, 0x2d, 0x8b, 0x7d, 0xa7, 0xf7, 0x79, 0x9e, 0xf7, 0x79, 0x3f, 0x6c, 0xbc, 0x37, 0x89,
-	0xb9, 0xff, 0x46, 0xf1, 0x51, 0xc2, 0x22, 0xca, 0x05, 0xb0, 0xc8, 0x1f, 0xba, 0x5c, 0x4c, 0x62,
-	0x16, 0x4d, 0x69, 0xd2, 0xa5, 0xfa, 0x40, 0xc2, 0x48, 0x82, 0xb4, 0xec, 0x0a, 0x96, 0x2c, 0x61,
-	0x49, 0xd2, 0x6d, 0x6e, 0x05, 0x32, 0x90, 0x1a, 0x4a, 0xb3, 0x53, 0xce, 0x6a, 0xde, 0x0c, 0xa4,
-	0x0c, 0x46, 0x8c, 0xba, 0x21, 0xa7, 0xae, 0x10, 0x12, 0x5c, 0xe0, 0x52, 0x28, 0x13, 0xbd, 0x7f,
-	0x49, 0xfe, 0x80, 0x09, 0xa6, 0x78, 0x81, 0xde, 0xf3, 0xa5, 0x1a, 0x4b, 0x45, 0x3d, 0x57, 0x31,
-	0x5a, 0x60, 0x3c, 0x06, 0x6e, 0x97, 0x86, 0x6e, 0xc0, 0x85, 0x96, 0x36, 0xd8, 0xdb, 0x55, 0xac,
-	0xeb, 0xf9, 0xbc, 0x84, 0x66, 0x17, 0x03, 0x6a, 0x1a, 0x10, 0xa4, 0x65, 0x14, 0xd2, 0xc2, 0x38,
-	0x30, 0x31, 0x60, 0xd1, 0x98, 0x0b, 0xa0, 0x30, 0x0d, 0x99, 0xca, 0x9f, 0x26, 0x4a, 0xb9, 0xe7,
-	0xd3, 0x11, 0x0f, 0x86, 0xe0, 0x8f, 0x38, 0x13, 0xa0, 0x68, 0x05, 0x9e, 0x74, 0x2b, 0xb7, 0x9c,
-	0xd0, 0x9e, 0xe2, 0xad, 0xd3, 0xcc, 0xb1, 0xc3, 0x26, 0x31, 0x53, 0xa0, 0xcc, 0xdb, 0x7a, 0x82,
-	0xf1, 0x1f, 0xef, 0x0d, 0xd4, 0x42, 0x9d, 0xcd, 0xde, 0x5d, 0x92, 0xfb, 0x22, 0x99, 0x79, 0x52,
-	0x34, 0x58, 0xfb, 0x23, 0x27, 0x6e, 0xc0, 0x0c, 0xd7, 0xa9, 0x30, 0xad, 0x1d, 0x7c, 0x5d, 0xb7,
-	0xee, 0x8c, 0x0f, 0x1a, 0xf5, 0x16, 0xea, 0x6c, 0x38, 0xeb, 0xfa, 0xfe, 0x6c, 0xd0, 0xfe, 0x84,
-	0xf0, 0xf6, 0x52, 0x6e, 0x15, 0x4a, 0xa1, 0x98, 0x75, 0x84, 0xd7, 0x33, 0x75, 0xce, 0x54, 0x03,
-	0xb5, 0x56, 0x3a, 0x9b, 0xbd, 0x5d, 0xf2, 0xef, 0x21, 0x13, 0xad, 0x73, 0xb8, 0x7a, 0xfe, 0xfd,
-	0x56, 0xcd, 0x29, 0xb8, 0xd6, 0xf1, 0x42, 0x0d, 0x75, 0x5d, 0xc3, 0xbd, 0x4b, 0x6b, 0xc8, 0x3d,
-	0x54, 0x8b, 0x68, 0xff, 0x42, 0xf8, 0xc6, 0x31, 0x83, 0x7e, 0xfa, 0x82, 0xc3, 0xf0, 0x24, 0x92,
-	0xf2, 0x75, 0x69, 0x75, 0x17, 0xd7, 0x21, 0x35, 0xfd, 0xd9, 0x2e, 0xb4, 0x21, 0x2d, 0x35, 0xfb,
-	0xa9, 0x53, 0x87, 0xd4, 0x3a, 0xc2, 0x9b, 0x90, 0x9e, 0x45, 0x86, 0x65, 0xbc, 0xdc, 0x59, 0xf0,
-	0xa2, 0xe7, 0x5f, 0xa1, 0x95, 0x46, 0xa0, 0x3c, 0x5b, 0x14, 0x5f, 0x0b, 0xb3, 0xf4, 0x8d, 0x15,
-	0x2d, 0xb0, 0x43, 0x2a, 0xf3, 0xcc, 0xd7, 0xa0, 0x9f, 0xe6, 0xfe, 0x72, 0x9c, 0x75, 0x80, 0xd7,
-	0x86, 0xcc, 0x1d, 0xb0, 0xa8, 0xb1, 0x6a, 0x46, 0xc8, 0x3d, 0x9f, 0x54, 0x17, 0xa4, 0x2a, 0x91,
-	0x74, 0xc9, 0x53, 0x8d, 0x76, 0x0c, 0xab, 0xf7, 0x05, 0xe1, 0x0d, 0xdd, 0xdb, 0xe7, 0x51, 0x12,
-	0x59, 0x9f, 0x11, 0x5e, 0x3f, 0x35, 0xcd, 0xdd, 0xbf, 0xd2, 0x48, 0x96, 0xd6, 0xaa, 0xf9, 0xe8,
-	0x3f, 0x59, 0x79, 0xdd, 0xed, 0xc7, 0xef, 0xbf, 0xfe, 0xfc, 0x58, 0xdf, 0xb7, 0x7a, 0xf4, 0x0a,
-	0x3f, 0x06, 0xce, 0x14, 0x7d, 0x5b, 0x2c, 0xdd, 0xbb, 0xc3, 0x97, 0xe7, 0x33, 0x1b, 0x5d, 0xcc,
-	0x6c, 0xf4, 0x63, 0x66, 0xa3, 0x0f, 0x73, 0xbb, 0x76, 0x31, 0xb7, 0x6b, 0xdf, 0xe6, 0x76, 0xed,
-	0xd5, 0x41, 0xc0, 0x61, 0x18, 0x7b, 0xc4, 0x97, 0x63, 0xca, 0x45, 0xc0, 0x44, 0xcc, 0x61, 0xfa,
-	0xc0, 0x8b, 0xf9, 0x68, 0xb0, 0x90, 0x27, 0xfd, 0x2b, 0x93, 0xee, 0xb5, 0xb7, 0xa6, 0x3f, 0xa1,
-	0x87, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x26, 0x98, 0xa8, 0xe8, 0xae, 0x04, 0x00, 0x00,
+func (m *QueryRelayerPayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerPayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerPayoutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerPayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerPayoutsRequest.Merge(m, src)
+}
+func (m *QueryRelayerPayoutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerPayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerPayoutsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRelayerPayoutsRequest proto.InternalMessageInfo
 
-// QuerySrvrClient is the client API for QuerySrvr service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QuerySrvrClient interface {
-	// Params returns the total set of minting parameters.
-	Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error)
+func (m *QueryRelayerPayoutsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type querySrvrClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRelayerPayoutsRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
 }
 
-func NewQuerySrvrClient(cc grpc1.ClientConn) QuerySrvrClient {
-	return &querySrvrClient{cc}
+// QueryRelayerPayoutsResponse is the response type for the
+// Query/RelayerPayouts RPC method.
+type QueryRelayerPayoutsResponse struct {
+	Payouts    []RelayerPayout     `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *querySrvrClient) Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error) {
-	out := new(QueryRequestsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Queries", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRelayerPayoutsResponse) Reset()         { *m = QueryRelayerPayoutsResponse{} }
+func (m *QueryRelayerPayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerPayoutsResponse) ProtoMessage()    {}
+func (*QueryRelayerPayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{5}
+}
+func (m *QueryRelayerPayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerPayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerPayoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QuerySrvrServer is the server API for QuerySrvr service.
-type QuerySrvrServer interface {
-	// Params returns the total set of minting parameters.
-	Queries(context.Context, *QueryRequestsRequest) (*QueryRequestsResponse, error)
+func (m *QueryRelayerPayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerPayoutsResponse.Merge(m, src)
+}
+func (m *QueryRelayerPayoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerPayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerPayoutsResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQuerySrvrServer can be embedded to have forward compatible implementations.
-type UnimplementedQuerySrvrServer struct {
+var xxx_messageInfo_QueryRelayerPayoutsResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerPayoutsResponse) GetPayouts() []RelayerPayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
 }
 
-func (*UnimplementedQuerySrvrServer) Queries(ctx context.Context, req *QueryRequestsRequest) (*QueryRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Queries not implemented")
+func (m *QueryRelayerPayoutsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQuerySrvrServer(s grpc1.Server, srv QuerySrvrServer) {
-	s.RegisterService(&_QuerySrvr_serviceDesc, srv)
+// QueryRelayersRequest is the request type for the Query/Relayers RPC method.
+type QueryRelayersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _QuerySrvr_Queries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QuerySrvrServer).Queries(ctx, in)
+func (m *QueryRelayersRequest) Reset()         { *m = QueryRelayersRequest{} }
+func (m *QueryRelayersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayersRequest) ProtoMessage()    {}
+func (*QueryRelayersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{6}
+}
+func (m *QueryRelayersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Queries",
+}
+func (m *QueryRelayersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayersRequest.Merge(m, src)
+}
+func (m *QueryRelayersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayersRequest proto.InternalMessageInfo
+
+func (m *QueryRelayersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuerySrvrServer).Queries(ctx, req.(*QueryRequestsRequest))
+	return nil
+}
+
+// QueryRelayersResponse is the response type for the Query/Relayers RPC
+// method.
+type QueryRelayersResponse struct {
+	Relayers   []Relayer           `protobuf:"bytes,1,rep,name=relayers,proto3" json:"relayers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRelayersResponse) Reset()         { *m = QueryRelayersResponse{} }
+func (m *QueryRelayersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayersResponse) ProtoMessage()    {}
+func (*QueryRelayersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{7}
+}
+func (m *QueryRelayersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRelayersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayersResponse.Merge(m, src)
+}
+func (m *QueryRelayersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayersResponse.DiscardUnknown(m)
 }
 
-var _QuerySrvr_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "quicksilver.interchainquery.v1.QuerySrvr",
-	HandlerType: (*QuerySrvrServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Queries",
-			Handler:    _QuerySrvr_Queries_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "quicksilver/interchainquery/v1/query.proto",
+var xxx_messageInfo_QueryRelayersResponse proto.InternalMessageInfo
+
+func (m *QueryRelayersResponse) GetRelayers() []Relayer {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
 }
 
-func (m *QueryRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRelayersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQueryRequest is the request type for the Query/Query RPC method.
+type QueryQueryRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryQueryRequest) Reset()         { *m = QueryQueryRequest{} }
+func (m *QueryQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryRequest) ProtoMessage()    {}
+func (*QueryQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{8}
+}
+func (m *QueryQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryRequest.Merge(m, src)
+}
+func (m *QueryQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQueryRequest proto.InternalMessageInfo
+
+func (m *QueryQueryRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQueryResponse is the response type for the Query/Query RPC method.
+type QueryQueryResponse struct {
+	Query Query `protobuf:"bytes,1,opt,name=query,proto3" json:"query"`
 }
 
-func (m *QueryRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Queries) > 0 {
-		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryQueryResponse) Reset()         { *m = QueryQueryResponse{} }
+func (m *QueryQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResponse) ProtoMessage()    {}
+func (*QueryQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{9}
+}
+func (m *QueryQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResponse.Merge(m, src)
+}
+func (m *QueryQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResponse.DiscardUnknown(m)
 }
 
-func (m *GetTxWithProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQueryResponse proto.InternalMessageInfo
+
+func (m *QueryQueryResponse) GetQuery() Query {
+	if m != nil {
+		return m.Query
 	}
-	return dAtA[:n], nil
+	return Query{}
 }
 
-func (m *GetTxWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDatapointsRequest is the request type for the Query/Datapoints RPC
+// method.
+type QueryDatapointsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetTxWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDatapointsRequest) Reset()         { *m = QueryDatapointsRequest{} }
+func (m *QueryDatapointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointsRequest) ProtoMessage()    {}
+func (*QueryDatapointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{10}
+}
+func (m *QueryDatapointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryDatapointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointsRequest.Merge(m, src)
+}
+func (m *QueryDatapointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDatapointsRequest proto.InternalMessageInfo
+
+func (m *QueryDatapointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return nil
+}
+
+// QueryDatapointsResponse is the response type for the Query/Datapoints RPC
+// method.
+type QueryDatapointsResponse struct {
+	Datapoints []DataPoint         `protobuf:"bytes,1,rep,name=datapoints,proto3" json:"datapoints"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDatapointsResponse) Reset()         { *m = QueryDatapointsResponse{} }
+func (m *QueryDatapointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointsResponse) ProtoMessage()    {}
+func (*QueryDatapointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{11}
+}
+func (m *QueryDatapointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDatapointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointsResponse.Merge(m, src)
+}
+func (m *QueryDatapointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryDatapointsResponse proto.InternalMessageInfo
+
+func (m *QueryDatapointsResponse) GetDatapoints() []DataPoint {
+	if m != nil {
+		return m.Datapoints
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryDatapointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Queries) > 0 {
-		for _, e := range m.Queries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryDatapointRequest is the request type for the Query/Datapoint RPC
+// method.
+type QueryDatapointRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDatapointRequest) Reset()         { *m = QueryDatapointRequest{} }
+func (m *QueryDatapointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointRequest) ProtoMessage()    {}
+func (*QueryDatapointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{12}
+}
+func (m *QueryDatapointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryDatapointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointRequest.Merge(m, src)
+}
+func (m *QueryDatapointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointRequest.DiscardUnknown(m)
 }
 
-func (m *GetTxWithProofResponse) Size() (n int) {
+var xxx_messageInfo_QueryDatapointRequest proto.InternalMessageInfo
+
+func (m *QueryDatapointRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryDatapointResponse is the response type for the Query/Datapoint RPC
+// method.
+type QueryDatapointResponse struct {
+	Datapoint DataPoint `protobuf:"bytes,1,opt,name=datapoint,proto3" json:"datapoint"`
+}
+
+func (m *QueryDatapointResponse) Reset()         { *m = QueryDatapointResponse{} }
+func (m *QueryDatapointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDatapointResponse) ProtoMessage()    {}
+func (*QueryDatapointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{13}
+}
+func (m *QueryDatapointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatapointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatapointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDatapointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatapointResponse.Merge(m, src)
+}
+func (m *QueryDatapointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatapointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatapointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDatapointResponse proto.InternalMessageInfo
+
+func (m *QueryDatapointResponse) GetDatapoint() DataPoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return DataPoint{}
+}
+
+// QueryChainStatsRequest is the request type for the Query/ChainStats RPC
+// method.
+type QueryChainStatsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// blocks is the number of most recent blocks over which responses are
+	// counted; zero counts over the whole response stats window.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryChainStatsRequest) Reset()         { *m = QueryChainStatsRequest{} }
+func (m *QueryChainStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainStatsRequest) ProtoMessage()    {}
+func (*QueryChainStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{14}
+}
+func (m *QueryChainStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainStatsRequest.Merge(m, src)
+}
+func (m *QueryChainStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainStatsRequest proto.InternalMessageInfo
+
+func (m *QueryChainStatsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryChainStatsRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryChainStatsResponse is the response type for the Query/ChainStats RPC
+// method.
+type QueryChainStatsResponse struct {
+	Stats ChainStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryChainStatsResponse) Reset()         { *m = QueryChainStatsResponse{} }
+func (m *QueryChainStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainStatsResponse) ProtoMessage()    {}
+func (*QueryChainStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{15}
+}
+func (m *QueryChainStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainStatsResponse.Merge(m, src)
+}
+func (m *QueryChainStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainStatsResponse proto.InternalMessageInfo
+
+func (m *QueryChainStatsResponse) GetStats() ChainStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ChainStats{}
+}
+
+// ChainStats are statistics of the queries of a chain.
+type ChainStats struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// queries is the number of registered queries.
+	Queries uint64 `protobuf:"varint,2,opt,name=queries,proto3" json:"queries,omitempty"`
+	// pending is the number of queries awaiting a response.
+	Pending uint64 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	// quarantined is the number of quarantined queries.
+	Quarantined uint64 `protobuf:"varint,4,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	// oldest_pending_emission is the local height of the earliest emission
+	// still awaiting a response; zero if none.
+	OldestPendingEmission int64 `protobuf:"varint,5,opt,name=oldest_pending_emission,json=oldestPendingEmission,proto3" json:"oldest_pending_emission,omitempty"`
+	// responses is the number of accepted responses in the last blocks.
+	Responses uint64 `protobuf:"varint,6,opt,name=responses,proto3" json:"responses,omitempty"`
+	// blocks is the number of blocks over which responses were counted.
+	Blocks uint64 `protobuf:"varint,7,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *ChainStats) Reset()         { *m = ChainStats{} }
+func (m *ChainStats) String() string { return proto.CompactTextString(m) }
+func (*ChainStats) ProtoMessage()    {}
+func (*ChainStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{16}
+}
+func (m *ChainStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStats.Merge(m, src)
+}
+func (m *ChainStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStats proto.InternalMessageInfo
+
+func (m *ChainStats) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainStats) GetQueries() uint64 {
+	if m != nil {
+		return m.Queries
+	}
+	return 0
+}
+
+func (m *ChainStats) GetPending() uint64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *ChainStats) GetQuarantined() uint64 {
+	if m != nil {
+		return m.Quarantined
+	}
+	return 0
+}
+
+func (m *ChainStats) GetOldestPendingEmission() int64 {
+	if m != nil {
+		return m.OldestPendingEmission
+	}
+	return 0
+}
+
+func (m *ChainStats) GetResponses() uint64 {
+	if m != nil {
+		return m.Responses
+	}
+	return 0
+}
+
+func (m *ChainStats) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// GetTxResponse is the response type for the Service.GetTx method.
+type GetTxWithProofResponse struct {
+	// tx is the queried transaction.
+	Tx *tx.Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// tx_response is the queried TxResponses.
+	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
+	// proof is the tmproto.TxProof for the queried tx
+	Proof *types1.TxProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// ibc-go header to validate txs
+	Header *types2.Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *GetTxWithProofResponse) Reset()         { *m = GetTxWithProofResponse{} }
+func (m *GetTxWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxWithProofResponse) ProtoMessage()    {}
+func (*GetTxWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{17}
+}
+func (m *GetTxWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxWithProofResponse.Merge(m, src)
+}
+func (m *GetTxWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxWithProofResponse proto.InternalMessageInfo
+
+func (m *GetTxWithProofResponse) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetTxResponse() *types.TxResponse {
+	if m != nil {
+		return m.TxResponse
+	}
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetProof() *types1.TxProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetHeader() *types2.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRequestsRequest)(nil), "quicksilver.interchainquery.v1.QueryRequestsRequest")
+	proto.RegisterType((*QueryRequestsResponse)(nil), "quicksilver.interchainquery.v1.QueryRequestsResponse")
+	proto.RegisterType((*QueryBountiesRequest)(nil), "quicksilver.interchainquery.v1.QueryBountiesRequest")
+	proto.RegisterType((*QueryBountiesResponse)(nil), "quicksilver.interchainquery.v1.QueryBountiesResponse")
+	proto.RegisterType((*QueryRelayerPayoutsRequest)(nil), "quicksilver.interchainquery.v1.QueryRelayerPayoutsRequest")
+	proto.RegisterType((*QueryRelayerPayoutsResponse)(nil), "quicksilver.interchainquery.v1.QueryRelayerPayoutsResponse")
+	proto.RegisterType((*QueryRelayersRequest)(nil), "quicksilver.interchainquery.v1.QueryRelayersRequest")
+	proto.RegisterType((*QueryRelayersResponse)(nil), "quicksilver.interchainquery.v1.QueryRelayersResponse")
+	proto.RegisterType((*QueryQueryRequest)(nil), "quicksilver.interchainquery.v1.QueryQueryRequest")
+	proto.RegisterType((*QueryQueryResponse)(nil), "quicksilver.interchainquery.v1.QueryQueryResponse")
+	proto.RegisterType((*QueryDatapointsRequest)(nil), "quicksilver.interchainquery.v1.QueryDatapointsRequest")
+	proto.RegisterType((*QueryDatapointsResponse)(nil), "quicksilver.interchainquery.v1.QueryDatapointsResponse")
+	proto.RegisterType((*QueryDatapointRequest)(nil), "quicksilver.interchainquery.v1.QueryDatapointRequest")
+	proto.RegisterType((*QueryDatapointResponse)(nil), "quicksilver.interchainquery.v1.QueryDatapointResponse")
+	proto.RegisterType((*QueryChainStatsRequest)(nil), "quicksilver.interchainquery.v1.QueryChainStatsRequest")
+	proto.RegisterType((*QueryChainStatsResponse)(nil), "quicksilver.interchainquery.v1.QueryChainStatsResponse")
+	proto.RegisterType((*ChainStats)(nil), "quicksilver.interchainquery.v1.ChainStats")
+	proto.RegisterType((*GetTxWithProofResponse)(nil), "quicksilver.interchainquery.v1.GetTxWithProofResponse")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/interchainquery/v1/query.proto", fileDescriptor_e4aadfdae61bcbb1)
+}
+
+var fileDescriptor_e4aadfdae61bcbb1 = []byte{
+	// 1227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xba, 0x4e, 0x1c, 0x3f, 0x4b, 0xfd, 0x7e, 0x19, 0x9a, 0xc4, 0x35, 0xc5, 0x8d, 0xb6,
+	0x94, 0xa4, 0x51, 0xbb, 0x5b, 0x9b, 0xfc, 0x40, 0x45, 0xaa, 0xd4, 0x40, 0x5a, 0x22, 0x84, 0x48,
+	0xb6, 0x91, 0x8a, 0x38, 0x60, 0xd6, 0xbb, 0xc3, 0x66, 0x94, 0xcd, 0xae, 0xb3, 0x3b, 0x36, 0xb6,
+	0x2a, 0x38, 0xf0, 0x17, 0x20, 0xf1, 0x1f, 0x70, 0x00, 0x21, 0xc4, 0x01, 0x2a, 0xc4, 0x9d, 0x53,
+	0x8f, 0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0xc6, 0x1f, 0xc0, 0x15, 0xed, 0xec, 0x9b, 0xdd, 0x75,
+	0x52, 0x6a, 0x2f, 0xb2, 0x10, 0x97, 0x64, 0xe7, 0xfd, 0xfc, 0xcc, 0x67, 0xde, 0xcc, 0x7b, 0x32,
+	0xac, 0x1c, 0x75, 0x99, 0x75, 0x10, 0x32, 0xb7, 0x47, 0x03, 0x9d, 0x79, 0x9c, 0x06, 0xd6, 0xbe,
+	0xc9, 0xbc, 0xa3, 0x2e, 0x0d, 0x06, 0x7a, 0xaf, 0xa1, 0x8b, 0x0f, 0xad, 0x13, 0xf8, 0xdc, 0x27,
+	0xf5, 0x8c, 0xad, 0x76, 0xca, 0x56, 0xeb, 0x35, 0x6a, 0x17, 0x1c, 0xdf, 0xf1, 0x85, 0xa9, 0x1e,
+	0x7d, 0xc5, 0x5e, 0xb5, 0x4b, 0x8e, 0xef, 0x3b, 0x2e, 0xd5, 0xcd, 0x0e, 0xd3, 0x4d, 0xcf, 0xf3,
+	0xb9, 0xc9, 0x99, 0xef, 0x85, 0xa8, 0xbd, 0x3e, 0x22, 0xbf, 0x43, 0x3d, 0x1a, 0x32, 0x69, 0xbd,
+	0x62, 0xf9, 0xe1, 0xa1, 0x1f, 0xea, 0x6d, 0x33, 0xa4, 0xba, 0xb4, 0x69, 0x53, 0x6e, 0x36, 0xf4,
+	0x8e, 0xe9, 0x30, 0x4f, 0x84, 0x46, 0xdb, 0x2b, 0x59, 0x5b, 0xb3, 0x6d, 0xb1, 0xc4, 0x34, 0x5a,
+	0xa0, 0x51, 0x0d, 0x8d, 0x78, 0x3f, 0xd1, 0xf2, 0xbe, 0x04, 0xce, 0xa9, 0x67, 0xd3, 0xe0, 0x90,
+	0x79, 0x5c, 0xe7, 0x83, 0x0e, 0x0d, 0xe3, 0xbf, 0xa8, 0xd5, 0x59, 0xdb, 0xd2, 0x5d, 0xe6, 0xec,
+	0x73, 0xcb, 0x65, 0xd4, 0xe3, 0xa1, 0x9e, 0x31, 0xef, 0x35, 0x32, 0xab, 0xd8, 0x41, 0xfd, 0xb6,
+	0x00, 0x17, 0x76, 0x23, 0xc8, 0x06, 0x3d, 0xea, 0xd2, 0x90, 0x87, 0xf8, 0x9f, 0xdc, 0x05, 0x48,
+	0xc1, 0x57, 0x95, 0x45, 0x65, 0xb9, 0xd2, 0x7c, 0x59, 0x8b, 0x81, 0x69, 0x11, 0x7a, 0x4d, 0x32,
+	0x2c, 0x00, 0x6a, 0x3b, 0xa6, 0x43, 0xd1, 0xd7, 0xc8, 0x78, 0x92, 0x8b, 0x30, 0x2b, 0xb8, 0x6b,
+	0x31, 0xbb, 0x5a, 0x58, 0x54, 0x96, 0xcb, 0x46, 0x49, 0xac, 0xb7, 0x6d, 0xa2, 0xc3, 0xf3, 0xcc,
+	0xb3, 0xdc, 0xae, 0x4d, 0x5b, 0x47, 0x5d, 0x33, 0x30, 0x3d, 0xce, 0x3c, 0x6a, 0x57, 0xcf, 0x2d,
+	0x2a, 0xcb, 0xb3, 0x06, 0x41, 0xd5, 0x6e, 0xaa, 0x21, 0xf3, 0x30, 0x73, 0xe8, 0xdb, 0x5d, 0x97,
+	0x56, 0x8b, 0x22, 0x12, 0xae, 0xc8, 0x65, 0xa8, 0x58, 0xa6, 0xeb, 0xb6, 0x4d, 0xeb, 0x20, 0x4a,
+	0x33, 0x2d, 0x94, 0x20, 0x45, 0xdb, 0x36, 0x79, 0x11, 0x40, 0xa0, 0x6d, 0x45, 0x5c, 0x55, 0x67,
+	0x84, 0xbe, 0x2c, 0x24, 0x7b, 0x83, 0x0e, 0x25, 0xd7, 0xe0, 0xff, 0x12, 0x88, 0xe9, 0x85, 0x1f,
+	0xd1, 0x80, 0xda, 0xd5, 0x92, 0x40, 0xf1, 0x3f, 0x94, 0xdf, 0x41, 0xb1, 0xfa, 0x95, 0x02, 0x73,
+	0xa7, 0xf8, 0x0a, 0x3b, 0xbe, 0x17, 0x52, 0xb2, 0x05, 0xa5, 0x28, 0x22, 0xa3, 0x61, 0x55, 0x59,
+	0x3c, 0xb7, 0x5c, 0x69, 0x5e, 0xd5, 0x9e, 0x5d, 0x99, 0x9a, 0x88, 0xb3, 0x59, 0x7c, 0xfc, 0xeb,
+	0xe5, 0x29, 0x43, 0xfa, 0x92, 0x7b, 0x43, 0xbc, 0x17, 0x04, 0xef, 0x4b, 0x23, 0x79, 0x8f, 0x31,
+	0x64, 0x89, 0x57, 0x07, 0x78, 0xb0, 0x9b, 0x7e, 0xd7, 0xe3, 0x8c, 0xfe, 0x8b, 0x07, 0x9b, 0x92,
+	0x94, 0xe6, 0xfe, 0x8f, 0x92, 0xf4, 0x09, 0xd4, 0xf0, 0x34, 0x5d, 0x73, 0x40, 0x83, 0x1d, 0x73,
+	0xe0, 0x77, 0x27, 0x7f, 0x07, 0xaa, 0x50, 0x0a, 0xe2, 0x04, 0x92, 0x29, 0x5c, 0xaa, 0x3f, 0x28,
+	0xf0, 0xc2, 0x53, 0x01, 0x20, 0x5f, 0x6f, 0x43, 0xa9, 0x13, 0x8b, 0x90, 0xaf, 0x1b, 0xa3, 0xf8,
+	0x1a, 0x0a, 0x24, 0x79, 0xc3, 0x18, 0x93, 0xe3, 0xed, 0xfd, 0xe4, 0xd5, 0x10, 0xd9, 0x26, 0xcd,
+	0x98, 0xfa, 0x4d, 0x7a, 0xcd, 0x64, 0x02, 0x64, 0x64, 0x1b, 0x66, 0x91, 0x3c, 0x49, 0xc9, 0xd2,
+	0x98, 0x94, 0x20, 0x19, 0x89, 0xfb, 0xe4, 0xd8, 0xb8, 0x02, 0xcf, 0x09, 0xb0, 0xd9, 0x87, 0x81,
+	0x9c, 0x87, 0x02, 0xb3, 0x05, 0x05, 0x65, 0xa3, 0xc0, 0x6c, 0xf5, 0x01, 0x90, 0xac, 0x11, 0x6e,
+	0xe7, 0x0e, 0x4c, 0x8b, 0x24, 0xc8, 0x55, 0xae, 0xeb, 0x10, 0x7b, 0xaa, 0x1f, 0xc0, 0xbc, 0x90,
+	0xbe, 0x61, 0x72, 0xb3, 0xe3, 0x33, 0x6f, 0xe2, 0xf5, 0xab, 0x3e, 0x52, 0x60, 0xe1, 0x4c, 0x0a,
+	0xdc, 0xc0, 0x3b, 0x00, 0x76, 0x22, 0xc5, 0x13, 0xb9, 0x36, 0x6a, 0x17, 0x51, 0x9c, 0x9d, 0xc8,
+	0x03, 0x77, 0x92, 0x09, 0x31, 0xb9, 0x53, 0x59, 0xc2, 0x12, 0x4a, 0x40, 0xff, 0xdd, 0xc9, 0x38,
+	0xa7, 0x09, 0xcc, 0x5c, 0xbf, 0x72, 0x82, 0x0c, 0xf9, 0xcb, 0xbd, 0xb7, 0x34, 0x82, 0xfa, 0x16,
+	0x26, 0x7a, 0x3d, 0x72, 0xb8, 0xcf, 0xcd, 0xf4, 0xa4, 0xb2, 0x8f, 0xa9, 0x32, 0xdc, 0x25, 0xe7,
+	0x61, 0xa6, 0xed, 0xfa, 0xd6, 0x41, 0x28, 0xb8, 0x28, 0x1a, 0xb8, 0x52, 0x4d, 0x58, 0x38, 0x13,
+	0x0c, 0x61, 0xdf, 0x85, 0xe9, 0x30, 0x12, 0x20, 0xe4, 0x95, 0x51, 0x90, 0xd3, 0x10, 0xb2, 0xb2,
+	0x84, 0xbb, 0xfa, 0x87, 0x02, 0x90, 0xea, 0x9e, 0x05, 0xb2, 0x9a, 0xbe, 0xeb, 0x31, 0x4a, 0xb9,
+	0x8c, 0x34, 0x1d, 0xea, 0xd9, 0xcc, 0x73, 0x44, 0x63, 0x2f, 0x1a, 0x72, 0x49, 0x16, 0xa1, 0x92,
+	0x6d, 0xfb, 0x45, 0xa1, 0xcd, 0x8a, 0xc8, 0x3a, 0x2c, 0xf8, 0xae, 0x4d, 0x43, 0xde, 0x42, 0x9f,
+	0x16, 0x3d, 0x64, 0x61, 0x18, 0xd5, 0x45, 0xd4, 0xe3, 0xcf, 0x19, 0x73, 0xb1, 0x7a, 0x27, 0xd6,
+	0x6e, 0xa1, 0x92, 0x5c, 0x82, 0x72, 0x80, 0x5c, 0x84, 0xa2, 0xdb, 0x17, 0x8d, 0x54, 0x90, 0x21,
+	0xb4, 0x34, 0x44, 0xe8, 0x9f, 0x0a, 0xcc, 0xdf, 0xa3, 0x7c, 0xaf, 0xff, 0x80, 0xf1, 0xfd, 0x9d,
+	0xc0, 0xf7, 0x3f, 0x4c, 0x08, 0xbd, 0x0a, 0x05, 0xde, 0x47, 0x36, 0xe7, 0x64, 0x2d, 0xf2, 0x7e,
+	0x52, 0x83, 0x7b, 0x7d, 0xa3, 0xc0, 0xfb, 0x64, 0x0b, 0x2a, 0xbc, 0xdf, 0x92, 0x99, 0xb0, 0x76,
+	0x5f, 0x1a, 0xaa, 0x5d, 0x31, 0xe5, 0x65, 0xdc, 0x92, 0xc2, 0xe5, 0xc9, 0x37, 0xd1, 0x61, 0xba,
+	0x13, 0xa5, 0x17, 0x84, 0x55, 0x9a, 0x17, 0xb5, 0xcc, 0xd4, 0x16, 0x0f, 0x7b, 0x7b, 0xfd, 0x18,
+	0x5f, 0x6c, 0x47, 0x6e, 0xc3, 0xcc, 0x3e, 0x35, 0x6d, 0x1a, 0x54, 0x8b, 0x78, 0xc7, 0x59, 0xdb,
+	0xd2, 0xb2, 0x63, 0x60, 0x36, 0x44, 0xaf, 0xa1, 0xbd, 0x29, 0xac, 0x0d, 0xf4, 0x6a, 0x7e, 0x59,
+	0x81, 0xb2, 0xa8, 0xa5, 0xfb, 0x41, 0x2f, 0x20, 0xdf, 0x2b, 0x50, 0xda, 0xc5, 0xd3, 0x5b, 0x1d,
+	0xeb, 0x3d, 0x3a, 0x35, 0x3b, 0xd6, 0xd6, 0x72, 0x7a, 0xc5, 0xfb, 0x56, 0x6f, 0x7d, 0xfa, 0xf3,
+	0xef, 0x9f, 0x17, 0x56, 0x49, 0x53, 0x1f, 0x63, 0xfc, 0x67, 0x34, 0xd4, 0x1f, 0xca, 0x72, 0xfc,
+	0x98, 0x7c, 0xad, 0xc0, 0xac, 0x9c, 0x36, 0xc6, 0x44, 0x7d, 0x6a, 0x30, 0xaa, 0xad, 0xe5, 0xf4,
+	0x42, 0xd4, 0x37, 0x05, 0xea, 0x15, 0xb2, 0x3c, 0x0a, 0x75, 0x5b, 0xc2, 0xfb, 0x49, 0x81, 0xf3,
+	0xc3, 0xfd, 0x9e, 0xdc, 0x1a, 0x93, 0xb1, 0xa7, 0x4c, 0x29, 0xb5, 0xd7, 0xfe, 0x91, 0x2f, 0xa2,
+	0xdf, 0x10, 0xe8, 0x1b, 0x44, 0x1f, 0x85, 0x1e, 0xbb, 0x66, 0x4b, 0x8e, 0x12, 0x11, 0xe1, 0x86,
+	0xec, 0xa4, 0xab, 0x79, 0x20, 0xe4, 0x2e, 0x93, 0xe1, 0x09, 0x60, 0x7c, 0xc2, 0x93, 0x46, 0xff,
+	0x85, 0x02, 0xd3, 0x22, 0x16, 0x69, 0x8c, 0x95, 0x32, 0x5b, 0x9e, 0xb5, 0x66, 0x1e, 0x17, 0x84,
+	0xd8, 0x14, 0x10, 0xaf, 0x93, 0x95, 0x71, 0x2a, 0x79, 0xa0, 0x3f, 0x8c, 0x2a, 0xf8, 0x3b, 0x05,
+	0x20, 0xed, 0xaf, 0x64, 0x7d, 0xac, 0xb4, 0x67, 0x7a, 0x7e, 0x6d, 0x23, 0xb7, 0x5f, 0x5e, 0xcc,
+	0x99, 0x5e, 0xfd, 0x48, 0x81, 0x72, 0x12, 0x8a, 0xac, 0xe5, 0x4b, 0x2d, 0x11, 0xaf, 0xe7, 0x75,
+	0xcb, 0x5b, 0xba, 0x29, 0xe0, 0x98, 0xe9, 0x1f, 0x87, 0xdb, 0xda, 0x78, 0xf9, 0xcf, 0xf4, 0xec,
+	0xda, 0x46, 0x6e, 0x3f, 0x04, 0xfe, 0xaa, 0x00, 0xde, 0x24, 0x37, 0x47, 0x01, 0x17, 0x5d, 0x38,
+	0xf3, 0xca, 0x6d, 0xbe, 0xfb, 0xf8, 0xb8, 0xae, 0x3c, 0x39, 0xae, 0x2b, 0xbf, 0x1d, 0xd7, 0x95,
+	0xcf, 0x4e, 0xea, 0x53, 0x4f, 0x4e, 0xea, 0x53, 0xbf, 0x9c, 0xd4, 0xa7, 0xde, 0xbb, 0xed, 0x30,
+	0xbe, 0xdf, 0x6d, 0x6b, 0x96, 0x7f, 0xa8, 0x33, 0xcf, 0xa1, 0x5e, 0x97, 0xf1, 0xc1, 0x8d, 0x76,
+	0x97, 0xb9, 0xf6, 0x50, 0x96, 0xfe, 0x99, 0x3c, 0xa2, 0xa3, 0xb4, 0x67, 0xc4, 0xcf, 0x01, 0xaf,
+	0xfc, 0x35, 0x00, 0x60, 0xe2, 0x71, 0x18, 0x7a, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QuerySrvrClient is the client API for QuerySrvr service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QuerySrvrClient interface {
+	// Params returns the total set of minting parameters.
+	Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error)
+	// Bounties returns the emitted queries that carry a bounty and await a
+	// response.
+	Bounties(ctx context.Context, in *QueryBountiesRequest, opts ...grpc.CallOption) (*QueryBountiesResponse, error)
+	// RelayerPayouts returns the bounties paid to relayers.
+	RelayerPayouts(ctx context.Context, in *QueryRelayerPayoutsRequest, opts ...grpc.CallOption) (*QueryRelayerPayoutsResponse, error)
+	// Relayers returns the relayers registered to submit responses to unproven
+	// queries.
+	Relayers(ctx context.Context, in *QueryRelayersRequest, opts ...grpc.CallOption) (*QueryRelayersResponse, error)
+	// Query returns a single query by id.
+	Query(ctx context.Context, in *QueryQueryRequest, opts ...grpc.CallOption) (*QueryQueryResponse, error)
+	// Datapoints returns the stored results of queries.
+	Datapoints(ctx context.Context, in *QueryDatapointsRequest, opts ...grpc.CallOption) (*QueryDatapointsResponse, error)
+	// Datapoint returns the stored result of a single query.
+	Datapoint(ctx context.Context, in *QueryDatapointRequest, opts ...grpc.CallOption) (*QueryDatapointResponse, error)
+	// ChainStats returns statistics of the queries of a chain.
+	ChainStats(ctx context.Context, in *QueryChainStatsRequest, opts ...grpc.CallOption) (*QueryChainStatsResponse, error)
+}
+
+type querySrvrClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQuerySrvrClient(cc grpc1.ClientConn) QuerySrvrClient {
+	return &querySrvrClient{cc}
+}
+
+func (c *querySrvrClient) Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error) {
+	out := new(QueryRequestsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Queries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Bounties(ctx context.Context, in *QueryBountiesRequest, opts ...grpc.CallOption) (*QueryBountiesResponse, error) {
+	out := new(QueryBountiesResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Bounties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) RelayerPayouts(ctx context.Context, in *QueryRelayerPayoutsRequest, opts ...grpc.CallOption) (*QueryRelayerPayoutsResponse, error) {
+	out := new(QueryRelayerPayoutsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/RelayerPayouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Relayers(ctx context.Context, in *QueryRelayersRequest, opts ...grpc.CallOption) (*QueryRelayersResponse, error) {
+	out := new(QueryRelayersResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Relayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Query(ctx context.Context, in *QueryQueryRequest, opts ...grpc.CallOption) (*QueryQueryResponse, error) {
+	out := new(QueryQueryResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Datapoints(ctx context.Context, in *QueryDatapointsRequest, opts ...grpc.CallOption) (*QueryDatapointsResponse, error) {
+	out := new(QueryDatapointsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Datapoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Datapoint(ctx context.Context, in *QueryDatapointRequest, opts ...grpc.CallOption) (*QueryDatapointResponse, error) {
+	out := new(QueryDatapointResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Datapoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) ChainStats(ctx context.Context, in *QueryChainStatsRequest, opts ...grpc.CallOption) (*QueryChainStatsResponse, error) {
+	out := new(QueryChainStatsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/ChainStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuerySrvrServer is the server API for QuerySrvr service.
+type QuerySrvrServer interface {
+	// Params returns the total set of minting parameters.
+	Queries(context.Context, *QueryRequestsRequest) (*QueryRequestsResponse, error)
+	// Bounties returns the emitted queries that carry a bounty and await a
+	// response.
+	Bounties(context.Context, *QueryBountiesRequest) (*QueryBountiesResponse, error)
+	// RelayerPayouts returns the bounties paid to relayers.
+	RelayerPayouts(context.Context, *QueryRelayerPayoutsRequest) (*QueryRelayerPayoutsResponse, error)
+	// Relayers returns the relayers registered to submit responses to unproven
+	// queries.
+	Relayers(context.Context, *QueryRelayersRequest) (*QueryRelayersResponse, error)
+	// Query returns a single query by id.
+	Query(context.Context, *QueryQueryRequest) (*QueryQueryResponse, error)
+	// Datapoints returns the stored results of queries.
+	Datapoints(context.Context, *QueryDatapointsRequest) (*QueryDatapointsResponse, error)
+	// Datapoint returns the stored result of a single query.
+	Datapoint(context.Context, *QueryDatapointRequest) (*QueryDatapointResponse, error)
+	// ChainStats returns statistics of the queries of a chain.
+	ChainStats(context.Context, *QueryChainStatsRequest) (*QueryChainStatsResponse, error)
+}
+
+// UnimplementedQuerySrvrServer can be embedded to have forward compatible implementations.
+type UnimplementedQuerySrvrServer struct {
+}
+
+func (*UnimplementedQuerySrvrServer) Queries(ctx context.Context, req *QueryRequestsRequest) (*QueryRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Queries not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Bounties(ctx context.Context, req *QueryBountiesRequest) (*QueryBountiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bounties not implemented")
+}
+func (*UnimplementedQuerySrvrServer) RelayerPayouts(ctx context.Context, req *QueryRelayerPayoutsRequest) (*QueryRelayerPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerPayouts not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Relayers(ctx context.Context, req *QueryRelayersRequest) (*QueryRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Relayers not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Query(ctx context.Context, req *QueryQueryRequest) (*QueryQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Datapoints(ctx context.Context, req *QueryDatapointsRequest) (*QueryDatapointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Datapoints not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Datapoint(ctx context.Context, req *QueryDatapointRequest) (*QueryDatapointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Datapoint not implemented")
+}
+func (*UnimplementedQuerySrvrServer) ChainStats(ctx context.Context, req *QueryChainStatsRequest) (*QueryChainStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainStats not implemented")
+}
+
+func RegisterQuerySrvrServer(s grpc1.Server, srv QuerySrvrServer) {
+	s.RegisterService(&_QuerySrvr_serviceDesc, srv)
+}
+
+func _QuerySrvr_Queries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Queries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Queries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Queries(ctx, req.(*QueryRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Bounties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBountiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Bounties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Bounties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Bounties(ctx, req.(*QueryBountiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_RelayerPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).RelayerPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/RelayerPayouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).RelayerPayouts(ctx, req.(*QueryRelayerPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Relayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Relayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Relayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Relayers(ctx, req.(*QueryRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Query(ctx, req.(*QueryQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Datapoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDatapointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Datapoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Datapoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Datapoints(ctx, req.(*QueryDatapointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Datapoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDatapointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Datapoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Datapoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Datapoint(ctx, req.(*QueryDatapointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_ChainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).ChainStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/ChainStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).ChainStats(ctx, req.(*QueryChainStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QuerySrvr_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "quicksilver.interchainquery.v1.QuerySrvr",
+	HandlerType: (*QuerySrvrServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Queries",
+			Handler:    _QuerySrvr_Queries_Handler,
+		},
+		{
+			MethodName: "Bounties",
+			Handler:    _QuerySrvr_Bounties_Handler,
+		},
+		{
+			MethodName: "RelayerPayouts",
+			Handler:    _QuerySrvr_RelayerPayouts_Handler,
+		},
+		{
+			MethodName: "Relayers",
+			Handler:    _QuerySrvr_Relayers_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _QuerySrvr_Query_Handler,
+		},
+		{
+			MethodName: "Datapoints",
+			Handler:    _QuerySrvr_Datapoints_Handler,
+		},
+		{
+			MethodName: "Datapoint",
+			Handler:    _QuerySrvr_Datapoint_Handler,
+		},
+		{
+			MethodName: "ChainStats",
+			Handler:    _QuerySrvr_ChainStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "quicksilver/interchainquery/v1/query.proto",
+}
+
+func (m *QueryRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeAnswered {
+		i--
+		if m.IncludeAnswered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IncludeQuarantined {
+		i--
+		if m.IncludeQuarantined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBountiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBountiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBountiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBountiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBountiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBountiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerPayoutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerPayoutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerPayoutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerPayoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerPayoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerPayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Datapoints) > 0 {
+		for iNdEx := len(m.Datapoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datapoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatapointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatapointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatapointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Responses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Responses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OldestPendingEmission != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestPendingEmission))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Quarantined != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quarantined))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Queries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Queries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeQuarantined {
+		n += 2
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeAnswered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBountiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBountiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerPayoutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerPayoutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for _, e := range m.Relayers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Query.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDatapointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatapointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datapoints) > 0 {
+		for _, e := range m.Datapoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatapointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDatapointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Datapoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChainStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryChainStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ChainStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Queries != 0 {
+		n += 1 + sovQuery(uint64(m.Queries))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovQuery(uint64(m.Pending))
+	}
+	if m.Quarantined != 0 {
+		n += 1 + sovQuery(uint64(m.Quarantined))
+	}
+	if m.OldestPendingEmission != 0 {
+		n += 1 + sovQuery(uint64(m.OldestPendingEmission))
+	}
+	if m.Responses != 0 {
+		n += 1 + sovQuery(uint64(m.Responses))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *GetTxWithProofResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeQuarantined", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeQuarantined = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeAnswered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeAnswered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBountiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBountiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBountiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBountiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBountiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBountiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerPayoutsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerPayoutsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerPayoutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerPayoutsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerPayoutsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerPayoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, RelayerPayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, Relayer{})
+			if err := m.Relayers[len(m.Relayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDatapointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDatapointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDatapointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryDatapointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDatapointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDatapointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datapoints = append(m.Datapoints, DataPoint{})
+			if err := m.Datapoints[len(m.Datapoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryDatapointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDatapointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDatapointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDatapointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -609,15 +4196,15 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDatapointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDatapointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -644,14 +4231,61 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -683,6 +4317,25 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -704,7 +4357,7 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -727,15 +4380,15 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -762,16 +4415,65 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queries = append(m.Queries, Query{})
-			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -781,28 +4483,138 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Queries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Queries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			m.Quarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quarantined |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingEmission", wireType)
+			}
+			m.OldestPendingEmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestPendingEmission |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			m.Responses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Responses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsBonded returns true if the relayer is not jailed, and holds at least the minimum bond.
+func (r Relayer) IsBonded(minBond sdk.Coin) bool {
+	return !r.Jailed && r.Bond.AmountOf(minBond.Denom).GTE(minBond.Amount)
+}
+
+// IsProven returns true if responses to the query type carry a proof that is verified against the light client of
+// the remote chain. Responses to other query types are accepted only from bonded relayers.
+func IsProven(queryType string) bool {
+	return strings.HasSuffix(queryType, "/key") || IsSubspace(queryType)
+}
+
+// IsSubspace returns true if the query type requests every key/value pair stored under a prefix, of the form
+// store/<module>/subspace.
+func IsSubspace(queryType string) bool {
+	return strings.HasSuffix(queryType, "/subspace")
+}
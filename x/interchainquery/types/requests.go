@@ -0,0 +1,56 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// requestTypes maps the query types issued by this chain to constructors of their request messages.
+var requestTypes = map[string]func() codec.ProtoMarshaler{
+	"cosmos.bank.v1beta1.Query/AllBalances":                    func() codec.ProtoMarshaler { return &banktypes.QueryAllBalancesRequest{} },
+	"cosmos.base.tendermint.v1beta1.Service/GetLatestBlock":    func() codec.ProtoMarshaler { return &tmservice.GetLatestBlockRequest{} },
+	"cosmos.distribution.v1beta1.Query/DelegationTotalRewards": func() codec.ProtoMarshaler { return &distrtypes.QueryDelegationTotalRewardsRequest{} },
+	"cosmos.gov.v1beta1.Query/Proposals":                       func() codec.ProtoMarshaler { return &govtypes.QueryProposalsRequest{} },
+	"cosmos.staking.v1beta1.Query/DelegatorDelegations":        func() codec.ProtoMarshaler { return &stakingtypes.QueryDelegatorDelegationsRequest{} },
+	"cosmos.staking.v1beta1.Query/Validator":                   func() codec.ProtoMarshaler { return &stakingtypes.QueryValidatorRequest{} },
+	"cosmos.staking.v1beta1.Query/Validators":                  func() codec.ProtoMarshaler { return &stakingtypes.QueryValidatorsRequest{} },
+	"cosmos.tx.v1beta1.Service/GetTxsEvent":                    func() codec.ProtoMarshaler { return &tx.GetTxsEventRequest{} },
+}
+
+// DecodeRequest returns the request of a query as readable JSON. Requests of known query types are decoded into
+// their request messages, and the keys of store queries into their store and hex encoded key, or prefix for subspace
+// queries; any other request is hex encoded.
+func DecodeRequest(cdc codec.JSONCodec, queryType string, request []byte) json.RawMessage {
+	if newRequest, ok := requestTypes[queryType]; ok {
+		msg := newRequest()
+		if err := msg.Unmarshal(request); err == nil {
+			if bz, err := cdc.MarshalJSON(msg); err == nil {
+				return bz
+			}
+		}
+	}
+
+	if IsProven(queryType) {
+		parts := strings.Split(queryType, "/")
+		if len(parts) == 3 && parts[0] == "store" {
+			field := "key"
+			if IsSubspace(queryType) {
+				field = "prefix"
+			}
+			bz, _ := json.Marshal(map[string]string{"store": parts[1], field: hex.EncodeToString(request)})
+			return bz
+		}
+	}
+
+	bz, _ := json.Marshal(hex.EncodeToString(request))
+	return bz
+}
@@ -0,0 +1,24 @@
+package types_test
+
+import (
+	"testing"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/app"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+func TestDecodeRequest(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	request := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := request.Marshal()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"status":"BOND_STATUS_BONDED","pagination":null}`, string(types.DecodeRequest(cdc, "cosmos.staking.v1beta1.Query/Validators", bz)))
+
+	require.JSONEq(t, `{"store":"bank","key":"0102"}`, string(types.DecodeRequest(cdc, "store/bank/key", []byte{1, 2})))
+	require.JSONEq(t, `{"store":"staking","prefix":"21"}`, string(types.DecodeRequest(cdc, "store/staking/subspace", []byte{0x21})))
+	require.JSONEq(t, `"0102"`, string(types.DecodeRequest(cdc, "unknown", []byte{1, 2})))
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -28,6 +29,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdZonesInfos(),
 		GetDelegatorIntentCmd(),
 		GetDepositAccountCmd(),
+		GetCuratorsCmd(),
+		GetFollowersCmd(),
+		GetHostProposalsCmd(),
+		GetHostProposalTallyCmd(),
+		GetUnbondingCapacityCmd(),
+		GetLiquidityBufferCmd(),
 	)
 
 	return cmd
@@ -145,3 +152,202 @@ func GetDepositAccountCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetCuratorsCmd returns the curators followed by at least one delegator for
+// the given chainID (zone).
+func GetCuratorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "curators [chain_id]",
+		Short: "Query intent curators for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCuratorsRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.Curators(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetFollowersCmd returns the delegators following the given curator for the
+// given chainID (zone).
+func GetFollowersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "followers [chain_id] [curator_addr]",
+		Short: "Query delegators following a curator for a given chain.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryFollowersRequest{
+				ChainId:        args[0],
+				CuratorAddress: args[1],
+			}
+
+			res, err := queryClient.Followers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetHostProposalsCmd returns the host zone governance proposals open for
+// pass-through voting for the given chainID (zone).
+func GetHostProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals [chain_id]",
+		Short: "Query host governance proposals for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryHostProposalsRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.HostProposals(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetHostProposalTallyCmd returns the qAsset weighted tally for the given host
+// zone governance proposal.
+func GetHostProposalTallyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally [chain_id] [proposal_id]",
+		Short: "Query the tally of votes for a host governance proposal.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s not a valid uint, please input a valid proposal id", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryHostProposalTallyRequest{
+				ChainId:    args[0],
+				ProposalId: proposalID,
+			}
+
+			res, err := queryClient.HostProposalTally(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUnbondingCapacityCmd returns the open and remaining unbonding entries of
+// each delegator/validator pair of the given zone.
+func GetUnbondingCapacityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-capacity [chain_id]",
+		Short: "Query the remaining unbonding entries of each delegator/validator pair for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryUnbondingCapacityRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.UnbondingCapacity(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetLiquidityBufferCmd returns the balance, target, utilisation and fee of
+// the instant redemption liquidity buffer of the given zone.
+func GetLiquidityBufferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-buffer [chain_id]",
+		Short: "Query the instant redemption liquidity buffer for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryLiquidityBufferRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.LiquidityBuffer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -31,6 +33,9 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(GetSignalIntentTxCmd())
 	txCmd.AddCommand(GetRequestRedemptionTxCmd())
+	txCmd.AddCommand(GetInstantRedeemTxCmd())
+	txCmd.AddCommand(GetDelegateIntentTxCmd())
+	txCmd.AddCommand(GetVoteOnHostProposalTxCmd())
 
 	return txCmd
 }
@@ -68,12 +73,78 @@ e.g. "0.3cosmosvaloper1xxxxxxxxx,0.3cosmosvaloper1yyyyyyyyy,0.4cosmosvaloper1zzz
 	return cmd
 }
 
+// GetDelegateIntentTxCmd returns a CLI command handler for following the
+// delegation intent of a curator.
+func GetDelegateIntentTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-intent [chainID] [curator_address]",
+		Short: `Follow the validator delegation intent of a curator.`,
+		Long: `follow the validator delegation intent of a curator address; the curator's
+intent will be used in place of your own. Pass an empty curator address ("")
+to stop following a curator.`,
+		Example: `delegate-intent [chain_id] quick1xxxxxxxxx`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateIntent(args[0], args[1], clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetVoteOnHostProposalTxCmd returns a CLI command handler for voting on a
+// host zone governance proposal.
+func GetVoteOnHostProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [chainID] [proposal_id] [options]",
+		Short: `Vote on a host zone governance proposal.`,
+		Long: `vote on a governance proposal of a host zone, weighted by your qAsset balance.
+Options may be a single option, or a comma separated list of weighted options,
+e.g. "yes" or "yes=0.6,no=0.3,abstain=0.1"`,
+		Example: `vote [chain_id] 1 yes=0.6,no=0.3,abstain=0.1`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s not a valid uint, please input a valid proposal id", args[1])
+			}
+
+			options, err := govtypes.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[2]))
+			if err != nil {
+				return fmt.Errorf("%v, see example: %v", err, cmd.Example)
+			}
+
+			msg := types.NewMsgVoteOnHostProposal(args[0], proposalID, options, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetRequestRedemptionTxCmd returns a CLI command handler for creating a Request transaction.
 func GetRequestRedemptionTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "redeem [coin] [destination_address]",
 		Short: `Redeem tokens.`,
-		Args:  cobra.ExactArgs(2),
+		Long: `redeem qAssets for native tokens, sent to destination_address on the host chain;
+if destination_address is a Quicksilver address, the native tokens are returned to it over IBC.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -96,6 +167,36 @@ func GetRequestRedemptionTxCmd() *cobra.Command {
 	return cmd
 }
 
+// GetInstantRedeemTxCmd returns a CLI command handler for creating an InstantRedeem transaction.
+func GetInstantRedeemTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-redeem [coin] [destination_address]",
+		Short: `Redeem tokens from the liquidity buffer.`,
+		Long: `redeem qAssets for native tokens paid immediately from the zone's liquidity buffer, less the instant
+redemption fee; destination_address may be a host chain or Quicksilver address, as for redeem.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			destinationAddress := args[1]
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to parse coin %s", args[0])
+			}
+
+			msg := types.NewMsgInstantRedeem(coin, destinationAddress, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitRegisterProposal implements the command to submit a register-zone proposal
 func GetCmdSubmitRegisterProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -117,8 +218,14 @@ Where proposal.json contains:
   "account_prefix": "cosmos",
   "multi_send": true,
   "liquidity_module": false,
+  "params": {
+      "deposit_interval": "10",
+      "validator_set_interval": "0",
+      "delegate_account_count": "20"
+  },
   "deposit": "512000000uqck"
 }
+Zero params, or params omitted, take the module parameter of the same name.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -149,6 +256,7 @@ Where proposal.json contains:
 
 			content := types.NewRegisterZoneProposal(proposal.Title, proposal.Description, proposal.ConnectionId, proposal.BaseDenom,
 				proposal.LocalDenom, proposal.AccountPrefix, proposal.MultiSend, proposal.LiquidityModule)
+			content.Params = proposal.Params
 
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
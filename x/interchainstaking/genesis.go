@@ -54,6 +54,34 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, intentDelegationsForZone := range genState.IntentDelegations {
+		zone, found := k.GetZone(ctx, intentDelegationsForZone.ChainId)
+		if !found {
+			panic("unable to find zone for intent delegation")
+		}
+		for _, intentDelegation := range intentDelegationsForZone.IntentDelegations {
+			k.SetIntentDelegation(ctx, zone, intentDelegation)
+		}
+	}
+
+	for _, proposal := range genState.HostProposals {
+		if _, found := k.GetZone(ctx, proposal.ChainId); !found {
+			panic("unable to find zone for host proposal")
+		}
+		k.SetHostProposal(ctx, proposal)
+	}
+
+	for _, vote := range genState.HostVotes {
+		if _, found := k.GetZone(ctx, vote.ChainId); !found {
+			panic("unable to find zone for host vote")
+		}
+		k.SetHostVote(ctx, vote)
+	}
+
+	for _, operation := range genState.PendingOperations {
+		k.SetPendingOperation(ctx, operation)
+	}
+
 	for _, receipt := range genState.Receipts {
 		k.SetReceipt(ctx, receipt)
 	}
@@ -61,14 +89,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	hostProposals := make([]types.HostProposal, 0)
+	hostVotes := make([]types.HostVote, 0)
+	k.IterateZones(ctx, func(_ int64, zoneInfo types.Zone) (stop bool) {
+		for _, proposal := range k.AllHostProposals(ctx, zoneInfo) {
+			hostProposals = append(hostProposals, proposal)
+			hostVotes = append(hostVotes, k.AllHostVotes(ctx, zoneInfo, proposal.ProposalId)...)
+		}
+		return false
+	})
+
 	return &types.GenesisState{
-		Params:           k.GetParams(ctx),
-		Zones:            k.AllZones(ctx),
-		Receipts:         k.AllReceipts(ctx),
-		Delegations:      ExportDelegationsPerZone(ctx, k),
-		DelegationPlans:  ExportDelegationPlansPerZone(ctx, k),
-		DelegatorIntents: ExportDelegatorIntentsPerZone(ctx, k),
-		PortConnections:  k.AllPortConnections(ctx),
+		Params:            k.GetParams(ctx),
+		Zones:             k.AllZones(ctx),
+		Receipts:          k.AllReceipts(ctx),
+		Delegations:       ExportDelegationsPerZone(ctx, k),
+		DelegationPlans:   ExportDelegationPlansPerZone(ctx, k),
+		DelegatorIntents:  ExportDelegatorIntentsPerZone(ctx, k),
+		PortConnections:   k.AllPortConnections(ctx),
+		IntentDelegations: ExportIntentDelegationsPerZone(ctx, k),
+		HostProposals:     hostProposals,
+		HostVotes:         hostVotes,
+		PendingOperations: k.AllPendingOperations(ctx),
 	}
 }
 
@@ -101,3 +143,12 @@ func ExportDelegatorIntentsPerZone(ctx sdk.Context, k keeper.Keeper) []types.Del
 	})
 	return delegatorIntentsForZones
 }
+
+func ExportIntentDelegationsPerZone(ctx sdk.Context, k keeper.Keeper) []types.IntentDelegationsForZone {
+	intentDelegationsForZones := make([]types.IntentDelegationsForZone, 0)
+	k.IterateZones(ctx, func(_ int64, zoneInfo types.Zone) (stop bool) {
+		intentDelegationsForZones = append(intentDelegationsForZones, types.IntentDelegationsForZone{ChainId: zoneInfo.ChainId, IntentDelegations: k.AllIntentDelegations(ctx, zoneInfo)})
+		return false
+	})
+	return intentDelegationsForZones
+}
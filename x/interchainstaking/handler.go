@@ -21,10 +21,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.RequestRedemption(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgInstantRedeem:
+			res, err := msgServer.InstantRedeem(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgSignalIntent:
 			res, err := msgServer.SignalIntent(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgDelegateIntent:
+			res, err := msgServer.DelegateIntent(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgVoteOnHostProposal:
+			res, err := msgServer.VoteOnHostProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchainstaking message type: %T", msg)
 		}
@@ -17,6 +17,10 @@ type zoneItrFn func(index int64, zoneInfo types.Zone) (stop bool)
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	k.IterateZones(ctx, func(index int64, zone types.Zone) (stop bool) {
+		// zones pending their preflight checks have no accounts other than the deposit account.
+		if !zone.IsActive() {
+			return false
+		}
 		if ctx.BlockHeight()%10 == 0 {
 			if err := k.EnsureWithdrawalAddresses(ctx, &zone); err != nil {
 				k.Logger(ctx).Error(err.Error())
@@ -32,6 +36,10 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 				// we don't return on failure here as we still want to attempt
 				// the unrelated tasks below.
 			}
+			if err := k.CastHostVotes(ctx, &zone); err != nil {
+				k.Logger(ctx).Error(err.Error())
+				// failing to cast votes is not terminal; log and continue.
+			}
 		}
 		connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
 		if found {
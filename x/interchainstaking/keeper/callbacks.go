@@ -7,12 +7,15 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	tmclienttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
@@ -28,15 +31,22 @@ import (
 // Callbacks wrapper struct for interchainstaking keeper
 type Callback func(Keeper, sdk.Context, []byte, icqtypes.Query) error
 
+// TimeoutCallback is invoked when a query remains unanswered after its final retry.
+type TimeoutCallback func(Keeper, sdk.Context, icqtypes.Query) error
+
 type Callbacks struct {
 	k         Keeper
 	callbacks map[string]Callback
+	timeouts  map[string]TimeoutCallback
 }
 
-var _ icqtypes.QueryCallbacks = Callbacks{}
+var (
+	_ icqtypes.QueryCallbacks   = Callbacks{}
+	_ icqtypes.ResponseVerifier = Callbacks{}
+)
 
 func (k Keeper) CallbackHandler() Callbacks {
-	return Callbacks{k, make(map[string]Callback)}
+	return Callbacks{k, make(map[string]Callback), make(map[string]TimeoutCallback)}
 }
 
 // callback handler
@@ -44,6 +54,215 @@ func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.
 	return c.callbacks[id](c.k, ctx, args, query)
 }
 
+// CallTimeout invokes the timeout callback registered for id, if any.
+func (c Callbacks) CallTimeout(ctx sdk.Context, id string, query icqtypes.Query) error {
+	fn, found := c.timeouts[id]
+	if !found {
+		return nil
+	}
+	return fn(c.k, ctx, query)
+}
+
+// Contradicts returns true if the proven value of a key in a remote store contradicts a response to one of the
+// module's unproven queries at the same remote height. Responses to proven queries cannot be contradicted.
+func (c Callbacks) Contradicts(ctx sdk.Context, query icqtypes.Query, result []byte, store string, key []byte, value []byte) (bool, error) {
+	if icqtypes.IsProven(query.QueryType) {
+		return false, nil
+	}
+	switch query.CallbackId {
+	case "valset":
+		return contradictsValidatorSet(c.k, result, store, key, value)
+	case "allbalances", "perfbalance", "distributerewards":
+		return contradictsAllBalances(c.k, query, result, store, key, value)
+	case "rewards":
+		return contradictsDelegationRewards(c.k, query, result, store, key, value)
+	case "depositinterval":
+		return contradictsDepositTxs(c.k, ctx, query, result, store, key, value)
+	}
+	return false, nil
+}
+
+// contradictsValidatorSet returns true if a proven validator record contradicts the same validator in a validator
+// set response. Validators omitted from the response are not treated as contradictions, as the response may be a
+// single page of the validator set.
+func contradictsValidatorSet(k Keeper, result []byte, store string, key []byte, value []byte) (bool, error) {
+	if store != stakingtypes.StoreKey || !bytes.HasPrefix(key, stakingtypes.ValidatorsKey) || len(key) < 3 {
+		return false, nil
+	}
+	operator := stakingtypes.AddressFromValidatorsKey(key)
+
+	validatorsRes := stakingtypes.QueryValidatorsResponse{}
+	if err := k.cdc.Unmarshal(result, &validatorsRes); err != nil {
+		return false, err
+	}
+	var reported *stakingtypes.Validator
+	for i, validator := range validatorsRes.Validators {
+		_, addr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+		if err == nil && bytes.Equal(addr, operator) {
+			reported = &validatorsRes.Validators[i]
+			break
+		}
+	}
+	if reported == nil {
+		return false, nil
+	}
+
+	// the response reported a validator that does not exist.
+	if len(value) == 0 {
+		return true, nil
+	}
+	proven := stakingtypes.Validator{}
+	if err := k.cdc.Unmarshal(value, &proven); err != nil {
+		return false, err
+	}
+
+	return !reported.Tokens.Equal(proven.Tokens) ||
+		!reported.DelegatorShares.Equal(proven.DelegatorShares) ||
+		!reported.GetCommission().Equal(proven.GetCommission()) ||
+		reported.Status != proven.Status ||
+		reported.Jailed != proven.Jailed, nil
+}
+
+// contradictsAllBalances returns true if a proven balance of the queried account differs from the amount of the same
+// denom in a balances response. A denom omitted from the response is reported as zero, unless the response is a
+// single page of the balances.
+func contradictsAllBalances(k Keeper, query icqtypes.Query, result []byte, store string, key []byte, value []byte) (bool, error) {
+	if store != banktypes.StoreKey || !bytes.HasPrefix(key, banktypes.BalancesPrefix) {
+		return false, nil
+	}
+	addr, err := banktypes.AddressFromBalancesStore(key[len(banktypes.BalancesPrefix):])
+	if err != nil {
+		return false, nil
+	}
+	denom := string(key[len(banktypes.BalancesPrefix)+1+len(addr):])
+	if sdk.ValidateDenom(denom) != nil {
+		return false, nil
+	}
+
+	request := banktypes.QueryAllBalancesRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &request); err != nil {
+		return false, err
+	}
+	if _, requested, err := bech32.DecodeAndConvert(request.Address); err != nil || !bytes.Equal(requested, addr) {
+		return false, nil
+	}
+
+	balancesRes := banktypes.QueryAllBalancesResponse{}
+	if err := k.cdc.Unmarshal(result, &balancesRes); err != nil {
+		return false, err
+	}
+	// the response is not trusted to be sorted, so the denom is not looked up with AmountOf.
+	reported, found := sdk.ZeroInt(), false
+	for _, coin := range balancesRes.Balances {
+		if coin.Denom == denom {
+			reported, found = reported.Add(coin.Amount), true
+		}
+	}
+	if !found && balancesRes.Pagination != nil && len(balancesRes.Pagination.NextKey) != 0 {
+		return false, nil
+	}
+
+	proven := sdk.ZeroInt()
+	if len(value) != 0 {
+		coin := sdk.Coin{}
+		if err := k.cdc.Unmarshal(value, &coin); err != nil {
+			return false, err
+		}
+		proven = coin.Amount
+	}
+
+	return !reported.Equal(proven), nil
+}
+
+// contradictsDelegationRewards returns true if a delegation for which a rewards response reported rewards is proven
+// not to exist.
+func contradictsDelegationRewards(k Keeper, query icqtypes.Query, result []byte, store string, key []byte, value []byte) (bool, error) {
+	if store != stakingtypes.StoreKey || !bytes.HasPrefix(key, stakingtypes.DelegationKey) || len(value) != 0 {
+		return false, nil
+	}
+
+	request := distrtypes.QueryDelegationTotalRewardsRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &request); err != nil {
+		return false, err
+	}
+	_, delegator, err := bech32.DecodeAndConvert(request.DelegatorAddress)
+	if err != nil {
+		return false, nil
+	}
+
+	rewardsRes := distrtypes.QueryDelegationTotalRewardsResponse{}
+	if err := k.cdc.Unmarshal(result, &rewardsRes); err != nil {
+		return false, err
+	}
+	for _, reward := range rewardsRes.Rewards {
+		_, validator, err := bech32.DecodeAndConvert(reward.ValidatorAddress)
+		if err == nil && bytes.Equal(key, stakingtypes.GetDelegationKey(delegator, validator)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// contradictsDepositTxs returns true if a proven account contradicts a transaction, reported in a deposit
+// transactions response, that transferred coins to the deposit account of the zone: the sender of the transfer
+// does not exist, or a signer of the transaction has not yet used its sequence.
+func contradictsDepositTxs(k Keeper, ctx sdk.Context, query icqtypes.Query, result []byte, store string, key []byte, value []byte) (bool, error) {
+	if store != authtypes.StoreKey || !bytes.HasPrefix(key, authtypes.AddressStoreKeyPrefix) {
+		return false, nil
+	}
+	addr := key[len(authtypes.AddressStoreKeyPrefix):]
+
+	zone, found := k.GetZone(ctx, query.ChainId)
+	if !found || zone.DepositAddress == nil {
+		return false, nil
+	}
+
+	txs := tx.GetTxsEventResponse{}
+	if err := k.cdc.Unmarshal(result, &txs); err != nil {
+		return false, err
+	}
+
+	var account authtypes.AccountI
+	if len(value) != 0 {
+		if err := k.cdc.UnmarshalInterface(value, &account); err != nil {
+			return false, err
+		}
+	}
+
+	for idx, txr := range txs.TxResponses {
+		deposit := false
+		for _, event := range txr.Events {
+			if event.Type != "transfer" {
+				continue
+			}
+			attrs := attributesToMap(event.Attributes)
+			if attrs["recipient"] != zone.DepositAddress.Address {
+				continue
+			}
+			deposit = true
+			if _, sender, err := bech32.DecodeAndConvert(attrs["sender"]); err == nil && bytes.Equal(sender, addr) && account == nil {
+				return true, nil
+			}
+		}
+		if !deposit || idx >= len(txs.Txs) || txs.Txs[idx].AuthInfo == nil {
+			continue
+		}
+		for _, signer := range txs.Txs[idx].AuthInfo.SignerInfos {
+			if signer.PublicKey == nil {
+				continue
+			}
+			var pubKey cryptotypes.PubKey
+			if err := k.cdc.UnpackAny(signer.PublicKey, &pubKey); err != nil || !bytes.Equal(pubKey.Address(), addr) {
+				continue
+			}
+			if account == nil || account.GetSequence() <= signer.Sequence {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func (c Callbacks) Has(id string) bool {
 	_, found := c.callbacks[id]
 	return found
@@ -54,6 +273,11 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 	return c
 }
 
+func (c Callbacks) AddTimeoutCallback(id string, fn TimeoutCallback) Callbacks {
+	c.timeouts[id] = fn
+	return c
+}
+
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
 		AddCallback("valset", Callback(ValsetCallback)).
@@ -61,15 +285,23 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback("rewards", Callback(RewardsCallback)).
 		AddCallback("delegations", Callback(DelegationsCallback)).
 		AddCallback("delegation", Callback(DelegationCallback)).
+		AddCallback("unbondingdelegation", Callback(UnbondingDelegationCallback)).
 		AddCallback("distributerewards", Callback(DistributeRewardsFromWithdrawAccount)).
 		AddCallback("depositinterval", Callback(DepositIntervalCallback)).
 		AddCallback("deposittx", Callback(DepositTx)).
 		AddCallback("perfbalance", Callback(PerfBalanceCallback)).
 		AddCallback("accountbalance", Callback(AccountBalanceCallback)).
 		AddCallback("allbalances", Callback(AllBalancesCallback)).
-		AddCallback("epochblock", Callback(SetEpochBlockCallback))
-
-	return a.(Callbacks)
+		AddCallback("epochblock", Callback(SetEpochBlockCallback)).
+		AddCallback("govproposals", Callback(GovProposalsCallback)).
+		AddCallback("preflightbonddenom", Callback(BondDenomPreflightCallback)).
+		AddCallback("preflightallowedmessages", Callback(AllowedMessagesPreflightCallback))
+
+	return a.(Callbacks).
+		AddTimeoutCallback("rewards", RewardsTimeoutCallback).
+		AddTimeoutCallback("delegations", DelegationsTimeoutCallback).
+		AddTimeoutCallback("preflightbonddenom", PreflightTimeoutCallback).
+		AddTimeoutCallback("preflightallowedmessages", PreflightTimeoutCallback)
 }
 
 // -----------------------------------
@@ -81,7 +313,7 @@ func ValsetCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
-	return SetValidatorsForZone(k, ctx, zone, args)
+	return SetValidatorSetForZone(k, ctx, zone, args)
 }
 
 // SetEpochBlockCallback records the block height of the registered zone at the epoch boundary.
@@ -110,6 +342,10 @@ func ValidatorCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Qu
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
+	// an empty payload is a proof that the validator no longer exists on the host.
+	if len(args) == 0 {
+		return k.HandleValidatorNonMembership(ctx, zone, query.Request)
+	}
 	return SetValidatorForZone(k, ctx, zone, args)
 }
 
@@ -138,12 +374,58 @@ func RewardsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Quer
 	return k.WithdrawDelegationRewardsForResponse(ctx, &zone, rewardsQuery.DelegatorAddress, args)
 }
 
+// RewardsTimeoutCallback re-issues a delegation rewards query that went unanswered, so that the withdrawal
+// waitgroup incremented for it in AfterEpochEnd is not left waiting on a query that no longer exists.
+func RewardsTimeoutCallback(k Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	// release the waitgroup held by the timed out query...
+	if zone.WithdrawalWaitgroup > 0 {
+		zone.WithdrawalWaitgroup--
+	}
+
+	// ...and take it again for the re-issued query.
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		query.ConnectionId,
+		query.ChainId,
+		query.QueryType,
+		query.Request,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"rewards",
+		0,
+		0,
+		sdk.Coin{},
+	)
+	zone.WithdrawalWaitgroup++
+
+	k.Logger(ctx).Info("QueryDelegationRewards timed out; re-issued query", "wg", zone.WithdrawalWaitgroup, "id", query.Id)
+	k.SetZone(ctx, &zone)
+	return nil
+}
+
 func DelegationsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	zone, found := k.GetZone(ctx, query.GetChainId())
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
 
+	if icqtypes.IsSubspace(query.QueryType) {
+		delAddr, err := types.ParseStakingDelegationsKey(query.Request)
+		if err != nil {
+			return err
+		}
+		delegator, err := bech32.ConvertAndEncode(zone.AccountPrefix, delAddr)
+		if err != nil {
+			return err
+		}
+		return k.SetDelegationRecordsForAddress(ctx, &zone, delegator, args)
+	}
+
 	delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{}
 	if bytes.Equal(query.Request, []byte("")) {
 		return fmt.Errorf("attempted to unmarshal zero length byte slice (3)")
@@ -156,6 +438,30 @@ func DelegationsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.
 	return k.UpdateDelegationRecordsForAddress(ctx, &zone, delegationQuery.DelegatorAddr, args)
 }
 
+// DelegationsTimeoutCallback re-issues a delegator delegations query that went unanswered.
+func DelegationsTimeoutCallback(k Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	if _, found := k.GetZone(ctx, query.GetChainId()); !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		query.ConnectionId,
+		query.ChainId,
+		query.QueryType,
+		query.Request,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"delegations",
+		0,
+		0,
+		k.queryBounty(ctx),
+	)
+
+	k.Logger(ctx).Info("QueryDelegatorDelegations timed out; re-issued query", "id", query.Id)
+	return nil
+}
+
 func DelegationCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	zone, found := k.GetZone(ctx, query.GetChainId())
 	if !found {
@@ -202,6 +508,54 @@ func DelegationCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Q
 	return k.UpdateDelegationRecordForAddress(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress, sdk.NewCoin(zone.BaseDenom, val.SharesToTokens(delegation.Shares)), &zone, true)
 }
 
+// UnbondingDelegationCallback records the unbonding entries of a delegator/validator pair on the host chain, and
+// attributes any slashing of those entries to the withdrawal records they pay out.
+func UnbondingDelegationCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	delegator, validator, err := types.ParseStakingUnbondingDelegationKey(query.Request)
+	if err != nil {
+		return err
+	}
+	delegatorAddress, err := bech32.ConvertAndEncode(zone.GetAccountPrefix(), delegator)
+	if err != nil {
+		return err
+	}
+	validatorAddress, err := bech32.ConvertAndEncode(zone.GetAccountPrefix()+"valoper", validator)
+	if err != nil {
+		return err
+	}
+
+	unbondingDelegation := stakingtypes.UnbondingDelegation{}
+	// the unbonding delegation is nil once all entries have completed, so explicitly DON'T guard against this.
+	if err := k.cdc.Unmarshal(args, &unbondingDelegation); err != nil {
+		return err
+	}
+
+	k.attributeUnbondingSlashes(ctx, &zone, delegatorAddress, validatorAddress, unbondingDelegation.Entries)
+
+	if len(unbondingDelegation.Entries) == 0 {
+		k.DeleteUnbondingRecord(ctx, &zone, delegatorAddress, validatorAddress)
+		return nil
+	}
+
+	record := types.UnbondingRecord{
+		ChainId:         zone.ChainId,
+		Delegator:       delegatorAddress,
+		Validator:       validatorAddress,
+		CompletionTimes: make([]time.Time, 0, len(unbondingDelegation.Entries)),
+		Height:          ctx.BlockHeight(),
+	}
+	for _, entry := range unbondingDelegation.Entries {
+		record.CompletionTimes = append(record.CompletionTimes, entry.CompletionTime)
+	}
+	k.SetUnbondingRecord(ctx, &record)
+	return nil
+}
+
 func PerfBalanceCallback(k Keeper, ctx sdk.Context, response []byte, query icqtypes.Query) error {
 	zone, found := k.GetZone(ctx, query.GetChainId())
 	if !found {
@@ -246,7 +600,7 @@ func DepositIntervalCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 		}
 		req.Pagination.Offset += req.Pagination.Limit
 
-		k.ICQKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, "cosmos.tx.v1beta1.Service/GetTxsEvent", k.cdc.MustMarshal(&req), sdk.NewInt(-1), types.ModuleName, "depositinterval", 0)
+		k.ICQKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, "cosmos.tx.v1beta1.Service/GetTxsEvent", k.cdc.MustMarshal(&req), sdk.NewInt(-1), types.ModuleName, "depositinterval", 0, 0, sdk.Coin{})
 	}
 
 	for idx, txn := range txs.TxResponses {
@@ -479,7 +833,7 @@ func AccountBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtyp
 		return err
 	}
 
-	return SetAccountBalanceForDenom(k, ctx, zone, address, coin)
+	return SetAccountBalanceForDenom(k, ctx, zone, address, coin, query.Height)
 }
 
 func AllBalancesCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
@@ -507,6 +861,22 @@ func AllBalancesCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.
 	return k.SetAccountBalance(ctx, zone, balanceQuery.Address, args)
 }
 
+func GovProposalsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	// proposal content may be of types unknown to quicksilver, so we avoid unpacking interfaces here.
+	response := govtypes.QueryProposalsResponse{}
+	if err := response.Unmarshal(args); err != nil {
+		return err
+	}
+
+	k.SetHostProposalsForZone(ctx, zone, response)
+	return nil
+}
+
 func coinFromRequestKey(query []byte, accAddr sdk.AccAddress) (sdk.Coin, error) {
 	idx := bytes.Index(query, accAddr)
 	if idx == -1 {
@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	querytypes "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icqkeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestBalancesResponseContradicts(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	verifier := kpr.CallbackHandler()
+
+	addr := utils.GenerateAccAddressForTest()
+	address, err := bech32.ConvertAndEncode("cosmos", addr)
+	require.NoError(t, err)
+	query := icqtypes.Query{
+		QueryType:  "cosmos.bank.v1beta1.Query/AllBalances",
+		CallbackId: "allbalances",
+		Request:    app.AppCodec().MustMarshal(&banktypes.QueryAllBalancesRequest{Address: address}),
+	}
+	response := banktypes.QueryAllBalancesResponse{Balances: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))}
+
+	contradicts := func(denom string, proven *sdk.Coin) bool {
+		value := []byte{}
+		if proven != nil {
+			value = app.AppCodec().MustMarshal(proven)
+		}
+		key := append(banktypes.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+		contradicted, err := verifier.Contradicts(ctx, query, app.AppCodec().MustMarshal(&response), banktypes.StoreKey, key, value)
+		require.NoError(t, err)
+		return contradicted
+	}
+	coin := func(denom string, amount int64) *sdk.Coin {
+		c := sdk.NewInt64Coin(denom, amount)
+		return &c
+	}
+
+	// 1. a matching balance, or a proven absent balance of an omitted denom, does not contradict the response.
+	require.False(t, contradicts("uatom", coin("uatom", 100)))
+	require.False(t, contradicts("uosmo", nil))
+
+	// 2. another balance of a reported denom, or a balance of an omitted denom, does.
+	require.True(t, contradicts("uatom", coin("uatom", 90)))
+	require.True(t, contradicts("uatom", nil))
+	require.True(t, contradicts("uosmo", coin("uosmo", 5)))
+
+	// 3. a denom omitted from a single page of the balances is not verified.
+	response.Pagination = &querytypes.PageResponse{NextKey: []byte("uosmo")}
+	require.False(t, contradicts("uosmo", coin("uosmo", 5)))
+	require.True(t, contradicts("uatom", coin("uatom", 90)))
+
+	// 4. balances of other accounts are not verified.
+	other := append(banktypes.CreateAccountBalancesPrefix(utils.GenerateAccAddressForTest()), []byte("uatom")...)
+	contradicted, err := verifier.Contradicts(ctx, query, app.AppCodec().MustMarshal(&response), banktypes.StoreKey, other, []byte{})
+	require.NoError(t, err)
+	require.False(t, contradicted)
+}
+
+func TestRewardsResponseContradicts(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	verifier := kpr.CallbackHandler()
+
+	delegator := utils.GenerateAccAddressForTest()
+	delegatorAddress, err := bech32.ConvertAndEncode("cosmos", delegator)
+	require.NoError(t, err)
+	validator := utils.GenerateValAddressForTest()
+	valoper, err := bech32.ConvertAndEncode("cosmosvaloper", validator)
+	require.NoError(t, err)
+
+	query := icqtypes.Query{
+		QueryType:  "cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
+		CallbackId: "rewards",
+		Request:    app.AppCodec().MustMarshal(&distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegatorAddress}),
+	}
+	result := app.AppCodec().MustMarshal(&distrtypes.QueryDelegationTotalRewardsResponse{
+		Rewards: []distrtypes.DelegationDelegatorReward{{ValidatorAddress: valoper, Reward: sdk.NewDecCoins(sdk.NewInt64DecCoin("uatom", 10))}},
+	})
+
+	contradicts := func(key []byte, value []byte) bool {
+		contradicted, err := verifier.Contradicts(ctx, query, result, stakingtypes.StoreKey, key, value)
+		require.NoError(t, err)
+		return contradicted
+	}
+
+	// a reported delegation that exists does not contradict the response; one proven absent does.
+	delegation := stakingtypes.NewDelegation(delegator, validator, sdk.NewDec(1000))
+	require.False(t, contradicts(stakingtypes.GetDelegationKey(delegator, validator), app.AppCodec().MustMarshal(&delegation)))
+	require.True(t, contradicts(stakingtypes.GetDelegationKey(delegator, validator), []byte{}))
+
+	// delegations not reported in the response are not verified.
+	require.False(t, contradicts(stakingtypes.GetDelegationKey(delegator, utils.GenerateValAddressForTest()), []byte{}))
+}
+
+func TestDepositTxsResponseContradicts(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	verifier := kpr.CallbackHandler()
+
+	depositAddress, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+	require.NoError(t, err)
+	zone := types.Zone{ChainId: "testchain-1", ConnectionId: "connection-0", AccountPrefix: "cosmos", BaseDenom: "uatom", LocalDenom: "uqatom", DepositAddress: &types.ICAAccount{Address: depositAddress}}
+	kpr.SetZone(ctx, &zone)
+
+	// a deposit signed by the sender with sequence 5.
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	sender := sdk.AccAddress(pubKey.Address())
+	senderAddress, err := bech32.ConvertAndEncode("cosmos", sender)
+	require.NoError(t, err)
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	deposit := &tx.Tx{
+		Body:     &tx.TxBody{},
+		AuthInfo: &tx.AuthInfo{SignerInfos: []*tx.SignerInfo{{PublicKey: anyPubKey, Sequence: 5}}},
+	}
+	result := app.AppCodec().MustMarshal(&tx.GetTxsEventResponse{
+		Txs: []*tx.Tx{deposit},
+		TxResponses: []*sdk.TxResponse{{
+			TxHash: "ABCD",
+			Events: []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: []byte("recipient"), Value: []byte(depositAddress)},
+				{Key: []byte("sender"), Value: []byte(senderAddress)},
+				{Key: []byte("amount"), Value: []byte("100uatom")},
+			}}},
+		}},
+	})
+	query := icqtypes.Query{ChainId: zone.ChainId, QueryType: "cosmos.tx.v1beta1.Service/GetTxsEvent", CallbackId: "depositinterval"}
+
+	contradicts := func(addr sdk.AccAddress, sequence *uint64) bool {
+		value := []byte{}
+		if sequence != nil {
+			var err error
+			value, err = app.AppCodec().MarshalInterface(authtypes.NewBaseAccount(addr, pubKey, 0, *sequence))
+			require.NoError(t, err)
+		}
+		contradicted, err := verifier.Contradicts(ctx, query, result, authtypes.StoreKey, authtypes.AddressStoreKey(addr), value)
+		require.NoError(t, err)
+		return contradicted
+	}
+	sequence := func(s uint64) *uint64 { return &s }
+
+	// 1. a sender whose account has used the signed sequence does not contradict the response.
+	require.False(t, contradicts(sender, sequence(6)))
+
+	// 2. a sender that does not exist, or has not yet used the signed sequence, does.
+	require.True(t, contradicts(sender, nil))
+	require.True(t, contradicts(sender, sequence(5)))
+
+	// 3. other accounts are not verified.
+	require.False(t, contradicts(utils.GenerateAccAddressForTest(), nil))
+}
+
+func (s *KeeperTestSuite) TestRelayerJailedForContradictedBalances() {
+	quicksilver := s.GetQuicksilverApp(s.chainA)
+	kpr := quicksilver.InterchainstakingKeeper
+	icqKeeper := quicksilver.InterchainQueryKeeper
+	ctx := s.chainA.GetContext()
+
+	// a zone of chain B, whose deposit account is chain B's sender account.
+	holder := s.chainB.SenderAccount.GetAddress()
+	depositAddress, err := bech32.ConvertAndEncode("cosmos", holder)
+	s.Require().NoError(err)
+	zone := types.Zone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, AccountPrefix: "cosmos", BaseDenom: "uatom", LocalDenom: "uqatom", DepositAddress: &types.ICAAccount{Address: depositAddress}}
+	kpr.SetZone(ctx, &zone)
+	s.Require().NoError(kpr.EmitDepositBalanceQuery(ctx, &zone))
+	request := quicksilver.AppCodec().MustMarshal(&banktypes.QueryAllBalancesRequest{Address: depositAddress})
+	id := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "cosmos.bank.v1beta1.Query/AllBalances", request, types.ModuleName)
+	_, found := icqKeeper.GetQuery(ctx, id)
+	s.Require().True(found)
+
+	// a bonded registered relayer.
+	relayer := utils.GenerateAccAddressForTest()
+	bond := sdk.NewCoins(icqKeeper.GetParams(ctx).MinRelayerBond)
+	s.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, bond))
+	s.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, relayer, bond))
+	icqKeeper.RegisterRelayer(ctx, relayer)
+	s.Require().NoError(icqKeeper.BondRelayer(ctx, relayer, bond))
+
+	// the balance of the deposit account, proven at the latest committed height of chain B.
+	denom := s.GetQuicksilverApp(s.chainB).BankKeeper.GetAllBalances(s.chainB.GetContext(), holder)[0].Denom
+	key := append(banktypes.CreateAccountBalancesPrefix(holder), []byte(denom)...)
+	height := s.chainB.LastHeader.Header.Height - 1
+	res := s.chainB.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", banktypes.StoreKey),
+		Height: height,
+		Data:   key,
+		Prove:  true,
+	})
+	s.Require().NotEmpty(res.Value)
+	proven := sdk.Coin{}
+	s.Require().NoError(quicksilver.AppCodec().Unmarshal(res.Value, &proven))
+
+	// the relayer inflates the balance of the deposit account; the response is accepted.
+	forged := banktypes.QueryAllBalancesResponse{Balances: sdk.NewCoins(proven.AddAmount(sdk.NewInt(1000)))}
+	msgSrv := icqkeeper.NewMsgServerImpl(icqKeeper)
+	_, err = msgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     zone.ChainId,
+		QueryId:     id,
+		Result:      quicksilver.AppCodec().MustMarshal(&forged),
+		Height:      height,
+		FromAddress: relayer.String(),
+	})
+	s.Require().NoError(err)
+	_, found = icqKeeper.GetResponseRecord(ctx, id, height)
+	s.Require().True(found)
+
+	// the proven balance contradicts the response, and jails the relayer.
+	_, err = msgSrv.SubmitResponseEvidence(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitResponseEvidence{
+		QueryId:     id,
+		Height:      height,
+		Store:       banktypes.StoreKey,
+		Key:         key,
+		Value:       res.Value,
+		ProofOps:    res.ProofOps,
+		FromAddress: relayer.String(),
+	})
+	s.Require().NoError(err)
+	jailed, found := icqKeeper.GetRelayer(ctx, relayer)
+	s.Require().True(found)
+	s.Require().True(jailed.Jailed)
+	s.Require().False(icqKeeper.IsBondedRelayer(ctx, relayer.String()))
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	distrTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -116,6 +119,74 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, zone *types.Zone, delegation t
 	return nil
 }
 
+// EmitDelegatorDelegationsQuery queries every delegation of a delegator on the host, with a proof that the set is
+// complete.
+func (k Keeper) EmitDelegatorDelegationsQuery(ctx sdk.Context, zone *types.Zone, delegator string) error {
+	_, delAddr, err := bech32.DecodeAndConvert(delegator)
+	if err != nil {
+		return err
+	}
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"store/staking/subspace",
+		stakingTypes.GetDelegationsKey(delAddr),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"delegations",
+		0,
+		0,
+		k.queryBounty(ctx),
+	)
+	return nil
+}
+
+// SetDelegationRecordsForAddress sets the delegation records of a delegator from its proven, complete set of host
+// delegations: the key/value pairs of a subspace query of its staking store delegations. Records of delegations
+// absent from the set, or with no shares, are removed.
+func (k *Keeper) SetDelegationRecordsForAddress(ctx sdk.Context, zone *types.Zone, delegatorAddress string, args []byte) error {
+	var pairs kv.Pairs
+	if err := pairs.Unmarshal(args); err != nil {
+		return err
+	}
+	_, delAddr, err := bech32.DecodeAndConvert(delegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	present := map[string]bool{}
+	for _, pair := range pairs.Pairs {
+		delegation := stakingTypes.Delegation{}
+		if err := k.cdc.Unmarshal(pair.Value, &delegation); err != nil {
+			return err
+		}
+		if delegation.Shares.IsNil() || delegation.Shares.IsZero() {
+			continue
+		}
+		val, found := zone.GetValidatorByValoper(delegation.ValidatorAddress)
+		if !found {
+			return fmt.Errorf("unable to get validator: %s", delegation.ValidatorAddress)
+		}
+		present[delegation.ValidatorAddress] = true
+		if err := k.UpdateDelegationRecordForAddress(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress, sdk.NewCoin(zone.BaseDenom, val.SharesToTokens(delegation.Shares)), zone, true); err != nil {
+			return err
+		}
+	}
+
+	for _, delegation := range k.GetDelegatorDelegations(ctx, zone, delAddr) {
+		if present[delegation.ValidatorAddress] {
+			continue
+		}
+		k.Logger(ctx).Info("delegation absent from host; removing", "delegator", delegation.DelegationAddress, "validator", delegation.ValidatorAddress)
+		if err := k.RemoveDelegation(ctx, zone, delegation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // IterateDelegatorDelegations iterates through one delegator's delegations.
 func (k Keeper) IterateDelegatorDelegations(ctx sdk.Context, zone *types.Zone, delegator sdk.AccAddress, cb func(delegation types.Delegation) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -144,7 +215,7 @@ func (k *Keeper) Delegate(ctx sdk.Context, zone types.Zone, account *types.ICAAc
 			}
 		}
 	}
-	return k.SubmitTx(ctx, msgs, account, "")
+	return k.SubmitTx(ctx, msgs, account, OperationMemo(ctx, "delegate", account.Address))
 }
 
 func (k Keeper) DeterminePlanForDelegation(ctx sdk.Context, zone types.Zone, amount sdk.Coins, delegator string, txhash string) (types.Allocations, error) {
@@ -184,6 +255,15 @@ func (k Keeper) DeterminePlanForDelegation(ctx sdk.Context, zone types.Zone, amo
 				delegationPlan := types.NewDelegationPlan(delegatorAddress, allocation.Address, sdk.NewCoins(coin))
 				sendPlan = sendPlan.Allocate(delegatorAddress, sdk.NewCoins(coin))
 				k.SetDelegationPlan(ctx, &zone, txhash, delegationPlan)
+				if err := ctx.EventManager().EmitTypedEvent(&types.EventDelegationPlanned{
+					ChainId:   zone.ChainId,
+					Txhash:    txhash,
+					Delegator: delegationPlan.DelegatorAddress,
+					Validator: delegationPlan.ValidatorAddress,
+					Amount:    delegationPlan.Value,
+				}); err != nil {
+					return types.Allocations{}, err
+				}
 			}
 		}
 	}
@@ -221,15 +301,13 @@ func (k *Keeper) WithdrawDelegationRewardsForResponse(ctx sdk.Context, zone *typ
 		k.SetZone(ctx, zone)
 		return nil
 	}
-	// increment withdrawal waitgroup for every withdrawal msg sent
-	// this allows us to track individual msg responses and ensure all
-	// responses have been received and handled...
-	// HandleWithdrawRewards contains the opposing decrement.
-	zone.WithdrawalWaitgroup += uint32(len(msgs))
+	// increment withdrawal waitgroup for the withdrawal operation, which may be split across several packets;
+	// completeWithdrawRewards contains the opposing decrement, once every packet has been resolved.
+	zone.WithdrawalWaitgroup++
 	k.SetZone(ctx, zone)
 	k.Logger(ctx).Info("Received WithdrawDelegationRewardsForResponse acknowledgement", "wg", zone.WithdrawalWaitgroup, "address", delegator)
 
-	return k.SubmitTx(ctx, msgs, account, "")
+	return k.SubmitTx(ctx, msgs, account, OperationMemo(ctx, "withdrawrewards", account.Address))
 }
 
 func rewardsForDelegation(delegatorRewards distrTypes.QueryDelegationTotalRewardsResponse, validator string) sdk.DecCoins {
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestSetDelegationRecordsForAddress(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	kpr.SetParams(ctx, types.DefaultParams())
+
+	valAddrs := []sdk.ValAddress{}
+	valopers := []string{}
+	for i := 0; i < 3; i++ {
+		valAddr := utils.GenerateValAddressForTest()
+		valoper, err := bech32.ConvertAndEncode("cosmosvaloper", valAddr)
+		require.NoError(t, err)
+		valAddrs = append(valAddrs, valAddr)
+		valopers = append(valopers, valoper)
+	}
+
+	// validators hold two tokens per share.
+	zone := types.Zone{ChainId: "cosmoshub-4", ConnectionId: "connection-0", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	for _, valoper := range valopers {
+		zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: valoper, CommissionRate: sdk.ZeroDec(), VotingPower: sdk.NewInt(2000), DelegatorShares: sdk.NewDec(1000), Score: sdk.ZeroDec()})
+	}
+	kpr.SetZone(ctx, &zone)
+
+	delAddr := utils.GenerateAccAddressForTest()
+	delegator, err := bech32.ConvertAndEncode("cosmos", delAddr)
+	require.NoError(t, err)
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator, valopers[0], sdk.NewCoin("uatom", sdk.NewInt(100))))
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator, valopers[1], sdk.NewCoin("uatom", sdk.NewInt(100))))
+
+	// the delegations of the delegator are queried by proven prefix, and answered by the delegations callback.
+	require.NoError(t, kpr.EmitDelegatorDelegationsQuery(ctx, &zone, delegator))
+	var query icqtypes.Query
+	for _, q := range kpr.ICQKeeper.AllQueries(ctx) {
+		if q.CallbackId == "delegations" {
+			query = q
+		}
+	}
+	require.Equal(t, "store/staking/subspace", query.QueryType)
+	require.Equal(t, stakingtypes.GetDelegationsKey(delAddr), []byte(query.Request))
+
+	pairs := kv.Pairs{}
+	for _, i := range []int{0, 2} {
+		delegation := stakingtypes.Delegation{DelegatorAddress: delegator, ValidatorAddress: valopers[i], Shares: sdk.NewDec(500)}
+		pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: stakingtypes.GetDelegationKey(delAddr, valAddrs[i]), Value: app.AppCodec().MustMarshal(&delegation)})
+	}
+	bz, err := pairs.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, icskeeper.DelegationsCallback(kpr, ctx, bz, query))
+
+	// delegations in the set are updated or added; those absent are removed.
+	delegations := kpr.GetDelegatorDelegations(ctx, &zone, delAddr)
+	require.Len(t, delegations, 2)
+	for _, i := range []int{0, 2} {
+		delegation, found := kpr.GetDelegation(ctx, &zone, delegator, valopers[i])
+		require.True(t, found)
+		require.Equal(t, sdk.NewCoin("uatom", sdk.NewInt(1000)), delegation.Amount)
+	}
+	_, found := kpr.GetDelegation(ctx, &zone, delegator, valopers[1])
+	require.False(t, found)
+
+	// a delegation to an unknown validator is rejected.
+	unknown, err := bech32.ConvertAndEncode("cosmosvaloper", utils.GenerateValAddressForTest())
+	require.NoError(t, err)
+	delegation := stakingtypes.Delegation{DelegatorAddress: delegator, ValidatorAddress: unknown, Shares: sdk.NewDec(500)}
+	bz, err = (&kv.Pairs{Pairs: []kv.Pair{{Key: []byte{0x31}, Value: app.AppCodec().MustMarshal(&delegation)}}}).Marshal()
+	require.NoError(t, err)
+	require.ErrorContains(t, kpr.SetDelegationRecordsForAddress(ctx, &zone, delegator, bz), "unable to get validator")
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// typedEvents returns the typed events of the given type emitted to the context's event manager.
+func typedEvents(t *testing.T, ctx sdk.Context, eventType proto.Message) []proto.Message {
+	t.Helper()
+
+	out := []proto.Message{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(eventType) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		out = append(out, msg)
+	}
+	return out
+}
+
+func TestLifecycleTypedEvents(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}).WithEventManager(sdk.NewEventManager())
+
+	valoper, err := bech32.ConvertAndEncode("cosmosvaloper", utils.GenerateValAddressForTest())
+	require.NoError(t, err)
+	zone := types.Zone{
+		ChainId:            "cosmoshub-4",
+		AccountPrefix:      "cosmos",
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		RedemptionRate:     sdk.MustNewDecFromStr("1.25"),
+		LastRedemptionRate: sdk.MustNewDecFromStr("1.25"),
+		Validators:         []*types.Validator{{ValoperAddress: valoper, CommissionRate: sdk.ZeroDec(), DelegatorShares: sdk.ZeroDec(), VotingPower: sdk.ZeroInt()}},
+	}
+	kpr.SetZone(ctx, &zone)
+	user := utils.GenerateAccAddressForTest()
+
+	// 1. Minting qAssets emits the deposit, the amount minted and the rate applied.
+	require.NoError(t, kpr.MintQAsset(ctx, user, zone, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))))
+	events := typedEvents(t, ctx, &types.EventQAssetMinted{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.EventQAssetMinted{
+		ChainId:        "cosmoshub-4",
+		Recipient:      user.String(),
+		Deposit:        sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))),
+		Minted:         sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(800))),
+		RedemptionRate: sdk.MustNewDecFromStr("1.25"),
+	}, events[0])
+
+	// 2. Signalling intent emits the intents signalled.
+	intents := []*types.ValidatorIntent{{ValoperAddress: valoper, Weight: sdk.OneDec()}}
+	msgSrv := icskeeper.NewMsgServerImpl(kpr)
+	_, err = msgSrv.SignalIntent(sdk.WrapSDKContext(ctx), types.NewMsgSignalIntent(zone.ChainId, intents, user))
+	require.NoError(t, err)
+	events = typedEvents(t, ctx, &types.EventIntentSignalled{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.EventIntentSignalled{ChainId: "cosmoshub-4", Delegator: user.String(), Intents: intents}, events[0])
+}
@@ -3,11 +3,13 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -25,6 +27,7 @@ func (k Keeper) ZoneInfos(c context.Context, req *types.QueryZonesInfoRequest) (
 	ctx := sdk.UnwrapSDKContext(c)
 
 	var zones []types.Zone
+	var params []types.ZoneParams
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZone)
 
 	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
@@ -33,6 +36,7 @@ func (k Keeper) ZoneInfos(c context.Context, req *types.QueryZonesInfoRequest) (
 			return err
 		}
 		zones = append(zones, zone)
+		params = append(params, k.GetEffectiveZoneParams(ctx, &zone))
 		return nil
 	})
 	if err != nil {
@@ -40,8 +44,9 @@ func (k Keeper) ZoneInfos(c context.Context, req *types.QueryZonesInfoRequest) (
 	}
 
 	return &types.QueryZonesInfoResponse{
-		Zones:      zones,
-		Pagination: pageRes,
+		Zones:           zones,
+		Pagination:      pageRes,
+		EffectiveParams: params,
 	}, nil
 }
 
@@ -186,3 +191,132 @@ func (k Keeper) WithdrawalRecords(c context.Context, req *types.QueryWithdrawalR
 
 	return &types.QueryWithdrawalRecordsResponse{Withdrawals: withdrawalrecords}, nil
 }
+
+func (k Keeper) Curators(c context.Context, req *types.QueryCuratorsRequest) (*types.QueryCuratorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	curatorMap := k.GetCurators(ctx, zone)
+	curators := make([]types.CuratorInfo, 0, len(curatorMap))
+	for curator, count := range curatorMap {
+		curators = append(curators, types.CuratorInfo{Curator: curator, FollowerCount: count})
+	}
+	sort.Slice(curators, func(i, j int) bool { return curators[i].Curator < curators[j].Curator })
+
+	return &types.QueryCuratorsResponse{Curators: curators}, nil
+}
+
+func (k Keeper) Followers(c context.Context, req *types.QueryFollowersRequest) (*types.QueryFollowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	return &types.QueryFollowersResponse{Followers: k.GetFollowers(ctx, zone, req.CuratorAddress)}, nil
+}
+
+func (k Keeper) HostProposals(c context.Context, req *types.QueryHostProposalsRequest) (*types.QueryHostProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	return &types.QueryHostProposalsResponse{Proposals: k.AllHostProposals(ctx, zone)}, nil
+}
+
+func (k Keeper) HostProposalTally(c context.Context, req *types.QueryHostProposalTallyRequest) (*types.QueryHostProposalTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	proposal, found := k.GetHostProposal(ctx, zone, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no proposal %d found for %s", req.ProposalId, req.GetChainId()))
+	}
+
+	tally, err := k.TallyHostVotes(ctx, zone, req.ProposalId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	get := func(option govtypes.VoteOption) sdk.Int {
+		if weight, ok := tally[option]; ok {
+			return weight.TruncateInt()
+		}
+		return sdk.ZeroInt()
+	}
+
+	return &types.QueryHostProposalTallyResponse{
+		Proposal: proposal,
+		Tally: govtypes.NewTallyResult(
+			get(govtypes.OptionYes),
+			get(govtypes.OptionAbstain),
+			get(govtypes.OptionNo),
+			get(govtypes.OptionNoWithVeto),
+		),
+	}, nil
+}
+
+func (k Keeper) UnbondingCapacity(c context.Context, req *types.QueryUnbondingCapacityRequest) (*types.QueryUnbondingCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	return &types.QueryUnbondingCapacityResponse{Capacity: k.GetUnbondingCapacity(ctx, &zone)}, nil
+}
+
+func (k Keeper) LiquidityBuffer(c context.Context, req *types.QueryLiquidityBufferRequest) (*types.QueryLiquidityBufferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	delegated := k.GetDelegatedAmount(ctx, &zone).Amount
+	return &types.QueryLiquidityBufferResponse{
+		Balance:     sdk.NewCoin(zone.BaseDenom, zone.GetLiquidityBuffer()),
+		Target:      sdk.NewCoin(zone.BaseDenom, zone.LiquidityBufferTarget(delegated)),
+		Ratio:       zone.GetLiquidityBufferRatio(),
+		Utilisation: zone.LiquidityBufferUtilisation(delegated),
+		Fee:         k.GetInstantRedemptionFee(ctx),
+	}, nil
+}
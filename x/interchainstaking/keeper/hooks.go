@@ -3,7 +3,6 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 
@@ -19,6 +18,9 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	k.Logger(ctx).Info("handling epoch end")
 	if epochIdentifier == "epoch" {
 		k.IterateZones(ctx, func(index int64, zoneInfo types.Zone) (stop bool) {
+			if !zoneInfo.IsActive() {
+				return false
+			}
 			blockQuery := tmservice.GetLatestBlockRequest{}
 			bz := k.cdc.MustMarshal(&blockQuery)
 
@@ -32,8 +34,13 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 				types.ModuleName,
 				"epochblock",
 				0,
+				0,
+				sdk.Coin{},
 			)
 
+			// ensure host governance proposals are being polled; re-requesting an existing query is harmless.
+			k.EmitGovProposalsQuery(ctx, zoneInfo)
+
 			k.Logger(ctx).Info("taking a snapshot of intents")
 			err := k.AggregateIntents(ctx, zoneInfo)
 			if err != nil {
@@ -49,24 +56,14 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			for _, da := range zoneInfo.GetDelegationAccounts() {
 				k.Logger(ctx).Info("Withdrawing rewards")
 
-				delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: da.Address}
-				bz := k.cdc.MustMarshal(&delegationQuery)
-
-				k.ICQKeeper.MakeRequest(
-					ctx,
-					zoneInfo.ConnectionId,
-					zoneInfo.ChainId,
-					"cosmos.staking.v1beta1.Query/DelegatorDelegations",
-					bz,
-					sdk.NewInt(-1),
-					types.ModuleName,
-					"delegations",
-					0,
-				)
+				if err := k.EmitDelegatorDelegationsQuery(ctx, &zoneInfo, da.Address); err != nil {
+					k.Logger(ctx).Error("unable to query delegations", "delegator", da.Address, "error", err.Error())
+					continue
+				}
 				da.IncrementBalanceWaitgroup()
 
 				rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: da.Address}
-				bz = k.cdc.MustMarshal(&rewardsQuery)
+				bz := k.cdc.MustMarshal(&rewardsQuery)
 
 				k.ICQKeeper.MakeRequest(
 					ctx,
@@ -78,6 +75,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 					types.ModuleName,
 					"rewards",
 					0,
+					0,
+					sdk.Coin{},
 				)
 
 				// increment the WithdrawalWaitgroup
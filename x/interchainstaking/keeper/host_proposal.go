@@ -0,0 +1,313 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	// setting HostProposalStatusOpen as 0 causes the value to be omitted when (un)marshalling.
+	HostProposalStatusOpen      int32 = iota + 1
+	HostProposalStatusCast      int32 = iota + 1
+	HostProposalStatusConfirmed int32 = iota + 1
+
+	// HostVoteWindow is the period before the end of a host proposal's voting period, during which
+	// the aggregated votes are cast via the delegate accounts.
+	HostVoteWindow = 24 * time.Hour
+)
+
+// GetHostProposal returns the host proposal for the given zone and id.
+func (k Keeper) GetHostProposal(ctx sdk.Context, zone types.Zone, proposalID uint64) (types.HostProposal, bool) {
+	proposal := types.HostProposal{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostProposalKey(zone.ChainId, proposalID))
+	if len(bz) == 0 {
+		return proposal, false
+	}
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+// SetHostProposal stores the host proposal.
+func (k Keeper) SetHostProposal(ctx sdk.Context, proposal types.HostProposal) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&proposal)
+	store.Set(types.GetHostProposalKey(proposal.ChainId, proposal.ProposalId), bz)
+}
+
+// DeleteHostProposal deletes the host proposal and all votes recorded against it.
+func (k Keeper) DeleteHostProposal(ctx sdk.Context, zone types.Zone, proposalID uint64) {
+	for _, vote := range k.AllHostVotes(ctx, zone, proposalID) {
+		k.DeleteHostVote(ctx, zone, proposalID, vote.Voter)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHostProposalKey(zone.ChainId, proposalID))
+}
+
+// IterateHostProposals iterates through host proposals for the given zone.
+func (k Keeper) IterateHostProposals(ctx sdk.Context, zone types.Zone, fn func(index int64, proposal types.HostProposal) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneHostProposalsKey(zone.ChainId))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		proposal := types.HostProposal{}
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+
+		stop := fn(i, proposal)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllHostProposals returns every host proposal in the store for the given zone.
+func (k Keeper) AllHostProposals(ctx sdk.Context, zone types.Zone) []types.HostProposal {
+	proposals := []types.HostProposal{}
+	k.IterateHostProposals(ctx, zone, func(_ int64, proposal types.HostProposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return proposals
+}
+
+// SetHostVote stores the vote, replacing any existing vote from the same voter.
+func (k Keeper) SetHostVote(ctx sdk.Context, vote types.HostVote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vote)
+	store.Set(types.GetHostVoteKey(vote.ChainId, vote.ProposalId, vote.Voter), bz)
+}
+
+// DeleteHostVote deletes the vote of the given voter.
+func (k Keeper) DeleteHostVote(ctx sdk.Context, zone types.Zone, proposalID uint64, voter string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHostVoteKey(zone.ChainId, proposalID, voter))
+}
+
+// IterateHostVotes iterates through votes for the given host proposal.
+func (k Keeper) IterateHostVotes(ctx sdk.Context, zone types.Zone, proposalID uint64, fn func(index int64, vote types.HostVote) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetHostVotesKey(zone.ChainId, proposalID))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		vote := types.HostVote{}
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+
+		stop := fn(i, vote)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllHostVotes returns every vote for the given host proposal.
+func (k Keeper) AllHostVotes(ctx sdk.Context, zone types.Zone, proposalID uint64) []types.HostVote {
+	votes := []types.HostVote{}
+	k.IterateHostVotes(ctx, zone, proposalID, func(_ int64, vote types.HostVote) (stop bool) {
+		votes = append(votes, vote)
+		return false
+	})
+	return votes
+}
+
+// TallyHostVotes returns the sum of votes per option for the given host proposal, weighted by
+// each voter's current qAsset balance.
+func (k Keeper) TallyHostVotes(ctx sdk.Context, zone types.Zone, proposalID uint64) (map[govtypes.VoteOption]sdk.Dec, error) {
+	var err error
+	tally := map[govtypes.VoteOption]sdk.Dec{}
+	k.IterateHostVotes(ctx, zone, proposalID, func(_ int64, vote types.HostVote) (stop bool) {
+		addr, localErr := sdk.AccAddressFromBech32(vote.Voter)
+		if localErr != nil {
+			err = localErr
+			return true
+		}
+		balance := k.BankKeeper.GetBalance(ctx, addr, zone.LocalDenom).Amount.ToDec()
+		if balance.IsZero() {
+			return false
+		}
+		for _, option := range vote.Options {
+			if _, ok := tally[option.Option]; !ok {
+				tally[option.Option] = sdk.ZeroDec()
+			}
+			tally[option.Option] = tally[option.Option].Add(balance.Mul(option.Weight))
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tally, nil
+}
+
+// TallyToWeightedVoteOptions normalises a tally into weighted vote options summing to exactly one.
+// Any rounding remainder is attributed to the option with the greatest weight.
+func TallyToWeightedVoteOptions(tally map[govtypes.VoteOption]sdk.Dec) govtypes.WeightedVoteOptions {
+	sum := sdk.ZeroDec()
+	keys := make([]govtypes.VoteOption, 0, len(tally))
+	for option, weight := range tally {
+		if weight.IsPositive() {
+			keys = append(keys, option)
+			sum = sum.Add(weight)
+		}
+	}
+	if sum.IsZero() {
+		return govtypes.WeightedVoteOptions{}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	out := govtypes.WeightedVoteOptions{}
+	largest := 0
+	allocated := sdk.ZeroDec()
+	for i, option := range keys {
+		weight := tally[option].QuoTruncate(sum)
+		allocated = allocated.Add(weight)
+		out = append(out, govtypes.WeightedVoteOption{Option: option, Weight: weight})
+		if weight.GT(out[largest].Weight) {
+			largest = i
+		}
+	}
+	out[largest].Weight = out[largest].Weight.Add(sdk.OneDec().Sub(allocated))
+	return out
+}
+
+// CastHostVotes casts the aggregated votes for any host proposals of the given zone whose voting
+// period ends within HostVoteWindow, and prunes proposals whose voting period has ended. Votes are
+// cast from every delegate account holding stake, such that each account's stake is split between
+// options in proportion to the qAsset weighted tally.
+func (k *Keeper) CastHostVotes(ctx sdk.Context, zone *types.Zone) error {
+	for _, proposal := range k.AllHostProposals(ctx, *zone) {
+		if !proposal.VotingEndTime.After(ctx.BlockTime()) {
+			k.DeleteHostProposal(ctx, *zone, proposal.ProposalId)
+			continue
+		}
+
+		if proposal.Status != HostProposalStatusOpen || proposal.VotingEndTime.Sub(ctx.BlockTime()) > HostVoteWindow {
+			continue
+		}
+
+		tally, err := k.TallyHostVotes(ctx, *zone, proposal.ProposalId)
+		if err != nil {
+			return err
+		}
+		options := TallyToWeightedVoteOptions(tally)
+
+		// mark the proposal as cast regardless; if nobody voted the delegate accounts abstain from voting entirely.
+		proposal.Status = HostProposalStatusCast
+		k.SetHostProposal(ctx, proposal)
+
+		if len(options) == 0 {
+			continue
+		}
+
+		stake := map[string]sdk.Int{}
+		k.IterateAllDelegations(ctx, zone, func(delegation types.Delegation) (stop bool) {
+			if _, ok := stake[delegation.DelegationAddress]; !ok {
+				stake[delegation.DelegationAddress] = sdk.ZeroInt()
+			}
+			stake[delegation.DelegationAddress] = stake[delegation.DelegationAddress].Add(delegation.Amount.Amount)
+			return false
+		})
+
+		for _, account := range zone.GetDelegationAccounts() {
+			if amount, ok := stake[account.Address]; !ok || !amount.IsPositive() {
+				continue
+			}
+			msg := &govtypes.MsgVoteWeighted{ProposalId: proposal.ProposalId, Voter: account.Address, Options: options}
+			if err := k.SubmitTx(ctx, []sdk.Msg{msg}, account, OperationMemo(ctx, fmt.Sprintf("vote/%d", proposal.ProposalId), account.Address)); err != nil {
+				return fmt.Errorf("unable to submit vote for proposal %d from %s: %w", proposal.ProposalId, account.Address, err)
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHostVoteCast,
+				sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				sdk.NewAttribute(types.AttributeKeyVoteOptions, options.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// HandleVoteWeighted marks the host proposal voted on by the acknowledged MsgVoteWeighted as confirmed.
+func (k *Keeper) HandleVoteWeighted(ctx sdk.Context, msg sdk.Msg) error {
+	voteMsg, ok := msg.(*govtypes.MsgVoteWeighted)
+	if !ok {
+		k.Logger(ctx).Error("unable to cast source message to MsgVoteWeighted")
+		return fmt.Errorf("unable to cast source message to MsgVoteWeighted")
+	}
+
+	zone := k.GetZoneForDelegateAccount(ctx, voteMsg.Voter)
+	if zone == nil {
+		return fmt.Errorf("unable to find zone for delegate account %s", voteMsg.Voter)
+	}
+
+	proposal, found := k.GetHostProposal(ctx, *zone, voteMsg.ProposalId)
+	if !found {
+		// the proposal may already have been pruned; nothing to do.
+		return nil
+	}
+
+	proposal.Status = HostProposalStatusConfirmed
+	k.SetHostProposal(ctx, proposal)
+	return nil
+}
+
+// EmitGovProposalsQuery emits a periodic query for host zone governance proposals in their voting period.
+func (k *Keeper) EmitGovProposalsQuery(ctx sdk.Context, zone types.Zone) {
+	proposalsQuery := govtypes.QueryProposalsRequest{ProposalStatus: govtypes.StatusVotingPeriod}
+	bz := k.cdc.MustMarshal(&proposalsQuery)
+
+	period := int64(k.GetZoneParam(ctx, &zone, types.KeyValidatorSetInterval))
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.gov.v1beta1.Query/Proposals",
+		bz,
+		sdk.NewInt(period),
+		types.ModuleName,
+		"govproposals",
+		0,
+		0,
+		sdk.Coin{},
+	)
+}
+
+// SetHostProposalsForZone records any proposals in their voting period that are not yet known.
+func (k *Keeper) SetHostProposalsForZone(ctx sdk.Context, zone types.Zone, response govtypes.QueryProposalsResponse) {
+	for _, proposal := range response.Proposals {
+		if proposal.Status != govtypes.StatusVotingPeriod {
+			continue
+		}
+		if _, found := k.GetHostProposal(ctx, zone, proposal.ProposalId); found {
+			continue
+		}
+		k.SetHostProposal(ctx, types.HostProposal{
+			ChainId:       zone.ChainId,
+			ProposalId:    proposal.ProposalId,
+			VotingEndTime: proposal.VotingEndTime,
+			Status:        HostProposalStatusOpen,
+		})
+	}
+}
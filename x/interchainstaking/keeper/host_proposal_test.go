@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestTallyToWeightedVoteOptions(t *testing.T) {
+	options := icskeeper.TallyToWeightedVoteOptions(map[govtypes.VoteOption]sdk.Dec{
+		govtypes.OptionYes:        sdk.NewDec(1),
+		govtypes.OptionNo:         sdk.NewDec(1),
+		govtypes.OptionAbstain:    sdk.NewDec(1),
+		govtypes.OptionNoWithVeto: sdk.ZeroDec(),
+	})
+	require.Len(t, options, 3)
+
+	sum := sdk.ZeroDec()
+	for _, option := range options {
+		require.True(t, govtypes.ValidWeightedVoteOption(option))
+		sum = sum.Add(option.Weight)
+	}
+	require.Equal(t, sdk.OneDec(), sum)
+
+	require.Empty(t, icskeeper.TallyToWeightedVoteOptions(map[govtypes.VoteOption]sdk.Dec{}))
+}
+
+func TestHostProposalVoting(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Now().UTC()})
+
+	zone := types.Zone{ChainId: "cosmoshub-4", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	kpr.SetZone(ctx, &zone)
+
+	kpr.SetHostProposalsForZone(ctx, zone, govtypes.QueryProposalsResponse{Proposals: govtypes.Proposals{
+		{ProposalId: 1, Status: govtypes.StatusVotingPeriod, VotingEndTime: ctx.BlockTime().Add(72 * time.Hour)},
+		{ProposalId: 2, Status: govtypes.StatusVotingPeriod, VotingEndTime: ctx.BlockTime().Add(time.Hour)},
+		{ProposalId: 3, Status: govtypes.StatusPassed, VotingEndTime: ctx.BlockTime().Add(-time.Hour)},
+	}})
+	require.Len(t, kpr.AllHostProposals(ctx, zone), 2)
+
+	// proposals of a zone whose chain id extends this one are kept apart.
+	other := types.Zone{ChainId: "cosmoshub-40", LocalDenom: "uqatom40", BaseDenom: "uatom"}
+	kpr.SetHostProposalsForZone(ctx, other, govtypes.QueryProposalsResponse{Proposals: govtypes.Proposals{
+		{ProposalId: 1, Status: govtypes.StatusVotingPeriod, VotingEndTime: ctx.BlockTime().Add(time.Hour)},
+	}})
+	require.Len(t, kpr.AllHostProposals(ctx, zone), 2)
+	require.Len(t, kpr.AllHostProposals(ctx, other), 1)
+	kpr.DeleteHostProposal(ctx, other, 1)
+
+	yes := utils.GenerateAccAddressForTest()
+	no := utils.GenerateAccAddressForTest()
+	fund := func(addr sdk.AccAddress, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(amount)))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+	}
+	fund(yes, 3000)
+	fund(no, 1000)
+
+	msgSrv := icskeeper.NewMsgServerImpl(kpr)
+	_, err := msgSrv.VoteOnHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteOnHostProposal(zone.ChainId, 1, govtypes.NewNonSplitVoteOption(govtypes.OptionYes), yes))
+	require.NoError(t, err)
+	_, err = msgSrv.VoteOnHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteOnHostProposal(zone.ChainId, 1, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionNo, Weight: sdk.MustNewDecFromStr("0.5")},
+		{Option: govtypes.OptionAbstain, Weight: sdk.MustNewDecFromStr("0.5")},
+	}, no))
+	require.NoError(t, err)
+	_, err = msgSrv.VoteOnHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteOnHostProposal(zone.ChainId, 3, govtypes.NewNonSplitVoteOption(govtypes.OptionYes), yes))
+	require.Error(t, err)
+
+	res, err := kpr.HostProposalTally(sdk.WrapSDKContext(ctx), &types.QueryHostProposalTallyRequest{ChainId: zone.ChainId, ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, govtypes.NewTallyResult(sdk.NewInt(3000), sdk.NewInt(500), sdk.NewInt(500), sdk.ZeroInt()), res.Tally)
+
+	// proposal 2 ends within the vote window and is cast; proposal 1 remains open.
+	require.NoError(t, kpr.CastHostVotes(ctx, &zone))
+	proposal, found := kpr.GetHostProposal(ctx, zone, 1)
+	require.True(t, found)
+	require.Equal(t, icskeeper.HostProposalStatusOpen, proposal.Status)
+	proposal, found = kpr.GetHostProposal(ctx, zone, 2)
+	require.True(t, found)
+	require.Equal(t, icskeeper.HostProposalStatusCast, proposal.Status)
+
+	// votes are no longer accepted for a cast proposal.
+	_, err = msgSrv.VoteOnHostProposal(sdk.WrapSDKContext(ctx), types.NewMsgVoteOnHostProposal(zone.ChainId, 2, govtypes.NewNonSplitVoteOption(govtypes.OptionYes), yes))
+	require.Error(t, err)
+
+	// proposals are pruned, along with their votes, once the voting period has ended.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(96 * time.Hour))
+	require.NoError(t, kpr.CastHostVotes(ctx, &zone))
+	require.Empty(t, kpr.AllHostProposals(ctx, zone))
+	require.Empty(t, kpr.AllHostVotes(ctx, zone, 1))
+}
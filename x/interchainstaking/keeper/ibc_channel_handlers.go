@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
@@ -50,23 +49,15 @@ func (k Keeper) HandleChannelOpenAck(ctx sdk.Context, portID string, connectionI
 			return err
 		}
 
-		balanceQuery := bankTypes.QueryAllBalancesRequest{Address: address}
-		bz, err := k.GetCodec().Marshal(&balanceQuery)
-		if err != nil {
-			return err
+		// the deposit account of a pending zone is checked before the zone is activated; the balance query
+		// is emitted on activation.
+		if !zone.IsActive() {
+			return k.checkAccountPrefix(ctx, &zone)
 		}
 
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			connectionID,
-			chainID,
-			"cosmos.bank.v1beta1.Query/AllBalances",
-			bz,
-			sdk.NewInt(int64(k.GetParam(ctx, types.KeyDepositInterval))),
-			types.ModuleName,
-			"allbalances",
-			0,
-		)
+		if err := k.EmitDepositBalanceQuery(ctx, &zone); err != nil {
+			return err
+		}
 
 	// withdrawal address
 	case len(portParts) == 2 && portParts[1] == types.ICASuffixWithdrawal:
@@ -22,28 +22,38 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/ingenuity-build/quicksilver/utils"
+	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	queryTypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
 func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var packetData icatypes.InterchainAccountPacketData
+	err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData)
+	if err != nil {
+		k.Logger(ctx).Error("unable to unmarshal acknowledgement packet data", "error", err, "data", packetData)
+		return err
+	}
+	if reflect.DeepEqual(packetData, icatypes.InterchainAccountPacketData{}) {
+		return fmt.Errorf("unable to unmarshal packet data; got empty JSON object")
+	}
+
+	// an error acknowledgement resolves the packet as failed; it is not itself an error, so the failure is kept.
+	ackErr := channeltypes.Acknowledgement_Error{}
+	if err := json.Unmarshal(acknowledgement, &ackErr); err == nil && ackErr.Error != "" {
+		k.Logger(ctx).Error("received error acknowledgement", "remote_err", ackErr.Error, "memo", packetData.Memo)
+		k.handleFailedPacket(ctx, packet, packetData)
+		return nil
+	}
+
 	ack := channeltypes.Acknowledgement_Result{}
-	err := json.Unmarshal(acknowledgement, &ack)
+	err = json.Unmarshal(acknowledgement, &ack)
 	if err != nil {
-		ackErr := channeltypes.Acknowledgement_Error{}
-		err := json.Unmarshal(acknowledgement, &ackErr)
-		if err != nil {
-			k.Logger(ctx).Error("unable to unmarshal acknowledgement error", "error", err, "data", acknowledgement)
-			return err
-		}
-		// assert acknowledgement not empty struct.
-		if reflect.DeepEqual(ack, channeltypes.Acknowledgement_Error{}) {
-			return fmt.Errorf("unable to unmarshal acknowledgement error; got empty JSON object")
-		}
-		k.Logger(ctx).Error("unable to unmarshal acknowledgement result", "error", err, "remote_err", ackErr, "data", acknowledgement)
+		k.Logger(ctx).Error("unable to unmarshal acknowledgement result", "error", err, "data", acknowledgement)
 		return err
 	}
 	// assert acknowledgement not empty struct.
@@ -62,16 +72,6 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 		return err
 	}
 
-	var packetData icatypes.InterchainAccountPacketData
-	err = icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData)
-	if err != nil {
-		k.Logger(ctx).Error("unable to unmarshal acknowledgement packet data", "error", err, "data", packetData)
-		return err
-	}
-	if reflect.DeepEqual(packetData, icatypes.InterchainAccountPacketData{}) {
-		return fmt.Errorf("unable to unmarshal packet data; got empty JSON object")
-	}
-
 	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, packetData.Data)
 	if err != nil {
 		k.Logger(ctx).Error("unable to decode messages", "err", err)
@@ -199,7 +199,19 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 				return err
 			}
 			k.Logger(ctx).Debug("MsgTranfer acknowledgement received")
-			if err := k.HandleMsgTransfer(ctx, src); err != nil {
+			if err := k.HandleMsgTransfer(ctx, src, packetData.Memo); err != nil {
+				return err
+			}
+			continue
+		case "/cosmos.gov.v1beta1.MsgVoteWeighted":
+			response := govtypes.MsgVoteWeightedResponse{}
+			err := proto.Unmarshal(msgData.Data, &response)
+			if err != nil {
+				k.Logger(ctx).Error("unable to unmarshal MsgVoteWeighted response", "error", err)
+				return err
+			}
+			k.Logger(ctx).Debug("Vote cast on host proposal", "response", response)
+			if err := k.HandleVoteWeighted(ctx, src); err != nil {
 				return err
 			}
 			continue
@@ -208,16 +220,121 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 		}
 	}
 
+	k.resolvePacketOperation(ctx, packet, packetData.Memo, false)
+
 	return nil
 }
 
 func (k *Keeper) HandleTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		k.Logger(ctx).Error("unable to unmarshal timeout packet data", "error", err)
+		return nil
+	}
+
+	k.handleFailedPacket(ctx, packet, packetData)
+
+	return nil
+}
+
+// handleFailedPacket handles an interchain account packet that failed on the host, by error acknowledgement or
+// timeout. Errors are logged rather than returned, as returning them would revert the resolution of the packet.
+func (k *Keeper) handleFailedPacket(ctx sdk.Context, packet channeltypes.Packet, packetData icatypes.InterchainAccountPacketData) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.handleFailedPayouts(cacheCtx, packetData); err != nil {
+		k.Logger(ctx).Error("unable to handle failed withdrawal payouts", "memo", packetData.Memo, "error", err)
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	k.resolvePacketOperation(ctx, packet, packetData.Memo, true)
+}
+
+// resolvePacketOperation marks a single packet of the pending operation identified by memo as resolved.
+func (k *Keeper) resolvePacketOperation(ctx sdk.Context, packet channeltypes.Packet, memo string, failed bool) {
+	if memo == "" {
+		return
+	}
+
+	connectionID, _, err := k.IBCKeeper.ChannelKeeper.GetChannelConnection(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		k.Logger(ctx).Error("unable to find connection for packet", "port", packet.SourcePort, "channel", packet.SourceChannel)
+		return
+	}
+
+	chainID, err := k.GetChainID(ctx, connectionID)
+	if err != nil {
+		k.Logger(ctx).Error("unable to find chain id for connection", "connection", connectionID)
+		return
+	}
+
+	if !k.ResolvePendingOperationChunk(ctx, chainID, memo, failed) {
+		return
+	}
+	k.Logger(ctx).Info("all packets for operation resolved", "chain_id", chainID, "memo", memo)
+
+	if strings.HasPrefix(memo, "withdrawrewards/") {
+		if err := k.completeWithdrawRewards(ctx, chainID); err != nil {
+			k.Logger(ctx).Error("unable to complete rewards withdrawal", "chain_id", chainID, "memo", memo, "error", err)
+		}
+	}
+}
+
+// handleFailedPayouts handles the withdrawal payouts among the messages of a packet that failed on the host. Instant
+// redemptions paid from the deposit account are refunded; payouts from a delegation account, whose funds remain in
+// the account, are marked to be resent.
+func (k *Keeper) handleFailedPayouts(ctx sdk.Context, packetData icatypes.InterchainAccountPacketData) error {
+	if packetData.Memo == "" {
+		return nil
+	}
+	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, packetData.Data)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		var sender, recipient string
+		var amount sdk.Coins
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			sender, recipient, amount = msg.FromAddress, msg.ToAddress, msg.Amount
+		case *ibctransfertypes.MsgTransfer:
+			sender, recipient, amount = msg.Sender, msg.Receiver, sdk.Coins{msg.Token}
+		default:
+			continue
+		}
+
+		zone := k.getZoneForRedemptionAccount(ctx, sender)
+		if zone == nil {
+			continue
+		}
+		failed := []types.WithdrawalRecord{}
+		k.IterateZoneDelegatorHashWithdrawalRecords(ctx, zone, packetData.Memo, sender, func(_ int64, withdrawal types.WithdrawalRecord) bool {
+			if withdrawal.Recipient == recipient && withdrawal.Status == WithdrawStatusSend && amount.IsEqual(sdk.Coins{withdrawal.Amount}) {
+				failed = append(failed, withdrawal)
+				return true
+			}
+			return false
+		})
+		for _, withdrawal := range failed {
+			if zone.DepositAddress != nil && sender == zone.DepositAddress.Address {
+				if err := k.refundInstantRedemption(ctx, zone, withdrawal); err != nil {
+					return err
+				}
+				continue
+			}
+			k.Logger(ctx).Info("withdrawal payout failed; resending once balance is proven", "delegator", withdrawal.Delegator, "recipient", withdrawal.Recipient, "txhash", withdrawal.Txhash)
+			withdrawal.Status = WithdrawStatusResend
+			k.SetWithdrawalRecord(ctx, &withdrawal)
+		}
+	}
 	return nil
 }
 
 //----------------------------------------------------------------
 
-func (k *Keeper) HandleMsgTransfer(ctx sdk.Context, msg sdk.Msg) error {
+func (k *Keeper) HandleMsgTransfer(ctx sdk.Context, msg sdk.Msg, memo string) error {
 	k.Logger(ctx).Info("Received MsgTransfer acknowledgement")
 	// first, type assertion. we should have ibctransfertypes.MsgTransfer
 	sMsg, ok := msg.(*ibctransfertypes.MsgTransfer)
@@ -226,6 +343,11 @@ func (k *Keeper) HandleMsgTransfer(ctx sdk.Context, msg sdk.Msg) error {
 		return fmt.Errorf("unable to cast source message to MsgTransfer")
 	}
 
+	// redemption returned from a delegation account, or from the liquidity buffer, to a local address.
+	if zone := k.getZoneForRedemptionAccount(ctx, sMsg.Sender); zone != nil {
+		return k.handleTransferForUser(ctx, zone, sMsg, memo)
+	}
+
 	// check if destination is interchainstaking module account (spoiler: it was)
 	if sMsg.Receiver != k.AccountKeeper.GetModuleAddress(types.ModuleName).String() {
 		k.Logger(ctx).Error("msgTransfer to unknown account!")
@@ -235,6 +357,91 @@ func (k *Keeper) HandleMsgTransfer(ctx sdk.Context, msg sdk.Msg) error {
 	return k.HandleDistributeFeesFromModuleAccount(ctx)
 }
 
+// handleTransferForUser checks that the MsgTransfer the host has executed matches an existing withdrawal record, and
+// marks the record as pending receipt of the transfer packet.
+func (k *Keeper) handleTransferForUser(ctx sdk.Context, zone *types.Zone, msg *ibctransfertypes.MsgTransfer, memo string) error {
+	matched := false
+	k.IterateZoneDelegatorHashWithdrawalRecords(ctx, zone, memo, msg.Sender, func(_ int64, withdrawal types.WithdrawalRecord) bool {
+		if withdrawal.Recipient == msg.Receiver && withdrawal.Status == WithdrawStatusSend && withdrawal.Amount.IsEqual(msg.Token) {
+			k.Logger(ctx).Info("Found matching withdrawal; awaiting receipt of transfer", "delegator", withdrawal.Delegator, "recipient", withdrawal.Recipient, "amount", withdrawal.Amount)
+			withdrawal.Status = WithdrawStatusTransfer
+			k.SetWithdrawalRecord(ctx, &withdrawal)
+			matched = true
+			return true
+		}
+		return false
+	})
+	if !matched {
+		return fmt.Errorf("unable to find matching withdrawal record for MsgTransfer")
+	}
+	return nil
+}
+
+// getZoneForRedemptionAccount returns the zone of the delegation or deposit account from which redemptions are paid.
+func (k *Keeper) getZoneForRedemptionAccount(ctx sdk.Context, address string) *types.Zone {
+	if zone := k.GetZoneForDelegateAccount(ctx, address); zone != nil {
+		return zone
+	}
+	return k.GetZoneForDepositAccount(ctx, address)
+}
+
+// HandleRedemptionTransferReceipt completes the withdrawal record returning a redemption to a local address once the
+// transfer packet sent by the delegation or deposit account has been received. Packets not sent by either are
+// ignored.
+func (k *Keeper) HandleRedemptionTransferReceipt(ctx sdk.Context, packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) error {
+	zone := k.getZoneForRedemptionAccount(ctx, data.Sender)
+	if zone == nil {
+		return nil
+	}
+
+	connectionID, _, err := k.IBCKeeper.ChannelKeeper.GetChannelConnection(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return err
+	}
+	if connectionID != zone.ConnectionId || data.Denom != zone.BaseDenom {
+		return fmt.Errorf("unexpected transfer of %s from delegation account %s over %s", data.Denom, data.Sender, connectionID)
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return fmt.Errorf("unable to parse transfer amount %s", data.Amount)
+	}
+
+	err = nil
+	matched := false
+	k.IterateZoneDelegatorWithdrawalRecords(ctx, zone, data.Sender, func(_ int64, withdrawal types.WithdrawalRecord) bool {
+		if withdrawal.Recipient == data.Receiver && withdrawal.Status == WithdrawStatusTransfer && withdrawal.Amount.Amount.Equal(amount) {
+			k.Logger(ctx).Info("Received transfer for withdrawal; marking as completed", "delegator", withdrawal.Delegator, "recipient", withdrawal.Recipient, "amount", withdrawal.Amount)
+			matched = true
+			err = k.completeWithdrawal(ctx, zone, withdrawal, sdk.Coins{withdrawal.Amount})
+			return true
+		}
+		return false
+	})
+	if err == nil && !matched {
+		err = fmt.Errorf("unable to find matching withdrawal record for transfer")
+	}
+	return err
+}
+
+// GetRemoteTransferChannel returns the host chain's port and channel identifiers of the transfer channel over the
+// given connection.
+func (k *Keeper) GetRemoteTransferChannel(ctx sdk.Context, connectionID string) (port string, channel string, err error) {
+	// iterate the channel store directly; the paginated ConnectionChannels query may omit the transfer channel when
+	// the connection carries many interchain account channels.
+	found := false
+	k.IBCKeeper.ChannelKeeper.IterateChannels(ctx, func(localChannel channeltypes.IdentifiedChannel) bool {
+		if localChannel.PortId == ibctransfertypes.PortID && len(localChannel.ConnectionHops) > 0 && localChannel.ConnectionHops[0] == connectionID {
+			port, channel, found = localChannel.Counterparty.PortId, localChannel.Counterparty.ChannelId, true
+			return true
+		}
+		return false
+	})
+	if !found {
+		return "", "", fmt.Errorf("unable to find remote transfer connection")
+	}
+	return port, channel, nil
+}
+
 func (k *Keeper) HandleDistributeFeesFromModuleAccount(ctx sdk.Context) error {
 	// what do we have in the account?
 	balance := k.BankKeeper.GetAllBalances(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName))
@@ -325,6 +532,9 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string) e
 
 	// checks here are specific to ensure future extensibility;
 	switch {
+	case sMsg.FromAddress == zone.WithdrawalAddress.GetAddress() && sMsg.ToAddress == zone.DepositAddress.GetAddress():
+		// rewards retained for the liquidity buffer.
+		return k.handleLiquidityBufferTopUp(ctx, zone, sMsg)
 	case sMsg.FromAddress == zone.WithdrawalAddress.GetAddress():
 		// WithdrawalAddress (for rewards) only send to DelegationAddresses.
 		// Target here is one of the DelegationAddresses.
@@ -333,6 +543,9 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string) e
 		return k.handleWithdrawForUser(ctx, zone, sMsg, memo)
 	case zone.IsDelegateAddress(sMsg.ToAddress) && zone.DepositAddress.Address == sMsg.FromAddress:
 		return k.handleSendToDelegate(ctx, zone, sMsg, memo)
+	case sMsg.FromAddress == zone.DepositAddress.GetAddress():
+		// instant redemption paid from the liquidity buffer.
+		return k.handleWithdrawForUser(ctx, zone, sMsg, memo)
 	default:
 		err = fmt.Errorf("unexpected completed send")
 		k.Logger(ctx).Error(err.Error())
@@ -386,7 +599,7 @@ func (k *Keeper) handleSendToDelegate(ctx sdk.Context, zone *types.Zone, msg *ba
 
 // withdraw for user will check that the msgSend we have successfully executed matches an existing withdrawal record.
 // on a match (recipient = msg.ToAddress + amount + status == SEND), we mark the record as complete.
-// if no other withdrawal records exist for this triple (i.e. no further withdrawal from this delegator account for this user (i.e. different validator))
+// if no other withdrawal records exist for this txhash (i.e. no further withdrawal for this redemption from any delegator account)
 // then burn the withdrawal_record's burn_amount.
 func (k *Keeper) handleWithdrawForUser(ctx sdk.Context, zone *types.Zone, msg *banktypes.MsgSend, memo string) error {
 	var err error
@@ -399,22 +612,15 @@ func (k *Keeper) handleWithdrawForUser(ctx sdk.Context, zone *types.Zone, msg *b
 				k.Logger(ctx).Info("withdrawal:  matched the amount", "amount", msg.Amount, "record.amount", withdrawal.Amount.Amount)
 				if withdrawal.Status == WithdrawStatusSend {
 					k.Logger(ctx).Info("Found matching withdrawal; marking as completed")
-					k.DeleteWithdrawalRecord(ctx, zone, memo, withdrawal.Delegator, withdrawal.Validator)
-					if len(k.AllZoneDelegatorHashWithdrawalRecords(ctx, zone, memo, withdrawal.Delegator)) == 0 {
-						err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{withdrawal.BurnAmount})
-						if err != nil {
-							// if we can't burn the coins, stop iterating so we can return err outside of the iterator.
-							return true
-						}
-						k.Logger(ctx).Info("burned coins post-withdrawal", "coins", withdrawal.BurnAmount)
-						// stop iterating here, we reached the end of the possible withdrawal. set end to true to escape below.
-						end = true
+					// a MsgSend completes a single withdrawal record; set end to true to escape below.
+					end = true
+					if err = k.completeWithdrawal(ctx, zone, withdrawal, msg.Amount); err != nil {
+						return true
 					}
 
 					err = k.EmitValsetRequery(ctx, zone.ConnectionId, zone.ChainId)
-					// if we can't emit the query, stop iterating so we can return err outside of the iterator.
-					// additionally, if we have reach the end of this withdrawal, stop iterating.
-					return (err != nil || end)
+					// if we can't emit the query, we return err outside of the iterator.
+					return true
 				}
 			}
 		}
@@ -428,33 +634,352 @@ func (k *Keeper) handleWithdrawForUser(ctx sdk.Context, zone *types.Zone, msg *b
 	return err
 }
 
+// completeWithdrawal deletes a withdrawal record whose funds have been sent to the recipient. The qAssets escrowed
+// for the redemption are burned once no records of the redemption remain, across all delegation accounts.
+func (k *Keeper) completeWithdrawal(ctx sdk.Context, zone *types.Zone, withdrawal types.WithdrawalRecord, sent sdk.Coins) error {
+	k.DeleteWithdrawalRecord(ctx, zone, withdrawal.Txhash, withdrawal.Delegator, withdrawal.Validator)
+	burned := sdk.Coins{}
+	if len(k.AllZoneHashWithdrawalRecords(ctx, zone, withdrawal.Txhash)) == 0 {
+		if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{withdrawal.BurnAmount}); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("burned coins post-withdrawal", "coins", withdrawal.BurnAmount)
+		burned = sdk.Coins{withdrawal.BurnAmount}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRedemptionSent{
+		ChainId:    zone.ChainId,
+		Txhash:     withdrawal.Txhash,
+		Delegator:  withdrawal.Delegator,
+		Recipient:  withdrawal.Recipient,
+		Amount:     sent,
+		BurnAmount: burned,
+	})
+}
+
+// HandleCompletedUnbondings marks withdrawal records whose unbonding has completed per local block time as pending
+// verification, and queries the host for the unbonding delegations and base denom balance of each delegation account
+// concerned. The balance of an account with records pending verification is queried at the latest host height known
+// to the light client, so that the proof reflects every unbonding completed by then. Funds are sent to recipients by
+// SettleUnbondedWithdrawals, once the balance has been proven; records still pending verification are requeried on
+// each call.
+//
+// Transfers to local addresses not received by their timeout are refunded to the sending account by the host. Those
+// sent from a delegation account are marked to be resent, once the balance of the account has been proven to cover
+// them; instant redemptions sent from the deposit account are refunded.
 func (k *Keeper) HandleCompletedUnbondings(ctx sdk.Context, zone *types.Zone) error {
 	var err error
+	delegators := []string{}
+	verifying := map[string]bool{}
+	pairs := map[string]bool{}
+	refunds := []types.WithdrawalRecord{}
+	addDelegator := func(delegator string) {
+		// records are keyed by delegator, so each delegator's records are contiguous.
+		if len(delegators) == 0 || delegators[len(delegators)-1] != delegator {
+			delegators = append(delegators, delegator)
+		}
+	}
 	k.IterateZoneWithdrawalRecords(ctx, zone, func(idx int64, withdrawal types.WithdrawalRecord) bool {
-		k.Logger(ctx).Info("iterating unbondings")
-		if withdrawal.Status == WithdrawStatusUnbond && withdrawal.CompletionTime.After(ctx.BlockTime()) { // completion date has passed.
-			k.Logger(ctx).Info("matched unbonding")
-
-			// bingo!
-			_, delegatorIca := k.GetICAForDelegateAccount(ctx, withdrawal.Delegator)
-			if delegatorIca == nil {
-				k.Logger(ctx).Error("unable to find delegator account for withdrawal; this shouldn't happen", err)
-				return true
+		switch withdrawal.Status {
+		case WithdrawStatusUnbond:
+			if withdrawal.CompletionTime.After(ctx.BlockTime()) {
+				return false
 			}
-			sendMsg := &banktypes.MsgSend{FromAddress: withdrawal.Delegator, ToAddress: withdrawal.Recipient, Amount: sdk.Coins{withdrawal.Amount}}
-
-			err = k.SubmitTx(ctx, []sdk.Msg{sendMsg}, delegatorIca, withdrawal.Txhash)
-			if err != nil {
-				k.Logger(ctx).Error("error", err)
-				return true
+			k.Logger(ctx).Info("matched unbonding", "delegator", withdrawal.Delegator, "validator", withdrawal.Validator, "txhash", withdrawal.Txhash)
+			withdrawal.Status = WithdrawStatusVerify
+			k.SetWithdrawalRecord(ctx, &withdrawal)
+		case WithdrawStatusVerify:
+		case WithdrawStatusTransfer:
+			if withdrawal.TransferTimeout.IsZero() || withdrawal.TransferTimeout.After(ctx.BlockTime()) {
+				return false
 			}
-			k.Logger(ctx).Info("sending funds", "from", withdrawal.Delegator, "to", withdrawal.Recipient, "amount", withdrawal.Amount)
-			withdrawal.Status = WithdrawStatusSend
+			if zone.DepositAddress != nil && withdrawal.Delegator == zone.DepositAddress.Address {
+				refunds = append(refunds, withdrawal)
+				return false
+			}
+			k.Logger(ctx).Info("transfer to local address timed out; resending once refunded", "delegator", withdrawal.Delegator, "recipient", withdrawal.Recipient, "txhash", withdrawal.Txhash)
+			withdrawal.Status = WithdrawStatusResend
 			k.SetWithdrawalRecord(ctx, &withdrawal)
+			fallthrough
+		case WithdrawStatusResend:
+			// only the balance of the account is required to resend.
+			addDelegator(withdrawal.Delegator)
+			return false
+		default:
+			return false
+		}
+
+		addDelegator(withdrawal.Delegator)
+		verifying[withdrawal.Delegator] = true
+		if !pairs[withdrawal.Delegator+withdrawal.Validator] {
+			pairs[withdrawal.Delegator+withdrawal.Validator] = true
+			err = k.EmitUnbondingDelegationQuery(ctx, zone, withdrawal.Delegator, withdrawal.Validator)
+		}
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, withdrawal := range refunds {
+		k.Logger(ctx).Info("instant redemption transfer timed out", "recipient", withdrawal.Recipient, "txhash", withdrawal.Txhash)
+		if err := k.refundInstantRedemption(ctx, zone, withdrawal); err != nil {
+			return err
+		}
+	}
+
+	// an unpinned balance may be proven at any past height; only a balance at a known height settles unbondings.
+	height, _, _ := k.latestRemoteHeight(ctx, zone)
+	for _, delegator := range delegators {
+		pin := int64(0)
+		if verifying[delegator] {
+			pin = height
+		}
+		if err := k.EmitDelegateAccountBalanceQuery(ctx, zone, delegator, pin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EmitDelegateAccountBalanceQuery queries the host for a proof of the base denom balance of the delegation account,
+// at the given host height or, if zero, at any height.
+func (k *Keeper) EmitDelegateAccountBalanceQuery(ctx sdk.Context, zone *types.Zone, delegator string, height int64) error {
+	delegatorIca, err := zone.GetDelegationAccountByAddress(delegator)
+	if err != nil {
+		return err
+	}
+	_, addr, err := bech32.DecodeAndConvert(delegator)
+	if err != nil {
+		return err
+	}
+
+	key := "store/bank/key"
+	request := append(banktypes.CreateAccountBalancesPrefix(addr), []byte(zone.BaseDenom)...)
+	// a re-request of an outstanding query does not result in an additional callback.
+	if _, found := k.ICQKeeper.GetQuery(ctx, interchainquerykeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, key, request, types.ModuleName)); !found {
+		delegatorIca.IncrementBalanceWaitgroup()
+		k.SetZone(ctx, zone)
+	}
+	k.ICQKeeper.MakeRequest(ctx, zone.ConnectionId, zone.ChainId, key, request, sdk.NewInt(-1), types.ModuleName, "accountbalance", 0, height, k.queryBounty(ctx))
+	return nil
+}
+
+// SettleUnbondedWithdrawals sends the funds of the delegation account's withdrawal records pending verification to
+// their recipients, given the base denom balance of the account proven at the given host height. Only records whose
+// unbonding completed by the block time of that height are settled; the balance of an unpinned query, at height zero,
+// settles none. Each payout is reduced by the slashed amount attributed to its validator from the proven unbonding
+// delegation entries. If the balance, less funds committed to unacknowledged sends, does not cover the payouts, the
+// balance is taken to be stale and the payouts it does not cover are deferred to a later proof.
+//
+// Payouts to be resent are resent from the balance remaining once those records are settled. As the refund of a
+// timed out transfer may not yet have been processed by the host, payouts it does not cover are deferred, and their
+// funds are not released.
+//
+// It returns the part of the balance not committed to withdrawals.
+func (k *Keeper) SettleUnbondedWithdrawals(ctx sdk.Context, zone *types.Zone, delegatorIca *types.ICAAccount, balance sdk.Coin, height int64) (sdk.Coin, error) {
+	blockTime, known := k.remoteBlockTime(ctx, zone, height)
+	completed := func(withdrawal types.WithdrawalRecord) bool {
+		if known {
+			return !withdrawal.CompletionTime.After(blockTime)
+		}
+		return !k.unbondingObserved(ctx, zone, withdrawal)
+	}
+
+	available := balance.Amount
+	owed := sdk.ZeroInt()
+	matured := []types.WithdrawalRecord{}
+	resend := []types.WithdrawalRecord{}
+	k.IterateZoneDelegatorWithdrawalRecords(ctx, zone, delegatorIca.Address, func(_ int64, withdrawal types.WithdrawalRecord) bool {
+		if withdrawal.Amount.Denom != balance.Denom {
+			return false
+		}
+		switch withdrawal.Status {
+		case WithdrawStatusUnbond:
+			// completed on the host ahead of local block time; keep the funds for the recipient.
+			if completed(withdrawal) {
+				available = available.Sub(withdrawal.Amount.Amount.Sub(slashedAmount(withdrawal).Amount))
+			}
+		case WithdrawStatusVerify:
+			if !known {
+				k.Logger(ctx).Info("balance not proven at a known height; deferring", "delegator", withdrawal.Delegator, "validator", withdrawal.Validator, "txhash", withdrawal.Txhash)
+				// the funds may already be in the balance; keep them for the recipient.
+				owed = owed.Add(withdrawal.Amount.Amount.Sub(slashedAmount(withdrawal).Amount))
+				return false
+			}
+			if !completed(withdrawal) {
+				k.Logger(ctx).Info("unbonding not completed on host at balance height", "delegator", withdrawal.Delegator, "validator", withdrawal.Validator, "txhash", withdrawal.Txhash, "height", height)
+				return false
+			}
+			matured = append(matured, withdrawal)
+		case WithdrawStatusSend:
+			available = available.Sub(withdrawal.Amount.Amount)
+		case WithdrawStatusResend:
+			resend = append(resend, withdrawal)
 		}
 		return false
 	})
-	return err
+	if available.IsNegative() {
+		available = sdk.ZeroInt()
+	}
+
+	for _, withdrawal := range matured {
+		slashed := slashedAmount(withdrawal)
+		payout := withdrawal.Amount.Amount.Sub(slashed.Amount)
+		if payout.GT(available) {
+			k.Logger(ctx).Info("balance does not cover unbonded payout; deferring", "delegator", withdrawal.Delegator, "validator", withdrawal.Validator, "txhash", withdrawal.Txhash, "amount", payout, "available", available)
+			owed = owed.Add(payout)
+			continue
+		}
+		if slashed.IsPositive() {
+			k.Logger(ctx).Info("unbonded balance reduced by slashing", "delegator", withdrawal.Delegator, "validator", withdrawal.Validator, "txhash", withdrawal.Txhash, "slashed", slashed)
+		}
+		withdrawal.Amount = sdk.NewCoin(withdrawal.Amount.Denom, payout)
+
+		if payout.IsZero() {
+			// nothing to send; the withdrawal is complete.
+			if err := k.completeWithdrawal(ctx, zone, withdrawal, sdk.Coins{}); err != nil {
+				return sdk.Coin{}, err
+			}
+		} else {
+			if err := k.sendWithdrawalPayout(ctx, zone, delegatorIca, withdrawal); err != nil {
+				return sdk.Coin{}, err
+			}
+			available = available.Sub(payout)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRedemptionUnbonded{
+			ChainId:       zone.ChainId,
+			Txhash:        withdrawal.Txhash,
+			Delegator:     withdrawal.Delegator,
+			Validator:     withdrawal.Validator,
+			Recipient:     withdrawal.Recipient,
+			Amount:        withdrawal.Amount,
+			SlashedAmount: slashed,
+		}); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	remaining := available
+	for _, withdrawal := range resend {
+		if withdrawal.Amount.Amount.GT(remaining) {
+			k.Logger(ctx).Info("balance does not cover payout to resend; deferring", "delegator", withdrawal.Delegator, "txhash", withdrawal.Txhash, "amount", withdrawal.Amount)
+			owed = owed.Add(withdrawal.Amount.Amount)
+			continue
+		}
+		if err := k.sendWithdrawalPayout(ctx, zone, delegatorIca, withdrawal); err != nil {
+			return sdk.Coin{}, err
+		}
+		remaining = remaining.Sub(withdrawal.Amount.Amount)
+	}
+	remaining = remaining.Sub(owed)
+	if remaining.IsNegative() {
+		remaining = sdk.ZeroInt()
+	}
+
+	return sdk.NewCoin(balance.Denom, remaining), nil
+}
+
+// withdrawalPayoutMsg returns the message sending the withdrawal's funds from the delegation account to its recipient:
+// a MsgSend to a host chain address, or a MsgTransfer returning the funds to a local address. The timeout of a
+// MsgTransfer is recorded against the withdrawal.
+func (k *Keeper) withdrawalPayoutMsg(ctx sdk.Context, zone *types.Zone, withdrawal *types.WithdrawalRecord) (sdk.Msg, error) {
+	if !IsLocalRecipient(zone, withdrawal.Recipient) {
+		return &banktypes.MsgSend{FromAddress: withdrawal.Delegator, ToAddress: withdrawal.Recipient, Amount: sdk.Coins{withdrawal.Amount}}, nil
+	}
+
+	remotePort, remoteChannel, err := k.GetRemoteTransferChannel(ctx, zone.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	// the timeout is checked against local block time on receipt, so a transfer not received by then is known to
+	// have been refunded to the delegation account; see HandleCompletedUnbondings.
+	withdrawal.TransferTimeout = ctx.BlockTime().Add(PayoutTransferTimeout)
+	return &ibctransfertypes.MsgTransfer{
+		SourcePort:       remotePort,
+		SourceChannel:    remoteChannel,
+		Token:            withdrawal.Amount,
+		Sender:           withdrawal.Delegator,
+		Receiver:         withdrawal.Recipient,
+		TimeoutTimestamp: uint64(withdrawal.TransferTimeout.UnixNano()),
+		TimeoutHeight:    clienttypes.Height{RevisionNumber: 0, RevisionHeight: 0},
+	}, nil
+}
+
+// sendWithdrawalPayout submits the payout of the withdrawal from the delegation account, and marks it as sent.
+func (k *Keeper) sendWithdrawalPayout(ctx sdk.Context, zone *types.Zone, delegatorIca *types.ICAAccount, withdrawal types.WithdrawalRecord) error {
+	sendMsg, err := k.withdrawalPayoutMsg(ctx, zone, &withdrawal)
+	if err != nil {
+		return err
+	}
+	if err := k.SubmitTx(ctx, []sdk.Msg{sendMsg}, delegatorIca, withdrawal.Txhash); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("sending funds", "from", withdrawal.Delegator, "to", withdrawal.Recipient, "amount", withdrawal.Amount)
+	withdrawal.Status = WithdrawStatusSend
+	k.SetWithdrawalRecord(ctx, &withdrawal)
+	return nil
+}
+
+// unbondingObserved returns true if the withdrawal's unbonding entry was still open on the host as of the last
+// unbonding delegation query for its delegator/validator pair.
+func (k *Keeper) unbondingObserved(ctx sdk.Context, zone *types.Zone, withdrawal types.WithdrawalRecord) bool {
+	record, found := k.GetUnbondingRecord(ctx, zone, withdrawal.Delegator, withdrawal.Validator)
+	if !found {
+		return false
+	}
+	for _, completion := range record.CompletionTimes {
+		if completion.Equal(withdrawal.CompletionTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// slashedAmount returns the amount of the withdrawal attributed to slashing during unbonding; records written before
+// it was tracked have none.
+func slashedAmount(withdrawal types.WithdrawalRecord) sdk.Coin {
+	if withdrawal.SlashedAmount.Amount.IsNil() {
+		return sdk.NewCoin(withdrawal.Amount.Denom, sdk.ZeroInt())
+	}
+	return withdrawal.SlashedAmount
+}
+
+// remoteBlockTime returns the block time of the given host height, per the consensus state held by the zone's light
+// client; false if the client holds none for the height.
+func (k *Keeper) remoteBlockTime(ctx sdk.Context, zone *types.Zone, height int64) (time.Time, bool) {
+	if height <= 0 {
+		return time.Time{}, false
+	}
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
+	if !found {
+		return time.Time{}, false
+	}
+	consState, found := k.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, clienttypes.NewHeight(clienttypes.ParseChainID(zone.ChainId), uint64(height)))
+	if !found {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(consState.GetTimestamp())).UTC(), true
+}
+
+// latestRemoteHeight returns the latest host height held by the zone's light client, and its block time; false if
+// the client holds no consensus state.
+func (k *Keeper) latestRemoteHeight(ctx sdk.Context, zone *types.Zone) (int64, time.Time, bool) {
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
+	if !found {
+		return 0, time.Time{}, false
+	}
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return 0, time.Time{}, false
+	}
+	height := int64(clientState.GetLatestHeight().GetRevisionHeight())
+	blockTime, found := k.remoteBlockTime(ctx, zone, height)
+	if !found {
+		return 0, time.Time{}, false
+	}
+	return height, blockTime, true
 }
 
 func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, amount sdk.Coin, memo string) error {
@@ -522,24 +1047,26 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 		return fmt.Errorf("unable to lookup withdrawal record")
 	}
 	record.CompletionTime = completion
+	record.Status = WithdrawStatusUnbond
 	k.Logger(ctx).Error("record to save", "rcd", record)
 	k.SetWithdrawalRecord(ctx, &record)
 
-	delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: undelegateMsg.DelegatorAddress}
-	bz := k.cdc.MustMarshal(&delegationQuery)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedemptionUnbonding{
+		ChainId:        zone.ChainId,
+		Txhash:         hash,
+		Delegator:      record.Delegator,
+		Validator:      record.Validator,
+		Amount:         record.Amount,
+		CompletionTime: completion,
+	}); err != nil {
+		return err
+	}
 
-	k.ICQKeeper.MakeRequest(
-		ctx,
-		zone.ConnectionId,
-		zone.ChainId,
-		"cosmos.staking.v1beta1.Query/DelegatorDelegations",
-		bz,
-		sdk.NewInt(-1),
-		types.ModuleName,
-		"delegations",
-		0,
-	)
-	return nil
+	if err := k.EmitUnbondingDelegationQuery(ctx, zone, undelegateMsg.DelegatorAddress, undelegateMsg.ValidatorAddress); err != nil {
+		return err
+	}
+
+	return k.EmitDelegatorDelegationsQuery(ctx, zone, undelegateMsg.DelegatorAddress)
 }
 
 func (k *Keeper) HandleRedeemTokens(ctx sdk.Context, msg sdk.Msg, amount sdk.Coin) error {
@@ -577,7 +1104,16 @@ func (k *Keeper) HandleDelegate(ctx sdk.Context, msg sdk.Msg) error {
 
 	}
 
-	return k.UpdateDelegationRecordForAddress(ctx, delegateMsg.DelegatorAddress, delegateMsg.ValidatorAddress, delegateMsg.Amount, zone, false)
+	if err := k.UpdateDelegationRecordForAddress(ctx, delegateMsg.DelegatorAddress, delegateMsg.ValidatorAddress, delegateMsg.Amount, zone, false); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDelegationAcknowledged{
+		ChainId:   zone.ChainId,
+		Delegator: delegateMsg.DelegatorAddress,
+		Validator: delegateMsg.ValidatorAddress,
+		Amount:    delegateMsg.Amount,
+	})
 }
 
 func (k *Keeper) HandleUpdatedWithdrawAddress(ctx sdk.Context, msg sdk.Msg) error {
@@ -671,6 +1207,8 @@ func (k *Keeper) UpdateDelegationRecordsForAddress(ctx sdk.Context, zone *types.
 				types.ModuleName,
 				"delegation",
 				0,
+				0,
+				k.queryBounty(ctx),
 			)
 			da.IncrementBalanceWaitgroup()
 		}
@@ -709,6 +1247,8 @@ func (k *Keeper) UpdateDelegationRecordsForAddress(ctx sdk.Context, zone *types.
 			types.ModuleName,
 			"delegation",
 			0,
+			0,
+			k.queryBounty(ctx),
 		)
 	}
 
@@ -745,49 +1285,49 @@ func (k *Keeper) HandleWithdrawRewards(ctx sdk.Context, msg sdk.Msg) error {
 		k.Logger(ctx).Error("unable to cast source message to MsgWithdrawDelegatorReward")
 		return fmt.Errorf("unable to cast source message to MsgWithdrawDelegatorReward")
 	}
+	k.Logger(ctx).Info("Received MsgWithdrawDelegatorReward acknowledgement", "delegator", withdrawalMsg.DelegatorAddress, "validator", withdrawalMsg.ValidatorAddress)
+	return nil
+}
 
-	zone, err := k.GetZoneFromContext(ctx)
-	if err != nil {
-		err = fmt.Errorf("4: %w", err)
-		k.Logger(ctx).Error(err.Error())
-		return err
+// completeWithdrawRewards decrements the withdrawal waitgroup of the zone once every packet of a delegation account's
+// rewards withdrawal has been resolved, whether acknowledged or failed. Once no withdrawals remain outstanding, the
+// balance of the withdrawal account is queried for the rewards to distribute.
+func (k *Keeper) completeWithdrawRewards(ctx sdk.Context, chainID string) error {
+	zone, found := k.GetZone(ctx, chainID)
+	if !found {
+		return fmt.Errorf("unable to find zone for %s", chainID)
 	}
-	// decrement withdrawal waitgroup
-	// We are specifically looking for protocol delegator:validator pairs
-	// and must not decrement the waitgroup for the performance address as it
-	// is not part of the waitgroup set. It is a special delegator address that
-	// operates outside the delegator set, its purpose is to track validator
-	// performance only.
-	if withdrawalMsg.DelegatorAddress != zone.PerformanceAddress.Address {
+	if zone.WithdrawalWaitgroup > 0 {
 		zone.WithdrawalWaitgroup--
-		k.SetZone(ctx, zone)
+		k.SetZone(ctx, &zone)
 	}
-	k.Logger(ctx).Info("Received MsgWithdrawDelegatorReward acknowledgement", "wg", zone.WithdrawalWaitgroup, "delegator", withdrawalMsg.DelegatorAddress)
-	switch zone.WithdrawalWaitgroup {
-	case 0:
-		// interface assertion
-		balanceQuery := banktypes.QueryAllBalancesRequest{Address: zone.WithdrawalAddress.Address}
-		bz, err := k.cdc.Marshal(&balanceQuery)
-		if err != nil {
-			return err
-		}
-
-		// total rewards balance withdrawn
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			"cosmos.bank.v1beta1.Query/AllBalances",
-			bz,
-			sdk.NewInt(int64(-1)),
-			types.ModuleName,
-			"distributerewards",
-			0,
-		)
-		return nil
-	default:
+	k.Logger(ctx).Info("rewards withdrawal completed", "wg", zone.WithdrawalWaitgroup, "chain_id", chainID)
+	if zone.WithdrawalWaitgroup > 0 || zone.WithdrawalAddress == nil {
 		return nil
 	}
+
+	// interface assertion
+	balanceQuery := banktypes.QueryAllBalancesRequest{Address: zone.WithdrawalAddress.Address}
+	bz, err := k.cdc.Marshal(&balanceQuery)
+	if err != nil {
+		return err
+	}
+
+	// total rewards balance withdrawn
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.bank.v1beta1.Query/AllBalances",
+		bz,
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		"distributerewards",
+		0,
+		0,
+		sdk.Coin{},
+	)
+	return nil
 }
 
 func DistributeRewardsFromWithdrawAccount(k Keeper, ctx sdk.Context, args []byte, query queryTypes.Query) error {
@@ -813,7 +1353,17 @@ func DistributeRewardsFromWithdrawAccount(k Keeper, ctx sdk.Context, args []byte
 	// prepare rewards distribution
 	rewards := sdk.NewCoin(zone.BaseDenom, baseDenomAmount.Sub(baseDenomFee))
 
-	dust, msgs := k.prepareRewardsDistributionMsgs(zone, rewards.Amount)
+	// top up the liquidity buffer before redelegating the remainder; the buffer is credited on acknowledgement.
+	bufferTopUp := zone.LiquidityBufferTopUp(k.GetDelegatedAmount(ctx, &zone).Amount, rewards.Amount)
+
+	dust, msgs := k.prepareRewardsDistributionMsgs(zone, rewards.Amount.Sub(bufferTopUp))
+	if bufferTopUp.IsPositive() {
+		msgs = append(msgs, &banktypes.MsgSend{
+			FromAddress: zone.WithdrawalAddress.GetAddress(),
+			ToAddress:   zone.DepositAddress.GetAddress(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, bufferTopUp)),
+		})
+	}
 
 	// subtract dust from rewards
 	rewards = rewards.SubAmount(dust)
@@ -821,23 +1371,10 @@ func DistributeRewardsFromWithdrawAccount(k Keeper, ctx sdk.Context, args []byte
 	// multiDenomFee is the balance of withdrawal account minus the redelegated rewards.
 	multiDenomFee := withdrawBalance.Balances.Sub(sdk.Coins{rewards})
 
-	channelReq := channeltypes.QueryConnectionChannelsRequest{Connection: zone.ConnectionId}
-	localChannelResp, err := k.IBCKeeper.ChannelKeeper.ConnectionChannels(sdk.WrapSDKContext(ctx), &channelReq)
+	remotePort, remoteChannel, err := k.GetRemoteTransferChannel(ctx, zone.ConnectionId)
 	if err != nil {
 		return err
 	}
-	var remotePort string
-	var remoteChannel string
-	for _, localChannel := range localChannelResp.Channels {
-		if localChannel.PortId == "transfer" {
-			remoteChannel = localChannel.Counterparty.ChannelId
-			remotePort = localChannel.Counterparty.PortId
-			break
-		}
-	}
-	if remotePort == "" {
-		return fmt.Errorf("unable to find remote transfer connection")
-	}
 
 	for _, coin := range multiDenomFee {
 		msgs = append(
@@ -854,11 +1391,19 @@ func DistributeRewardsFromWithdrawAccount(k Keeper, ctx sdk.Context, args []byte
 		)
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardsReinvested{
+		ChainId: zone.ChainId,
+		Rewards: rewards,
+		Fees:    multiDenomFee,
+	}); err != nil {
+		return err
+	}
+
 	// update redemption rate
 	k.updateRedemptionRate(ctx, zone, rewards.Amount)
 
 	// send tx
-	return k.SubmitTx(ctx, msgs, zone.WithdrawalAddress, "")
+	return k.SubmitTx(ctx, msgs, zone.WithdrawalAddress, OperationMemo(ctx, "distributerewards", zone.WithdrawalAddress.Address))
 }
 
 func (k *Keeper) updateRedemptionRate(ctx sdk.Context, zone types.Zone, epochRewards sdk.Int) {
@@ -866,16 +1411,26 @@ func (k *Keeper) updateRedemptionRate(ctx sdk.Context, zone types.Zone, epochRew
 	k.Logger(ctx).Info("Epochly rewards", "coins", epochRewards)
 	k.Logger(ctx).Info("Last redemption rate", "rate", zone.LastRedemptionRate)
 	k.Logger(ctx).Info("Current redemption rate", "rate", zone.RedemptionRate)
-	k.Logger(ctx).Info("New redemption rate", "rate", ratio, "supply", k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount.ToDec(), "lv", k.GetDelegatedAmount(ctx, &zone).Amount.Add(epochRewards).ToDec())
+	k.Logger(ctx).Info("New redemption rate", "rate", ratio, "supply", k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount.ToDec(), "lv", k.GetDelegatedAmount(ctx, &zone).Amount.Add(epochRewards).ToDec(), "buffer", zone.GetLiquidityBuffer())
 
 	zone.LastRedemptionRate = zone.RedemptionRate
 	zone.RedemptionRate = ratio
 	k.SetZone(ctx, &zone)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRateUpdated{
+		ChainId:            zone.ChainId,
+		LastRedemptionRate: zone.LastRedemptionRate,
+		RedemptionRate:     zone.RedemptionRate,
+		EpochRewards:       epochRewards,
+	}); err != nil {
+		k.Logger(ctx).Error("unable to emit redemption rate event", "err", err)
+	}
 }
 
 func (k *Keeper) getRatio(ctx sdk.Context, zone types.Zone, epochRewards sdk.Int) sdk.Dec {
-	// native asset amount
-	naAmount := k.GetDelegatedAmount(ctx, &zone).Amount
+	// native asset amount; the liquidity buffer, and instant redemptions paid from it whose qAssets are yet to be
+	// burned, are held undelegated.
+	naAmount := k.GetDelegatedAmount(ctx, &zone).Amount.Add(zone.GetLiquidityBuffer()).Add(k.PendingInstantRedemptions(ctx, &zone))
 	// qAsset amount
 	qaAmount := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
 
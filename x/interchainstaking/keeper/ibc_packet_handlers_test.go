@@ -0,0 +1,360 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	qapp "github.com/ingenuity-build/quicksilver/app"
+	"github.com/ingenuity-build/quicksilver/utils"
+	icqkeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// newInitialisedQuicksilver returns a Quicksilver app initialised with the default genesis state.
+func newInitialisedQuicksilver(t *testing.T) *qapp.Quicksilver {
+	quicksilver := newQuicksilver(t)
+	stateBytes, err := json.Marshal(qapp.NewDefaultGenesisState())
+	require.NoError(t, err)
+	quicksilver.InitChain(abci.RequestInitChain{ChainId: "mercury-1", ConsensusParams: qapp.DefaultConsensusParams, AppStateBytes: stateBytes})
+	return quicksilver
+}
+
+func TestCompletedUnbondingsVerifiedBeforePayout(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: now}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(zone.DelegationAddresses), 2)
+	delegator0, delegator1 := zone.DelegationAddresses[0].Address, zone.DelegationAddresses[1].Address
+	validator0, err := bech32.ConvertAndEncode("cosmosvaloper", utils.GenerateValAddressForTest())
+	require.NoError(t, err)
+	validator1, err := bech32.ConvertAndEncode("cosmosvaloper", utils.GenerateValAddressForTest())
+	require.NoError(t, err)
+	recipient, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+	require.NoError(t, err)
+
+	// a redemption undelegated from two delegation accounts, and another completing after the host's latest known
+	// block time.
+	burn := sdk.NewCoin("uqatom", sdk.NewInt(1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(burn.AddAmount(sdk.NewInt(100)))))
+	completion := now.Add(-time.Hour)
+	for _, record := range []types.WithdrawalRecord{
+		{Delegator: delegator0, Validator: validator0, Amount: sdk.NewCoin("uatom", sdk.NewInt(600)), BurnAmount: burn, Txhash: "redemption", CompletionTime: completion},
+		{Delegator: delegator1, Validator: validator0, Amount: sdk.NewCoin("uatom", sdk.NewInt(400)), BurnAmount: burn, Txhash: "redemption", CompletionTime: completion},
+		{Delegator: delegator0, Validator: validator1, Amount: sdk.NewCoin("uatom", sdk.NewInt(100)), BurnAmount: sdk.NewCoin("uqatom", sdk.NewInt(100)), Txhash: "lagging", CompletionTime: now.Add(time.Hour)},
+	} {
+		record := record
+		record.ChainId, record.Recipient, record.Status, record.SlashedAmount = zone.ChainId, recipient, icskeeper.WithdrawStatusUnbond, sdk.NewCoin("uatom", sdk.ZeroInt())
+		kpr.SetWithdrawalRecord(ctx, &record)
+	}
+
+	// the unbonding entry of delegator0 was slashed on the host.
+	_, delAddr, err := bech32.DecodeAndConvert(delegator0)
+	require.NoError(t, err)
+	_, valAddr, err := bech32.DecodeAndConvert(validator0)
+	require.NoError(t, err)
+	ubd := stakingtypes.UnbondingDelegation{DelegatorAddress: delegator0, ValidatorAddress: validator0, Entries: []stakingtypes.UnbondingDelegationEntry{
+		{CompletionTime: completion, InitialBalance: sdk.NewInt(600), Balance: sdk.NewInt(450)},
+	}}
+	require.NoError(t, icskeeper.UnbondingDelegationCallback(kpr, ctx, kpr.GetCodec().MustMarshal(&ubd), icqtypes.Query{ChainId: zone.ChainId, Request: stakingtypes.GetUBDKey(delAddr, valAddr)}))
+	record, found := kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator0, validator0)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin("uatom", sdk.NewInt(150)), record.SlashedAmount)
+
+	// 1. matured unbondings await verification, and the delegation account balances are queried at the latest host
+	// height known to the light client.
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	require.NoError(t, kpr.HandleCompletedUnbondings(ctx, &zone))
+	for _, record := range kpr.AllZoneWithdrawalRecords(ctx, &zone) {
+		require.Equal(t, icskeeper.WithdrawStatusVerify, record.Status)
+	}
+	require.Empty(t, typedEvents(t, ctx, &types.EventRedemptionUnbonded{}))
+
+	balanceQuery := func(delegator string) icqtypes.Query {
+		_, addr, err := bech32.DecodeAndConvert(delegator)
+		require.NoError(t, err)
+		request := append(banktypes.CreateAccountBalancesPrefix(addr), []byte("uatom")...)
+		query, found := app.InterchainQueryKeeper.GetQuery(ctx, icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "store/bank/key", request, types.ModuleName))
+		require.True(t, found)
+		require.Equal(t, "accountbalance", query.CallbackId)
+		return query
+	}
+	require.Equal(t, int64(1), balanceQuery(delegator0).Height)
+	respond := func(delegator string, amount int64) {
+		coin := sdk.NewCoin("uatom", sdk.NewInt(amount))
+		require.NoError(t, icskeeper.AccountBalanceCallback(kpr, ctx, kpr.GetCodec().MustMarshal(&coin), balanceQuery(delegator)))
+	}
+
+	// 2. a slashed unbonding is paid out less the slashed amount attributed from its unbonding entry; an unbonding
+	// completing after the proven height is deferred.
+	respond(delegator0, 450)
+	record, found = kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator0, validator0)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusSend, record.Status)
+	require.Equal(t, sdk.NewCoin("uatom", sdk.NewInt(450)), record.Amount)
+	require.Equal(t, sdk.NewCoin("uatom", sdk.NewInt(150)), record.SlashedAmount)
+	record, found = kpr.GetWithdrawalRecord(ctx, &zone, "lagging", delegator0, validator1)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusVerify, record.Status)
+
+	// 3. a balance that does not cover an unslashed payout defers it, rather than reducing it.
+	account, err := zone.GetDelegationAccountByAddress(delegator1)
+	require.NoError(t, err)
+	remaining, err := kpr.SettleUnbondedWithdrawals(ctx, &zone, account, sdk.NewCoin("uatom", sdk.NewInt(300)), 1)
+	require.NoError(t, err)
+	require.True(t, remaining.IsZero())
+	record, found = kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator1, validator0)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusVerify, record.Status)
+	require.Equal(t, sdk.NewCoin("uatom", sdk.NewInt(400)), record.Amount)
+	require.True(t, record.SlashedAmount.IsZero())
+
+	// 4. nor does a balance proven at an unknown height settle it.
+	remaining, err = kpr.SettleUnbondedWithdrawals(ctx, &zone, account, sdk.NewCoin("uatom", sdk.NewInt(500)), 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uatom", sdk.NewInt(100)), remaining)
+	record, found = kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator1, validator0)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusVerify, record.Status)
+
+	// 5. an unbonding covered by the proven balance is paid out in full.
+	respond(delegator1, 400)
+	record, found = kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator1, validator0)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusSend, record.Status)
+	require.Equal(t, sdk.NewCoin("uatom", sdk.NewInt(400)), record.Amount)
+
+	events := typedEvents(t, ctx, &types.EventRedemptionUnbonded{})
+	require.Equal(t, []proto.Message{
+		&types.EventRedemptionUnbonded{ChainId: zone.ChainId, Txhash: "redemption", Delegator: delegator0, Validator: validator0, Recipient: recipient, Amount: sdk.NewCoin("uatom", sdk.NewInt(450)), SlashedAmount: sdk.NewCoin("uatom", sdk.NewInt(150))},
+		&types.EventRedemptionUnbonded{ChainId: zone.ChainId, Txhash: "redemption", Delegator: delegator1, Validator: validator0, Recipient: recipient, Amount: sdk.NewCoin("uatom", sdk.NewInt(400)), SlashedAmount: sdk.NewCoin("uatom", sdk.ZeroInt())},
+	}, events)
+
+	zone, found = kpr.GetZone(ctx, zone.ChainId)
+	require.True(t, found)
+	for _, account := range zone.DelegationAddresses {
+		require.Zero(t, account.BalanceWaitgroup)
+	}
+
+	// 6. the redemption's qAssets are burned once, when the last of its sends is acknowledged.
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, kpr.AllZoneHashWithdrawalRecords(ctx, &zone, "redemption"))
+	require.Len(t, kpr.AllZoneHashWithdrawalRecords(ctx, &zone, "lagging"), 1)
+
+	burned := sdk.NewCoins()
+	for _, event := range typedEvents(t, ctx, &types.EventRedemptionSent{}) {
+		burned = burned.Add(event.(*types.EventRedemptionSent).BurnAmount...)
+	}
+	require.Equal(t, sdk.NewCoins(burn), burned)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName), "uqatom").Amount)
+
+	// 7. once the light client is updated past its completion, the lagging unbonding is settled at the new height.
+	require.NoError(t, host.UpdateClient(ctx))
+	require.NoError(t, kpr.HandleCompletedUnbondings(ctx, &zone))
+	require.Equal(t, int64(2), balanceQuery(delegator0).Height)
+	respond(delegator0, 100)
+	record, found = kpr.GetWithdrawalRecord(ctx, &zone, "lagging", delegator0, validator1)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusSend, record.Status)
+}
+
+func TestRedemptionReturnedOverIBC(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: now}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-7", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctransfertypes.PortID, "channel-9"), []string{zone.ConnectionId}, ibctransfertypes.Version,
+	))
+
+	delegator := zone.DelegationAddresses[0].Address
+	validator, err := bech32.ConvertAndEncode("cosmosvaloper", utils.GenerateValAddressForTest())
+	require.NoError(t, err)
+	recipient := utils.GenerateAccAddressForTest()
+	require.True(t, icskeeper.IsLocalRecipient(&zone, recipient.String()))
+
+	burn := sdk.NewCoin("uqatom", sdk.NewInt(500))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(burn)))
+	amount := sdk.NewCoin("uatom", sdk.NewInt(500))
+	kpr.SetWithdrawalRecord(ctx, &types.WithdrawalRecord{
+		ChainId: zone.ChainId, Delegator: delegator, Validator: validator, Recipient: recipient.String(), Amount: amount, BurnAmount: burn,
+		Txhash: "redemption", Status: icskeeper.WithdrawStatusVerify, CompletionTime: now.Add(-time.Hour),
+	})
+
+	// 1. once the unbonded balance is proven, the funds are transferred from the delegation account over the zone's
+	// transfer channel.
+	_, addr, err := bech32.DecodeAndConvert(delegator)
+	require.NoError(t, err)
+	query := icqtypes.Query{ChainId: zone.ChainId, Request: append(banktypes.CreateAccountBalancesPrefix(addr), []byte("uatom")...), Height: 1}
+	require.NoError(t, icskeeper.AccountBalanceCallback(kpr, ctx, kpr.GetCodec().MustMarshal(&amount), query))
+	record, found := kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator, validator)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusSend, record.Status)
+
+	// 2. once the host acknowledges the transfer, the record awaits receipt of the transfer packet.
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	record, found = kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator, validator)
+	require.True(t, found)
+	require.Equal(t, icskeeper.WithdrawStatusTransfer, record.Status)
+
+	// 3. receipt of the transfer packet credits the recipient, completes the record and burns the qAssets.
+	data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "500", delegator, recipient.String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, "channel-9", ibctransfertypes.PortID, "channel-7", clienttypes.ZeroHeight(), uint64(now.Add(time.Hour).UnixNano()))
+	middleware := interchainstaking.NewTransferMiddleware(transfer.NewIBCModule(app.TransferKeeper), kpr)
+	ack := middleware.OnRecvPacket(ctx, packet, utils.GenerateAccAddressForTest())
+	require.True(t, ack.Success())
+
+	voucher := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, "channel-7", "uatom")).IBCDenom()
+	require.Equal(t, sdk.NewInt(500), app.BankKeeper.GetBalance(ctx, recipient, voucher).Amount)
+	_, found = kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator, validator)
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName), "uqatom").IsZero())
+}
+
+func TestTimedOutRedemptionTransferResent(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: now}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-7", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctransfertypes.PortID, "channel-9"), []string{zone.ConnectionId}, ibctransfertypes.Version,
+	))
+
+	delegator := zone.DelegationAddresses[0].Address
+	validator, err := bech32.ConvertAndEncode("cosmosvaloper", utils.GenerateValAddressForTest())
+	require.NoError(t, err)
+	recipient := utils.GenerateAccAddressForTest()
+	amount := sdk.NewCoin("uatom", sdk.NewInt(500))
+	kpr.SetWithdrawalRecord(ctx, &types.WithdrawalRecord{
+		ChainId: zone.ChainId, Delegator: delegator, Validator: validator, Recipient: recipient.String(), Amount: amount, BurnAmount: sdk.NewCoin("uqatom", sdk.NewInt(500)),
+		Txhash: "redemption", Status: icskeeper.WithdrawStatusVerify, CompletionTime: now.Add(-time.Hour),
+	})
+	record := func() types.WithdrawalRecord {
+		record, found := kpr.GetWithdrawalRecord(ctx, &zone, "redemption", delegator, validator)
+		require.True(t, found)
+		return record
+	}
+	_, addr, err := bech32.DecodeAndConvert(delegator)
+	require.NoError(t, err)
+	query := icqtypes.Query{ChainId: zone.ChainId, Request: append(banktypes.CreateAccountBalancesPrefix(addr), []byte("uatom")...), Height: 1}
+	proveBalance := func(balance int64) {
+		require.NoError(t, icskeeper.AccountBalanceCallback(kpr, ctx, kpr.GetCodec().MustMarshal(&sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(balance)}), query))
+	}
+	transferType := sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{})
+
+	// 1. a transfer rejected by the host leaves the funds in the delegation account, and is resent from them.
+	host.RejectMessages(transferType)
+	proveBalance(500)
+	require.Equal(t, icskeeper.WithdrawStatusSend, record().Status)
+	require.Equal(t, now.Add(icskeeper.PayoutTransferTimeout), record().TransferTimeout)
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, icskeeper.WithdrawStatusResend, record().Status)
+
+	host.AcceptMessages(transferType)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	proveBalance(500)
+	require.Equal(t, icskeeper.WithdrawStatusSend, record().Status)
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, icskeeper.WithdrawStatusTransfer, record().Status)
+
+	// 2. until the transfer times out, it may still be received.
+	require.NoError(t, kpr.HandleCompletedUnbondings(ctx, &zone))
+	require.Equal(t, icskeeper.WithdrawStatusTransfer, record().Status)
+
+	// 3. once it has, it is refunded to the delegation account by the host, and is resent once the refund is proven;
+	// the funds owed are not released in the meantime.
+	ctx = ctx.WithBlockTime(now.Add(icskeeper.PayoutTransferTimeout)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, kpr.HandleCompletedUnbondings(ctx, &zone))
+	require.Equal(t, icskeeper.WithdrawStatusResend, record().Status)
+	account, err := zone.GetDelegationAccountByAddress(delegator)
+	require.NoError(t, err)
+	remaining, err := kpr.SettleUnbondedWithdrawals(ctx, &zone, account, sdk.NewCoin("uatom", sdk.NewInt(300)), 1)
+	require.NoError(t, err)
+	require.True(t, remaining.IsZero())
+	require.Equal(t, icskeeper.WithdrawStatusResend, record().Status)
+
+	proveBalance(500)
+	require.Equal(t, icskeeper.WithdrawStatusSend, record().Status)
+	require.Equal(t, now.Add(2*icskeeper.PayoutTransferTimeout), record().TransferTimeout)
+}
+
+func TestTimedOutInstantRedemptionTransferRefunded(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: now}).WithEventManager(sdk.NewEventManager())
+	msgSrv := icskeeper.NewMsgServerImpl(kpr)
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-7", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctransfertypes.PortID, "channel-9"), []string{zone.ConnectionId}, ibctransfertypes.Version,
+	))
+	zone.LiquidityBufferRatio = sdk.NewDecWithPrec(1, 1)
+	zone.LiquidityBuffer = sdk.NewInt(1000)
+	kpr.SetZone(ctx, &zone)
+
+	redeemer := utils.GenerateAccAddressForTest()
+	qAssets := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(500)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, qAssets))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, qAssets))
+
+	_, err = msgSrv.InstantRedeem(sdk.WrapSDKContext(ctx), types.NewMsgInstantRedeem(qAssets[0], redeemer.String(), redeemer))
+	require.NoError(t, err)
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	records := kpr.AllZoneDelegatorWithdrawalRecords(ctx, &zone, zone.DepositAddress.Address)
+	require.Len(t, records, 1)
+	require.Equal(t, icskeeper.WithdrawStatusTransfer, records[0].Status)
+
+	// the payout is returned to the buffer, and the escrowed qAssets to the redeemer, once the transfer times out.
+	ctx = ctx.WithBlockTime(now.Add(icskeeper.PayoutTransferTimeout)).WithEventManager(sdk.NewEventManager())
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.NoError(t, kpr.HandleCompletedUnbondings(ctx, &zone))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, sdk.NewInt(1000), zone.GetLiquidityBuffer())
+	require.Equal(t, qAssets, app.BankKeeper.GetAllBalances(ctx, redeemer))
+	require.Empty(t, kpr.AllZoneDelegatorWithdrawalRecords(ctx, &zone, zone.DepositAddress.Address))
+	require.Len(t, typedEvents(t, ctx, &types.EventInstantRedemptionRefunded{}), 1)
+}
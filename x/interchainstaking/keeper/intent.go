@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -106,21 +107,18 @@ func (k Keeper) AllOrdinalizedIntents(ctx sdk.Context, zone types.Zone, snapshot
 	return intents, nil
 }
 
+// AggregateIntents reduces the intents of all delegators for the given zone into a single
+// normalised intent. Delegators following a curator contribute their balance to the curator's
+// intent in place of their own, capped per curator at MaxCuratorWeight of the total weight. A
+// follower whose curator cannot be resolved or has no intent is counted by their own intent.
 func (k *Keeper) AggregateIntents(ctx sdk.Context, zone types.Zone) error {
 	var err error
 	snapshot := false
 	intents := map[string]*types.ValidatorIntent{}
 	ordinalizedIntentSum := sdk.ZeroDec()
-	// reduce intents
-	k.IterateIntents(ctx, zone, snapshot, func(_ int64, intent types.DelegatorIntent) (stop bool) {
-		addr, localErr := sdk.AccAddressFromBech32(intent.Delegator)
-		if localErr != nil {
-			err = localErr
-			return true
-		}
-		balance := k.BankKeeper.GetBalance(ctx, addr, zone.LocalDenom)
 
-		for _, vIntent := range intent.Ordinalize(balance.Amount.ToDec()).Intents {
+	addIntent := func(intent types.DelegatorIntent, weight sdk.Dec) {
+		for _, vIntent := range intent.Ordinalize(weight).Intents {
 			thisIntent, ok := intents[vIntent.ValoperAddress]
 			ordinalizedIntentSum = ordinalizedIntentSum.Add(vIntent.Weight)
 			if !ok {
@@ -130,13 +128,82 @@ func (k *Keeper) AggregateIntents(ctx sdk.Context, zone types.Zone) error {
 				intents[vIntent.ValoperAddress] = thisIntent
 			}
 		}
+	}
 
+	// sum follower balances per resolved curator. Followers whose chain cannot be resolved, or
+	// whose curator has not signalled an intent, fall back to their own intent below.
+	following := map[string]bool{}
+	curatorIntents := map[string]types.DelegatorIntent{}
+	followerWeights := map[string]sdk.Dec{}
+	followerWeightSum := sdk.ZeroDec()
+	k.IterateIntentDelegations(ctx, zone, func(_ int64, intentDelegation types.IntentDelegation) (stop bool) {
+		curator, localErr := k.ResolveCurator(ctx, zone, intentDelegation.Follower)
+		if localErr != nil {
+			k.Logger(ctx).Error("unable to resolve curator; using follower's own intent", "follower", intentDelegation.Follower, "err", localErr)
+			return false
+		}
+		curatorIntent, ok := curatorIntents[curator]
+		if !ok {
+			curatorIntent, _ = k.GetIntent(ctx, zone, curator, snapshot)
+			curatorIntents[curator] = curatorIntent
+		}
+		if len(curatorIntent.Intents) == 0 {
+			return false
+		}
+		addr, localErr := sdk.AccAddressFromBech32(intentDelegation.Follower)
+		if localErr != nil {
+			err = localErr
+			return true
+		}
+		balance := k.BankKeeper.GetBalance(ctx, addr, zone.LocalDenom).Amount.ToDec()
+		if _, ok := followerWeights[curator]; !ok {
+			followerWeights[curator] = sdk.ZeroDec()
+		}
+		followerWeights[curator] = followerWeights[curator].Add(balance)
+		followerWeightSum = followerWeightSum.Add(balance)
+		following[intentDelegation.Follower] = true
 		return false
 	})
 	if err != nil {
 		return err
 	}
 
+	// reduce intents
+	k.IterateIntents(ctx, zone, snapshot, func(_ int64, intent types.DelegatorIntent) (stop bool) {
+		// followers' own intents are disregarded in favour of their curator's.
+		if following[intent.Delegator] {
+			return false
+		}
+		addr, localErr := sdk.AccAddressFromBech32(intent.Delegator)
+		if localErr != nil {
+			err = localErr
+			return true
+		}
+		balance := k.BankKeeper.GetBalance(ctx, addr, zone.LocalDenom)
+		addIntent(intent, balance.Amount.ToDec())
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(followerWeights) > 0 {
+		curatorCap := ordinalizedIntentSum.Add(followerWeightSum).Mul(k.GetMaxCuratorWeight(ctx))
+		curators := make([]string, 0, len(followerWeights))
+		for curator := range followerWeights {
+			curators = append(curators, curator)
+		}
+		sort.Strings(curators)
+
+		for _, curator := range curators {
+			weight := followerWeights[curator]
+			if weight.GT(curatorCap) {
+				weight = curatorCap
+			}
+			addIntent(curatorIntents[curator].Normalize(), weight)
+		}
+	}
+
 	if len(intents) > 0 && ordinalizedIntentSum.IsZero() {
 		return fmt.Errorf("ordinalized intent sum is zero, this should never happen")
 	}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// MaxIntentDelegationDepth is the maximum number of curators that may be followed
+// through when resolving the intent of a delegator.
+const MaxIntentDelegationDepth = 5
+
+// GetIntentDelegation returns the curator followed by the given delegator, if any.
+func (k Keeper) GetIntentDelegation(ctx sdk.Context, zone types.Zone, follower string) (types.IntentDelegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIntentDelegationKey(zone.ChainId, follower))
+	if len(bz) == 0 {
+		return types.IntentDelegation{}, false
+	}
+	intentDelegation := types.IntentDelegation{}
+	k.cdc.MustUnmarshal(bz, &intentDelegation)
+	return intentDelegation, true
+}
+
+// SetIntentDelegation stores the intent delegation.
+func (k Keeper) SetIntentDelegation(ctx sdk.Context, zone types.Zone, intentDelegation types.IntentDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&intentDelegation)
+	store.Set(types.GetIntentDelegationKey(zone.ChainId, intentDelegation.Follower), bz)
+}
+
+// DeleteIntentDelegation deletes the intent delegation of the given delegator.
+func (k Keeper) DeleteIntentDelegation(ctx sdk.Context, zone types.Zone, follower string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIntentDelegationKey(zone.ChainId, follower))
+}
+
+// IterateIntentDelegations iterates through intent delegations for a given zone.
+func (k Keeper) IterateIntentDelegations(ctx sdk.Context, zone types.Zone, fn func(index int64, intentDelegation types.IntentDelegation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneIntentDelegationsKey(zone.ChainId))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		intentDelegation := types.IntentDelegation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &intentDelegation)
+
+		stop := fn(i, intentDelegation)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllIntentDelegations returns every intent delegation in the store for the specified zone.
+func (k Keeper) AllIntentDelegations(ctx sdk.Context, zone types.Zone) []types.IntentDelegation {
+	intentDelegations := []types.IntentDelegation{}
+	k.IterateIntentDelegations(ctx, zone, func(_ int64, intentDelegation types.IntentDelegation) (stop bool) {
+		intentDelegations = append(intentDelegations, intentDelegation)
+		return false
+	})
+	return intentDelegations
+}
+
+// GetFollowers returns the delegators directly following the given curator, sorted by address.
+func (k Keeper) GetFollowers(ctx sdk.Context, zone types.Zone, curator string) []string {
+	followers := []string{}
+	k.IterateIntentDelegations(ctx, zone, func(_ int64, intentDelegation types.IntentDelegation) (stop bool) {
+		if intentDelegation.Curator == curator {
+			followers = append(followers, intentDelegation.Follower)
+		}
+		return false
+	})
+	sort.Strings(followers)
+	return followers
+}
+
+// GetCurators returns a map of curator address to the number of delegators directly following them.
+func (k Keeper) GetCurators(ctx sdk.Context, zone types.Zone) map[string]uint64 {
+	curators := map[string]uint64{}
+	k.IterateIntentDelegations(ctx, zone, func(_ int64, intentDelegation types.IntentDelegation) (stop bool) {
+		curators[intentDelegation.Curator]++
+		return false
+	})
+	return curators
+}
+
+// ResolveCurator follows the chain of intent delegations starting at the given delegator and
+// returns the address whose own intent is used. A delegator without an intent delegation resolves
+// to themselves. An error is returned if a cycle is found or the chain is too deep.
+func (k Keeper) ResolveCurator(ctx sdk.Context, zone types.Zone, delegator string) (string, error) {
+	visited := map[string]bool{delegator: true}
+	current := delegator
+	for depth := 0; ; depth++ {
+		intentDelegation, found := k.GetIntentDelegation(ctx, zone, current)
+		if !found {
+			return current, nil
+		}
+		if depth >= MaxIntentDelegationDepth {
+			return "", fmt.Errorf("intent delegation chain for %s exceeds maximum depth of %d", delegator, MaxIntentDelegationDepth)
+		}
+		if visited[intentDelegation.Curator] {
+			return "", fmt.Errorf("intent delegation cycle detected for %s at %s", delegator, intentDelegation.Curator)
+		}
+		visited[intentDelegation.Curator] = true
+		current = intentDelegation.Curator
+	}
+}
+
+// ValidateIntentDelegationChains checks that the chain of the given delegator, and that of every
+// delegator transitively following them, resolves without a cycle within MaxIntentDelegationDepth.
+func (k Keeper) ValidateIntentDelegationChains(ctx sdk.Context, zone types.Zone, delegator string) error {
+	followers := map[string][]string{}
+	k.IterateIntentDelegations(ctx, zone, func(_ int64, intentDelegation types.IntentDelegation) (stop bool) {
+		followers[intentDelegation.Curator] = append(followers[intentDelegation.Curator], intentDelegation.Follower)
+		return false
+	})
+
+	seen := map[string]bool{delegator: true}
+	queue := []string{delegator}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if _, err := k.ResolveCurator(ctx, zone, current); err != nil {
+			return err
+		}
+		for _, follower := range followers[current] {
+			if !seen[follower] {
+				seen[follower] = true
+				queue = append(queue, follower)
+			}
+		}
+	}
+	return nil
+}
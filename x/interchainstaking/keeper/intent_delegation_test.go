@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestResolveCurator(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	zone := types.Zone{ChainId: "cosmoshub-4", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	kpr.SetZone(ctx, &zone)
+
+	a := utils.GenerateAccAddressForTest().String()
+	b := utils.GenerateAccAddressForTest().String()
+	c := utils.GenerateAccAddressForTest().String()
+	d := utils.GenerateAccAddressForTest().String()
+
+	// a delegator without a curator resolves to themselves.
+	resolved, err := kpr.ResolveCurator(ctx, zone, a)
+	require.NoError(t, err)
+	require.Equal(t, a, resolved)
+
+	// a -> b -> c resolves to c.
+	kpr.SetIntentDelegation(ctx, zone, types.IntentDelegation{Follower: a, Curator: b})
+	kpr.SetIntentDelegation(ctx, zone, types.IntentDelegation{Follower: b, Curator: c})
+	resolved, err = kpr.ResolveCurator(ctx, zone, a)
+	require.NoError(t, err)
+	require.Equal(t, c, resolved)
+
+	require.Equal(t, []string{a}, kpr.GetFollowers(ctx, zone, b))
+	require.Equal(t, map[string]uint64{b: 1, c: 1}, kpr.GetCurators(ctx, zone))
+
+	// c -> a closes the cycle.
+	kpr.SetIntentDelegation(ctx, zone, types.IntentDelegation{Follower: c, Curator: a})
+	_, err = kpr.ResolveCurator(ctx, zone, a)
+	require.ErrorContains(t, err, "cycle")
+
+	kpr.DeleteIntentDelegation(ctx, zone, c)
+	_, found := kpr.GetIntentDelegation(ctx, zone, c)
+	require.False(t, found)
+
+	// d follows a and is resolved through the chain.
+	kpr.SetIntentDelegation(ctx, zone, types.IntentDelegation{Follower: d, Curator: a})
+	resolved, err = kpr.ResolveCurator(ctx, zone, d)
+	require.NoError(t, err)
+	require.Equal(t, c, resolved)
+	require.Len(t, kpr.AllIntentDelegations(ctx, zone), 3)
+}
+
+func TestAggregateIntentsWithCurators(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	zone := types.Zone{ChainId: "cosmoshub-4", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	kpr.SetZone(ctx, &zone)
+
+	curator := utils.GenerateAccAddressForTest()
+	delegator := utils.GenerateAccAddressForTest()
+	follower := utils.GenerateAccAddressForTest()
+
+	fund := func(addr sdk.AccAddress, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(amount)))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+	}
+	fund(curator, 1000)
+	fund(delegator, 1000)
+	fund(follower, 3000)
+
+	kpr.SetIntent(ctx, zone, types.DelegatorIntent{Delegator: curator.String(), Intents: []*types.ValidatorIntent{
+		{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", Weight: sdk.OneDec()},
+	}}, false)
+	kpr.SetIntent(ctx, zone, types.DelegatorIntent{Delegator: delegator.String(), Intents: []*types.ValidatorIntent{
+		{ValoperAddress: "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", Weight: sdk.OneDec()},
+	}}, false)
+	// the follower's own intent must be ignored in favour of the curator's.
+	kpr.SetIntent(ctx, zone, types.DelegatorIntent{Delegator: follower.String(), Intents: []*types.ValidatorIntent{
+		{ValoperAddress: "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", Weight: sdk.OneDec()},
+	}}, false)
+
+	msgSrv := icskeeper.NewMsgServerImpl(kpr)
+	_, err := msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, curator.String(), follower))
+	require.NoError(t, err)
+
+	// curator following the follower would introduce a cycle.
+	_, err = msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, follower.String(), curator))
+	require.Error(t, err)
+
+	// with no effective cap, the follower's full balance is attributed to the curator: 4000:1000.
+	params := types.DefaultParams()
+	params.MaxCuratorWeight = sdk.OneDec()
+	kpr.SetParams(ctx, params)
+
+	require.NoError(t, kpr.AggregateIntents(ctx, zone))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), zone.AggregateIntent["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), zone.AggregateIntent["cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"].Weight)
+
+	// cap follower weight at 10% of the total (500): 1500:1000.
+	params.MaxCuratorWeight = sdk.MustNewDecFromStr("0.1")
+	kpr.SetParams(ctx, params)
+
+	require.NoError(t, kpr.AggregateIntents(ctx, zone))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), zone.AggregateIntent["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), zone.AggregateIntent["cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"].Weight)
+
+	// removing the delegation restores the follower's own intent: 1000:4000.
+	_, err = msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, "", follower))
+	require.NoError(t, err)
+
+	require.NoError(t, kpr.AggregateIntents(ctx, zone))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), zone.AggregateIntent["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), zone.AggregateIntent["cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"].Weight)
+
+	// following a curator without an intent leaves the follower's own intent in place: 1000:4000.
+	_, err = msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, utils.GenerateAccAddressForTest().String(), follower))
+	require.NoError(t, err)
+
+	require.NoError(t, kpr.AggregateIntents(ctx, zone))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), zone.AggregateIntent["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), zone.AggregateIntent["cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"].Weight)
+
+	// so does a chain to the curator that exceeds the maximum depth: 1000:4000.
+	current := follower.String()
+	for i := 0; i < icskeeper.MaxIntentDelegationDepth; i++ {
+		next := utils.GenerateAccAddressForTest().String()
+		kpr.SetIntentDelegation(ctx, zone, types.IntentDelegation{Follower: current, Curator: next})
+		current = next
+	}
+	kpr.SetIntentDelegation(ctx, zone, types.IntentDelegation{Follower: current, Curator: curator.String()})
+
+	require.NoError(t, kpr.AggregateIntents(ctx, zone))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), zone.AggregateIntent["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), zone.AggregateIntent["cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"].Weight)
+}
+
+func TestDelegateIntentFollowerDepth(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	zone := types.Zone{ChainId: "cosmoshub-4", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	kpr.SetZone(ctx, &zone)
+	msgSrv := icskeeper.NewMsgServerImpl(kpr)
+
+	// build a chain of the maximum depth: chain[n] -> chain[n-1] -> ... -> chain[0].
+	chain := []sdk.AccAddress{utils.GenerateAccAddressForTest()}
+	for i := 0; i < icskeeper.MaxIntentDelegationDepth; i++ {
+		follower := utils.GenerateAccAddressForTest()
+		_, err := msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, chain[i].String(), follower))
+		require.NoError(t, err)
+		chain = append(chain, follower)
+	}
+
+	// the root following anyone would push the deepest follower past the maximum depth.
+	_, err := msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, utils.GenerateAccAddressForTest().String(), chain[0]))
+	require.ErrorContains(t, err, "exceeds maximum depth")
+	_, found := kpr.GetIntentDelegation(ctx, zone, chain[0].String())
+	require.False(t, found)
+
+	// once the deepest follower leaves, the root may follow someone.
+	_, err = msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, "", chain[icskeeper.MaxIntentDelegationDepth]))
+	require.NoError(t, err)
+	_, err = msgSrv.DelegateIntent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateIntent(zone.ChainId, utils.GenerateAccAddressForTest().String(), chain[0]))
+	require.NoError(t, err)
+}
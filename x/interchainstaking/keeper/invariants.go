@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	redemptionRateInvariantName   = "redemption-rate-backing"
+	waitgroupInvariantName        = "non-negative-waitgroups"
+	withdrawalEscrowInvariantName = "withdrawal-record-escrow"
+	delegationOwnerInvariantName  = "delegation-owner"
+)
+
+// RedemptionRateTolerance is the fraction by which the value of circulating qAssets at the current
+// redemption rate may exceed the native assets backing them. Account balances and delegations are
+// only refreshed periodically by interchain query, so a small divergence is expected.
+var RedemptionRateTolerance = sdk.NewDecWithPrec(5, 2)
+
+// RegisterInvariants registers all interchainstaking invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, redemptionRateInvariantName, RedemptionRateInvariant(k))
+	ir.RegisterRoute(types.ModuleName, waitgroupInvariantName, WaitgroupInvariant(k))
+	ir.RegisterRoute(types.ModuleName, withdrawalEscrowInvariantName, WithdrawalEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, delegationOwnerInvariantName, DelegationOwnerInvariant(k))
+}
+
+// AllInvariants runs all invariants of the module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			RedemptionRateInvariant(k),
+			WaitgroupInvariant(k),
+			WithdrawalEscrowInvariant(k),
+			DelegationOwnerInvariant(k),
+		} {
+			if msg, broke := invariant(ctx); broke {
+				return msg, broke
+			}
+		}
+		return "", false
+	}
+}
+
+// RedemptionRateInvariant checks that, for each zone, circulating qAssets valued at the redemption rate do not
+// exceed the delegated and undelegated native assets held by the zone's accounts by more than RedemptionRateTolerance.
+// qAssets escrowed in the module account for pending withdrawals are excluded, as are the assets being unbonded for them.
+func RedemptionRateInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+		moduleAddress := k.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+		for _, zone := range k.AllZones(ctx) {
+			supply := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+			escrowed := k.BankKeeper.GetBalance(ctx, moduleAddress, zone.LocalDenom).Amount
+			circulating := supply.Sub(escrowed)
+			if !circulating.IsPositive() {
+				continue
+			}
+
+			delegated := k.GetDelegatedAmount(ctx, &zone).Amount
+			undelegated := sdk.ZeroInt()
+			for _, account := range zone.GetDelegationAccounts() {
+				undelegated = undelegated.Add(account.Balance.AmountOf(zone.BaseDenom))
+			}
+			if zone.DepositAddress != nil {
+				undelegated = undelegated.Add(zone.DepositAddress.Balance.AmountOf(zone.BaseDenom))
+			}
+			backing := delegated.Add(undelegated).ToDec()
+
+			value := circulating.ToDec().Mul(zone.RedemptionRate)
+			if value.GT(backing.Mul(sdk.OneDec().Add(RedemptionRateTolerance))) {
+				broken++
+				msg += fmt.Sprintf("\tzone %s: circulating %s%s at redemption rate %s is valued at %s%s, exceeding backing of %s%s (delegated %s, undelegated %s) by more than %s\n",
+					zone.ChainId, circulating, zone.LocalDenom, zone.RedemptionRate, value, zone.BaseDenom, backing, zone.BaseDenom, delegated, undelegated, RedemptionRateTolerance)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			redemptionRateInvariantName,
+			fmt.Sprintf("%d zones with qAssets insufficiently backed\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// WaitgroupInvariant checks that no zone or account waitgroup has been decremented below zero. Waitgroups are
+// unsigned, so an underflow is detected as a value that would be negative when interpreted as signed.
+func WaitgroupInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		check := func(chainID string, name string, wg uint32) {
+			if wg > math.MaxInt32 {
+				broken++
+				msg += fmt.Sprintf("\tzone %s: %s is negative (%d)\n", chainID, name, int32(wg))
+			}
+		}
+
+		for _, zone := range k.AllZones(ctx) {
+			check(zone.ChainId, "withdrawal waitgroup", zone.WithdrawalWaitgroup)
+			if zone.DepositAddress != nil {
+				check(zone.ChainId, "deposit account balance waitgroup", zone.DepositAddress.BalanceWaitgroup)
+			}
+			if zone.WithdrawalAddress != nil {
+				check(zone.ChainId, "withdrawal account balance waitgroup", zone.WithdrawalAddress.BalanceWaitgroup)
+			}
+			if zone.PerformanceAddress != nil {
+				check(zone.ChainId, "performance account balance waitgroup", zone.PerformanceAddress.BalanceWaitgroup)
+			}
+			for _, account := range zone.GetDelegationAccounts() {
+				check(zone.ChainId, fmt.Sprintf("delegation account %s balance waitgroup", account.Address), account.BalanceWaitgroup)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			waitgroupInvariantName,
+			fmt.Sprintf("%d negative waitgroups found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// WithdrawalEscrowInvariant checks that the qAssets held by the module account equal the burn amounts of
+// outstanding withdrawal records. All records created by a single redemption share its burn amount, which
+// is counted once.
+func WithdrawalEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		type redemption struct{ chainID, txhash string }
+		burnAmounts := map[redemption]sdk.Coin{}
+		expected := sdk.NewCoins()
+
+		k.IterateWithdrawalRecords(ctx, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+			key := redemption{record.ChainId, record.Txhash}
+			if burnAmount, found := burnAmounts[key]; found {
+				if !burnAmount.IsEqual(record.BurnAmount) {
+					broken++
+					msg += fmt.Sprintf("\twithdrawal record %s/%s/%s: burn amount %s differs from %s on other records of the same redemption\n",
+						record.ChainId, record.Txhash, record.Validator, record.BurnAmount, burnAmount)
+				}
+				return false
+			}
+			burnAmounts[key] = record.BurnAmount
+			expected = expected.Add(record.BurnAmount)
+			return false
+		})
+
+		moduleAddress := k.AccountKeeper.GetModuleAddress(types.ModuleName)
+		denoms := []string{}
+		for _, zone := range k.AllZones(ctx) {
+			denoms = append(denoms, zone.LocalDenom)
+		}
+		sort.Strings(denoms)
+
+		for _, denom := range denoms {
+			escrowed := k.BankKeeper.GetBalance(ctx, moduleAddress, denom).Amount
+			if !escrowed.Equal(expected.AmountOf(denom)) {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s: module account holds %s, withdrawal records expect %s\n", denom, escrowed, expected.AmountOf(denom))
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			withdrawalEscrowInvariantName,
+			fmt.Sprintf("%d withdrawal escrow mismatches found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// DelegationOwnerInvariant checks that every delegation record belongs to one of the zone's delegation accounts.
+func DelegationOwnerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		for _, zone := range k.AllZones(ctx) {
+			owners := map[string]bool{}
+			for _, account := range zone.GetDelegationAccounts() {
+				owners[account.Address] = true
+			}
+			k.IterateAllDelegations(ctx, &zone, func(delegation types.Delegation) (stop bool) {
+				if !owners[delegation.DelegationAddress] {
+					broken++
+					msg += fmt.Sprintf("\tzone %s: delegation of %s to %s is held by %s, which is not a delegation account\n",
+						zone.ChainId, delegation.Amount, delegation.ValidatorAddress, delegation.DelegationAddress)
+				}
+				return false
+			})
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			delegationOwnerInvariantName,
+			fmt.Sprintf("%d delegations not owned by a delegation account\n%s", broken, msg),
+		), broken != 0
+	}
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestInvariants(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	encode := func(prefix string, addr []byte) string {
+		out, err := bech32.ConvertAndEncode(prefix, addr)
+		require.NoError(t, err)
+		return out
+	}
+	delegator := encode("cosmos", utils.GenerateAccAddressForTest())
+	valoper := encode("cosmosvaloper", utils.GenerateValAddressForTest())
+
+	zone := types.Zone{
+		ChainId:            "cosmoshub-4",
+		AccountPrefix:      "cosmos",
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		RedemptionRate:     sdk.MustNewDecFromStr("1.1"),
+		LastRedemptionRate: sdk.MustNewDecFromStr("1.1"),
+		DepositAddress:     &types.ICAAccount{Address: encode("cosmos", utils.GenerateAccAddressForTest())},
+		DelegationAddresses: []*types.ICAAccount{
+			{Address: delegator, Balance: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))},
+		},
+	}
+	kpr.SetZone(ctx, &zone)
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator, valoper, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	// 1000 qatom at 1.1 are backed by 1100 atom.
+	holder := utils.GenerateAccAddressForTest()
+	coins := sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(1200)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, holder, coins))
+
+	// 200 qatom are escrowed for a pending redemption.
+	burnAmount := sdk.NewCoin("uqatom", sdk.NewInt(200))
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, sdk.NewCoins(burnAmount)))
+	kpr.AddWithdrawalRecord(ctx, &zone, delegator, valoper, delegator, sdk.NewCoin("uatom", sdk.NewInt(220)), burnAmount, "hash", ctx.BlockTime())
+	kpr.AddWithdrawalRecord(ctx, &zone, delegator, encode("cosmosvaloper", utils.GenerateValAddressForTest()), delegator, sdk.NewCoin("uatom", sdk.NewInt(0)), burnAmount, "hash", ctx.BlockTime())
+
+	msg, broken := icskeeper.AllInvariants(kpr)(ctx)
+	require.False(t, broken, msg)
+
+	// redemption rate exceeding the backing beyond tolerance.
+	zone.RedemptionRate = sdk.MustNewDecFromStr("1.2")
+	kpr.SetZone(ctx, &zone)
+	msg, broken = icskeeper.RedemptionRateInvariant(kpr)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "zone cosmoshub-4")
+	zone.RedemptionRate = sdk.MustNewDecFromStr("1.1")
+
+	// underflowed waitgroup.
+	zone.WithdrawalWaitgroup--
+	kpr.SetZone(ctx, &zone)
+	msg, broken = icskeeper.WaitgroupInvariant(kpr)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "withdrawal waitgroup is negative (-1)")
+	zone.WithdrawalWaitgroup = 0
+	kpr.SetZone(ctx, &zone)
+
+	// escrow not matching withdrawal records.
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(1)))))
+	msg, broken = icskeeper.WithdrawalEscrowInvariant(kpr)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "module account holds 201, withdrawal records expect 200")
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(1)))))
+
+	// delegation held by an unknown account.
+	stranger := encode("cosmos", utils.GenerateAccAddressForTest())
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(stranger, valoper, sdk.NewCoin("uatom", sdk.NewInt(1))))
+	msg, broken = icskeeper.DelegationOwnerInvariant(kpr)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, stranger)
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authKeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -128,65 +127,6 @@ func (k Keeper) AllPortConnections(ctx sdk.Context) (pcs []types.PortConnectionT
 // * some of these functions (or portions thereof) may be changed to single
 //   query type functions, dependent upon callback features / capabilities;
 
-func SetValidatorsForZone(k Keeper, ctx sdk.Context, zoneInfo types.Zone, data []byte) error {
-	validatorsRes := stakingTypes.QueryValidatorsResponse{}
-	if bytes.Equal(data, []byte("")) {
-		return fmt.Errorf("attempted to unmarshal zero length byte slice (8)")
-	}
-	err := k.cdc.Unmarshal(data, &validatorsRes)
-	if err != nil {
-		k.Logger(ctx).Error("unable to unmarshal validators info for zone", "zone", zoneInfo.ChainId, "err", err)
-		return err
-	}
-
-	for _, validator := range validatorsRes.Validators {
-		_, addr, _ := bech32.DecodeAndConvert(validator.OperatorAddress)
-		val, found := zoneInfo.GetValidatorByValoper(validator.OperatorAddress)
-		if !found {
-			k.Logger(ctx).Info("Unable to find validator - fetching proof...", "valoper", validator.OperatorAddress)
-
-			data := stakingTypes.GetValidatorKey(addr)
-			k.ICQKeeper.MakeRequest(
-				ctx,
-				zoneInfo.ConnectionId,
-				zoneInfo.ChainId,
-				"store/staking/key",
-				data,
-				sdk.NewInt(-1),
-				types.ModuleName,
-				"validator",
-				0,
-			)
-			continue
-		}
-
-		if !val.CommissionRate.Equal(validator.GetCommission()) || !val.VotingPower.Equal(validator.Tokens) || !val.DelegatorShares.Equal(validator.DelegatorShares) {
-			k.Logger(ctx).Info("Validator state change; fetching proof", "valoper", validator.OperatorAddress)
-
-			if err != nil {
-				return err
-			}
-
-			data := stakingTypes.GetValidatorKey(addr)
-			k.ICQKeeper.MakeRequest(
-				ctx,
-				zoneInfo.ConnectionId,
-				zoneInfo.ChainId,
-				"store/staking/key",
-				data,
-				sdk.NewInt(-1),
-				types.ModuleName,
-				"validator",
-				0,
-			)
-		}
-	}
-
-	// also do this for Unbonded and Unbonding
-	k.SetZone(ctx, &zoneInfo)
-	return nil
-}
-
 func SetValidatorForZone(k Keeper, ctx sdk.Context, zoneInfo types.Zone, data []byte) error {
 	validator := stakingTypes.Validator{}
 	if bytes.Equal(data, []byte("")) {
@@ -240,7 +180,7 @@ func (k Keeper) depositInterval(ctx sdk.Context) zoneItrFn {
 				k.Logger(ctx).Info("balance is non zero", "balance", zoneInfo.DepositAddress.Balance)
 
 				req := tx.GetTxsEventRequest{Events: []string{"transfer.recipient='" + zoneInfo.DepositAddress.GetAddress() + "'"}, Pagination: &query.PageRequest{Limit: types.TxRetrieveCount, Reverse: true}}
-				k.ICQKeeper.MakeRequest(ctx, zoneInfo.ConnectionId, zoneInfo.ChainId, "cosmos.tx.v1beta1.Service/GetTxsEvent", k.cdc.MustMarshal(&req), sdk.NewInt(-1), types.ModuleName, "depositinterval", 0)
+				k.ICQKeeper.MakeRequest(ctx, zoneInfo.ConnectionId, zoneInfo.ChainId, "cosmos.tx.v1beta1.Service/GetTxsEvent", k.cdc.MustMarshal(&req), sdk.NewInt(-1), types.ModuleName, "depositinterval", 0, 0, sdk.Coin{})
 
 			}
 		} else {
@@ -262,8 +202,41 @@ func (k *Keeper) GetCommissionRate(ctx sdk.Context) sdk.Dec {
 	return out
 }
 
-func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
-	k.paramStore.GetParamSet(clientCtx, &params)
+func (k *Keeper) GetMaxCuratorWeight(ctx sdk.Context) sdk.Dec {
+	out := types.DefaultMaxCuratorWeight
+	k.paramStore.GetIfExists(ctx, types.KeyMaxCuratorWeight, &out)
+	return out
+}
+
+func (k *Keeper) GetInstantRedemptionFee(ctx sdk.Context) sdk.Dec {
+	out := types.DefaultInstantRedemptionFee
+	k.paramStore.GetIfExists(ctx, types.KeyInstantRedemptionFee, &out)
+	return out
+}
+
+// queryBounty returns the bounty attached to each proven query emitted for a zone, or no bounty if the QueryBounty
+// parameter is zero. Bounties are paid from the interchainstaking bounty budget, in the interchainquery bounty denom.
+// Responses to unproven queries may be fabricated, so no bounty is attached to them.
+func (k *Keeper) queryBounty(ctx sdk.Context) sdk.Coin {
+	amount := types.DefaultQueryBounty
+	k.paramStore.GetIfExists(ctx, types.KeyQueryBounty, &amount)
+	if !amount.IsPositive() {
+		return sdk.Coin{}
+	}
+	return sdk.NewCoin(k.ICQKeeper.BountyDenom(ctx), amount)
+}
+
+// GetParams returns the total set of interchainstaking parameters. Parameters not yet set, such as those added
+// since a chain was upgraded, take their default values.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramStore.GetIfExists(ctx, types.KeyDelegateAccountCount, &params.DelegationAccountCount)
+	k.paramStore.GetIfExists(ctx, types.KeyDepositInterval, &params.DepositInterval)
+	k.paramStore.GetIfExists(ctx, types.KeyValidatorSetInterval, &params.ValidatorsetInterval)
+	k.paramStore.GetIfExists(ctx, types.KeyCommissionRate, &params.CommissionRate)
+	k.paramStore.GetIfExists(ctx, types.KeyMaxCuratorWeight, &params.MaxCuratorWeight)
+	k.paramStore.GetIfExists(ctx, types.KeyInstantRedemptionFee, &params.InstantRedemptionFee)
+	k.paramStore.GetIfExists(ctx, types.KeyQueryBounty, &params.QueryBounty)
 	return params
 }
 
@@ -315,6 +288,8 @@ func (k Keeper) EmitPerformanceBalanceQuery(ctx sdk.Context, zone *types.Zone) e
 		types.ModuleName,
 		"perfbalance",
 		0,
+		0,
+		sdk.Coin{},
 	)
 
 	return nil
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	LiquidityBufferSourceDeposit = "deposit"
+	LiquidityBufferSourceRewards = "rewards"
+)
+
+// RetainForLiquidityBuffer adds the part of a deposit required to bring the zone's liquidity buffer to its target to
+// the buffer, and returns the amount retained. The retained amount remains in the deposit account, undelegated.
+func (k *Keeper) RetainForLiquidityBuffer(ctx sdk.Context, chainID string, deposit sdk.Int) (sdk.Int, error) {
+	zone, found := k.GetZone(ctx, chainID)
+	if !found {
+		return sdk.ZeroInt(), fmt.Errorf("unable to find zone for %s", chainID)
+	}
+	retained := zone.LiquidityBufferTopUp(k.GetDelegatedAmount(ctx, &zone).Amount, deposit)
+	if !retained.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+	return retained, k.addToLiquidityBuffer(ctx, &zone, LiquidityBufferSourceDeposit, retained)
+}
+
+// handleLiquidityBufferTopUp adds rewards sent from the withdrawal account to the deposit account to the liquidity buffer.
+func (k *Keeper) handleLiquidityBufferTopUp(ctx sdk.Context, zone *types.Zone, msg *banktypes.MsgSend) error {
+	return k.addToLiquidityBuffer(ctx, zone, LiquidityBufferSourceRewards, msg.Amount.AmountOf(zone.BaseDenom))
+}
+
+func (k *Keeper) addToLiquidityBuffer(ctx sdk.Context, zone *types.Zone, source string, amount sdk.Int) error {
+	zone.LiquidityBuffer = zone.GetLiquidityBuffer().Add(amount)
+	k.SetZone(ctx, zone)
+	k.Logger(ctx).Info("topped up liquidity buffer", "chain_id", zone.ChainId, "source", source, "amount", amount, "balance", zone.LiquidityBuffer)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventLiquidityBufferToppedUp{
+		ChainId: zone.ChainId,
+		Source:  source,
+		Amount:  sdk.NewCoin(zone.BaseDenom, amount),
+		Balance: sdk.NewCoin(zone.BaseDenom, zone.LiquidityBuffer),
+	})
+}
+
+// PendingInstantRedemptions returns the native assets paid out of the zone's liquidity buffer that have not yet
+// reached their recipients; the qAssets redeemed for them remain in escrow until they do.
+func (k Keeper) PendingInstantRedemptions(ctx sdk.Context, zone *types.Zone) sdk.Int {
+	pending := sdk.ZeroInt()
+	if zone.DepositAddress == nil {
+		return pending
+	}
+	k.IterateZoneDelegatorWithdrawalRecords(ctx, zone, zone.DepositAddress.Address, func(_ int64, withdrawal types.WithdrawalRecord) (stop bool) {
+		pending = pending.Add(withdrawal.Amount.Amount)
+		return false
+	})
+	return pending
+}
+
+// refundInstantRedemption returns the payout of the instant redemption to the liquidity buffer, refunds the escrowed
+// qAssets to the redeemer and deletes the withdrawal record.
+func (k *Keeper) refundInstantRedemption(ctx sdk.Context, zone *types.Zone, withdrawal types.WithdrawalRecord) error {
+	redeemer, err := sdk.AccAddressFromBech32(withdrawal.Redeemer)
+	if err != nil {
+		return fmt.Errorf("unable to refund instant redemption %s: %w", withdrawal.Txhash, err)
+	}
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, sdk.NewCoins(withdrawal.BurnAmount)); err != nil {
+		return err
+	}
+	k.DeleteWithdrawalRecord(ctx, zone, withdrawal.Txhash, withdrawal.Delegator, withdrawal.Validator)
+
+	zone.LiquidityBuffer = zone.GetLiquidityBuffer().Add(withdrawal.Amount.Amount)
+	k.SetZone(ctx, zone)
+	k.Logger(ctx).Info("instant redemption payout failed; refunded", "chain_id", zone.ChainId, "txhash", withdrawal.Txhash, "redeemer", withdrawal.Redeemer, "refund", withdrawal.BurnAmount, "balance", zone.LiquidityBuffer)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventInstantRedemptionRefunded{
+		ChainId:      zone.ChainId,
+		Txhash:       withdrawal.Txhash,
+		Redeemer:     withdrawal.Redeemer,
+		RefundAmount: withdrawal.BurnAmount,
+		RedeemAmount: withdrawal.Amount,
+	})
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestInstantRedeemFromLiquidityBuffer(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+	msgSrv := icskeeper.NewMsgServerImpl(kpr)
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	zone.LiquidityBufferRatio = sdk.NewDecWithPrec(1, 1)
+	zone.LiquidityBuffer = sdk.NewInt(1000)
+	kpr.SetZone(ctx, &zone)
+
+	redeemer := utils.GenerateAccAddressForTest()
+	qAssets := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1500)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, qAssets))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, qAssets))
+	recipient, err := bech32.ConvertAndEncode(zone.AccountPrefix, utils.GenerateAccAddressForTest())
+	require.NoError(t, err)
+
+	// 1. the payout, less the fee, is drawn from the buffer and sent from the deposit account.
+	res, err := msgSrv.InstantRedeem(sdk.WrapSDKContext(ctx), types.NewMsgInstantRedeem(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(500)), recipient, redeemer))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(497)), res.Amount)
+	require.Equal(t, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(3)), res.Fee)
+
+	zone, found := kpr.GetZone(ctx, zone.ChainId)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(503), zone.GetLiquidityBuffer())
+	require.Equal(t, sdk.NewInt(497), kpr.PendingInstantRedemptions(ctx, &zone))
+	require.Len(t, kpr.AllZoneDelegatorWithdrawalRecords(ctx, &zone, zone.DepositAddress.Address), 1)
+
+	// 2. redemptions exceeding the buffer are rejected.
+	_, err = msgSrv.InstantRedeem(sdk.WrapSDKContext(ctx), types.NewMsgInstantRedeem(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000)), recipient, redeemer))
+	require.ErrorContains(t, err, "insufficient liquidity buffer")
+
+	// 3. the escrowed qAssets are burned once the host acknowledges the payout.
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, kpr.AllZoneDelegatorWithdrawalRecords(ctx, &zone, zone.DepositAddress.Address))
+	require.True(t, kpr.PendingInstantRedemptions(ctx, &zone).IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName), zone.LocalDenom).IsZero())
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount)
+
+	// 4. deposits top the buffer up to its target before delegation.
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	expected := zone.LiquidityBufferTopUp(kpr.GetDelegatedAmount(ctx, &zone).Amount, sdk.NewInt(10000))
+	require.True(t, expected.IsPositive())
+	retained, err := kpr.RetainForLiquidityBuffer(ctx, zone.ChainId, sdk.NewInt(10000))
+	require.NoError(t, err)
+	require.Equal(t, expected, retained)
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, sdk.NewInt(503).Add(expected), zone.GetLiquidityBuffer())
+	require.Len(t, typedEvents(t, ctx, &types.EventLiquidityBufferToppedUp{}), 1)
+}
+
+func TestInstantRedeemRefundedOnFailedPayout(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+	msgSrv := icskeeper.NewMsgServerImpl(kpr)
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	zone.LiquidityBufferRatio = sdk.NewDecWithPrec(1, 1)
+	zone.LiquidityBuffer = sdk.NewInt(1000)
+	kpr.SetZone(ctx, &zone)
+	host.RejectMessages(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+	redeemer := utils.GenerateAccAddressForTest()
+	qAssets := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, qAssets))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, qAssets))
+	recipient, err := bech32.ConvertAndEncode(zone.AccountPrefix, utils.GenerateAccAddressForTest())
+	require.NoError(t, err)
+
+	redeem := func(amount int64) {
+		_, err := msgSrv.InstantRedeem(sdk.WrapSDKContext(ctx), types.NewMsgInstantRedeem(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(amount)), recipient, redeemer))
+		require.NoError(t, err)
+		zone, _ = kpr.GetZone(ctx, zone.ChainId)
+		require.Equal(t, sdk.NewInt(1000-amount).Add(sdk.NewDec(amount).Mul(kpr.GetInstantRedemptionFee(ctx)).Ceil().TruncateInt()), zone.GetLiquidityBuffer())
+		require.Equal(t, sdk.NewInt(1000-amount), app.BankKeeper.GetBalance(ctx, redeemer, zone.LocalDenom).Amount)
+		require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	}
+	refunded := func() {
+		zone, _ = kpr.GetZone(ctx, zone.ChainId)
+		require.Equal(t, sdk.NewInt(1000), zone.GetLiquidityBuffer())
+		require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, redeemer, zone.LocalDenom).Amount)
+		require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName), zone.LocalDenom).IsZero())
+		require.Empty(t, kpr.AllZoneDelegatorWithdrawalRecords(ctx, &zone, zone.DepositAddress.Address))
+		require.Len(t, typedEvents(t, ctx, &types.EventInstantRedemptionRefunded{}), 1)
+	}
+
+	// 1. a payout rejected by the host is returned to the buffer, and the escrowed qAssets to the redeemer.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	redeem(500)
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	refunded()
+
+	// 2. so is a payout whose packet times out.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	redeem(300)
+	_, err = host.TimeoutPackets(ctx)
+	require.NoError(t, err)
+	refunded()
+}
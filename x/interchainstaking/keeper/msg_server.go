@@ -11,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/ingenuity-build/quicksilver/internal/multierror"
@@ -48,24 +49,15 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, err
 	}
 
-	var zone *types.Zone
-
-	k.IterateZones(ctx, func(_ int64, thisZone types.Zone) bool {
-		if thisZone.LocalDenom == msg.Value.GetDenom() {
-			zone = &thisZone
-			return true
-		}
-		return false
-	})
-
 	// does zone exist?
-	if nil == zone {
+	zoneInfo, found := k.GetZoneForLocalDenom(ctx, msg.Value.GetDenom())
+	if !found {
 		return nil, fmt.Errorf("unable to find matching zone for denom %s", msg.Value.GetDenom())
 	}
+	zone := &zoneInfo
 
-	// does destination address match the prefix registered against the zone?
-	if _, err := utils.AccAddressFromBech32(msg.DestinationAddress, zone.AccountPrefix); err != nil {
-		return nil, fmt.Errorf("destination address %s does not match expected prefix %s", msg.DestinationAddress, zone.AccountPrefix)
+	if err := k.validateRedemptionDestination(ctx, zone, msg.DestinationAddress); err != nil {
+		return nil, err
 	}
 
 	sender, err := sdk.AccAddressFromBech32(msg.FromAddress)
@@ -187,6 +179,17 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRequested{
+		ChainId:      zone.ChainId,
+		Txhash:       hashString,
+		Redeemer:     msg.FromAddress,
+		Recipient:    msg.DestinationAddress,
+		BurnAmount:   msg.Value,
+		RedeemAmount: sumAmount,
+	}); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -205,6 +208,136 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 	return &types.MsgRequestRedemptionResponse{}, nil
 }
 
+// validateRedemptionDestination checks that the destination address matches the prefix registered against the zone.
+// If not, it may be a local address, to which the redemption is returned over IBC.
+func (k msgServer) validateRedemptionDestination(ctx sdk.Context, zone *types.Zone, destination string) error {
+	if _, err := utils.AccAddressFromBech32(destination, zone.AccountPrefix); err == nil {
+		return nil
+	}
+	localAddress, err := utils.AccAddressFromBech32(destination, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		return fmt.Errorf("destination address %s does not match expected prefix %s or %s", destination, zone.AccountPrefix, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	}
+	if k.BankKeeper.BlockedAddr(localAddress) {
+		return fmt.Errorf("destination address %s is not allowed to receive funds", destination)
+	}
+	_, _, err = k.GetRemoteTransferChannel(ctx, zone.ConnectionId)
+	return err
+}
+
+// InstantRedeem burns qAssets for native assets paid from the zone's liquidity buffer, less the instant redemption
+// fee, which is retained in the buffer. The qAssets are escrowed until the host acknowledges the payout, and refunded
+// if the payout fails.
+func (k msgServer) InstantRedeem(goCtx context.Context, msg *types.MsgInstantRedeem) (*types.MsgInstantRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Value.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(msg.DestinationAddress) == 0 {
+		return nil, fmt.Errorf("recipient address not provided")
+	}
+
+	zoneInfo, found := k.GetZoneForLocalDenom(ctx, msg.Value.GetDenom())
+	if !found {
+		return nil, fmt.Errorf("unable to find matching zone for denom %s", msg.Value.GetDenom())
+	}
+	zone := &zoneInfo
+
+	if zone.DepositAddress == nil {
+		return nil, fmt.Errorf("zone %s has no deposit account", zone.ChainId)
+	}
+
+	if err := k.validateRedemptionDestination(ctx, zone, msg.DestinationAddress); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.BankKeeper.HasBalance(ctx, sender, msg.Value) {
+		return nil, fmt.Errorf("account has insufficient balance of qasset to burn")
+	}
+
+	// get min of LastRedemptionRate (N-1) and RedemptionRate (N)
+	rate := zone.LastRedemptionRate
+	if zone.RedemptionRate.LT(rate) {
+		rate = zone.RedemptionRate
+	}
+
+	nativeTokens := msg.Value.Amount.ToDec().Mul(rate).TruncateInt()
+	fee := sdk.NewCoin(zone.BaseDenom, nativeTokens.ToDec().Mul(k.GetInstantRedemptionFee(ctx)).Ceil().TruncateInt())
+	amount := sdk.NewCoin(zone.BaseDenom, nativeTokens.Sub(fee.Amount))
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("redemption of %s yields no native assets after fee", msg.Value)
+	}
+	if amount.Amount.GT(zone.GetLiquidityBuffer()) {
+		return nil, fmt.Errorf("insufficient liquidity buffer for instant redemption: requested %s, available %s%s", amount, zone.GetLiquidityBuffer(), zone.BaseDenom)
+	}
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(ctx.BlockHeight()))
+	hash := sha256.Sum256(append(msg.GetSignBytes(), heightBytes...))
+	hashString := hex.EncodeToString(hash[:])
+
+	if _, found := k.GetWithdrawalRecord(ctx, zone, hashString, zone.DepositAddress.Address, ""); found {
+		return nil, fmt.Errorf("cannot redeem twice in a single transaction")
+	}
+
+	// lock qAssets until the payout is acknowledged.
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.Value)); err != nil {
+		return nil, err
+	}
+
+	zone.LiquidityBuffer = zone.GetLiquidityBuffer().Sub(amount.Amount)
+	k.SetZone(ctx, zone)
+
+	record := types.WithdrawalRecord{
+		ChainId:       zone.ChainId,
+		Delegator:     zone.DepositAddress.Address,
+		Recipient:     msg.DestinationAddress,
+		Amount:        amount,
+		BurnAmount:    msg.Value,
+		Txhash:        hashString,
+		Status:        WithdrawStatusSend,
+		SlashedAmount: sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt()),
+		Redeemer:      msg.FromAddress,
+	}
+	sendMsg, err := k.withdrawalPayoutMsg(ctx, zone, &record)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SubmitTx(ctx, []sdk.Msg{sendMsg}, zone.DepositAddress, hashString); err != nil {
+		k.Logger(ctx).Error("error submitting tx", "err", err)
+		return nil, err
+	}
+	k.SetWithdrawalRecord(ctx, &record)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventInstantRedemptionRequested{
+		ChainId:      zone.ChainId,
+		Txhash:       hashString,
+		Redeemer:     msg.FromAddress,
+		Recipient:    msg.DestinationAddress,
+		BurnAmount:   msg.Value,
+		RedeemAmount: amount,
+		Fee:          fee,
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgInstantRedeemResponse{Amount: amount, Fee: fee}, nil
+}
+
 func (k msgServer) SignalIntent(goCtx context.Context, msg *types.MsgSignalIntent) (*types.MsgSignalIntentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -226,21 +359,109 @@ func (k msgServer) SignalIntent(goCtx context.Context, msg *types.MsgSignalInten
 
 	k.SetIntent(ctx, zone, intent, false)
 
-	// ctx.EventManager().EmitEvents(sdk.Events{
-	// 	sdk.NewEvent(
-	// 		sdk.EventTypeMessage,
-	// 		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-	// 	),
-	// 	sdk.NewEvent(
-	// 		types.EventTypeRegisterZone,
-	// 		sdk.NewAttribute(types.AttributeKeyConnectionId, msg.ConnectionId),
-	// 		sdk.NewAttribute(types.AttributeKeyConnectionId, msg.ChainId),
-	// 	),
-	// })
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIntentSignalled{
+		ChainId:   zone.ChainId,
+		Delegator: msg.FromAddress,
+		Intents:   msg.Intents,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgSignalIntentResponse{}, nil
 }
 
+func (k msgServer) DelegateIntent(goCtx context.Context, msg *types.MsgDelegateIntent) (*types.MsgDelegateIntentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get zone
+	zone, ok := k.GetZone(ctx, msg.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("invalid chain id \"%s\"", msg.ChainId)
+	}
+
+	// empty curator address removes the existing delegation.
+	if msg.CuratorAddress == "" {
+		k.DeleteIntentDelegation(ctx, zone, msg.FromAddress)
+		return &types.MsgDelegateIntentResponse{}, nil
+	}
+
+	if msg.CuratorAddress == msg.FromAddress {
+		return nil, fmt.Errorf("cannot delegate intent to self")
+	}
+
+	// apply the delegation in a cached context, so that we may reject any that introduce a cycle or
+	// push the chain of the sender, or of any of their followers, past the maximum depth.
+	cacheCtx, write := ctx.CacheContext()
+	k.SetIntentDelegation(cacheCtx, zone, types.IntentDelegation{Follower: msg.FromAddress, Curator: msg.CuratorAddress})
+	if err := k.ValidateIntentDelegationChains(cacheCtx, zone, msg.FromAddress); err != nil {
+		return nil, err
+	}
+	write()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeDelegateIntent,
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeySourceAddress, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyCurator, msg.CuratorAddress),
+		),
+	})
+
+	return &types.MsgDelegateIntentResponse{}, nil
+}
+
+func (k msgServer) VoteOnHostProposal(goCtx context.Context, msg *types.MsgVoteOnHostProposal) (*types.MsgVoteOnHostProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get zone
+	zone, ok := k.GetZone(ctx, msg.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("invalid chain id \"%s\"", msg.ChainId)
+	}
+
+	proposal, found := k.GetHostProposal(ctx, zone, msg.ProposalId)
+	if !found {
+		return nil, fmt.Errorf("unable to find proposal %d for %s", msg.ProposalId, msg.ChainId)
+	}
+
+	if proposal.Status != HostProposalStatusOpen || !proposal.VotingEndTime.After(ctx.BlockTime()) {
+		return nil, fmt.Errorf("proposal %d for %s is no longer accepting votes", msg.ProposalId, msg.ChainId)
+	}
+
+	k.SetHostVote(ctx, types.HostVote{
+		ChainId:    msg.ChainId,
+		ProposalId: msg.ProposalId,
+		Voter:      msg.FromAddress,
+		Options:    msg.Options,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeHostVote,
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeySourceAddress, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyVoteOptions, govtypes.WeightedVoteOptions(msg.Options).String()),
+		),
+	})
+
+	return &types.MsgVoteOnHostProposalResponse{}, nil
+}
+
 func (k msgServer) validateIntents(zone types.Zone, intents []*types.ValidatorIntent) error {
 	errors := make(map[string]error)
 
@@ -258,57 +479,39 @@ func (k msgServer) validateIntents(zone types.Zone, intents []*types.ValidatorIn
 	return nil
 }
 
+// EmitValsetRequery queries the full host validator set, with a proof that the set is complete.
 func (k Keeper) EmitValsetRequery(ctx sdk.Context, connectionID string, chainID string) error {
-	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
-	bz1, err := k.cdc.Marshal(&bondedQuery)
-	if err != nil {
-		return err
-	}
-	unbondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusUnbonded}
-	bz2, err := k.cdc.Marshal(&unbondedQuery)
-	if err != nil {
-		return err
+	zone, found := k.GetZone(ctx, chainID)
+	if !found {
+		return fmt.Errorf("unable to find zone for %s", chainID)
 	}
-	unbondingQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusUnbonding}
-	bz3, err := k.cdc.Marshal(&unbondingQuery)
-	if err != nil {
-		return err
-	}
-
-	period := int64(k.GetParam(ctx, types.KeyValidatorSetInterval))
+	period := int64(k.GetZoneParam(ctx, &zone, types.KeyValidatorSetInterval))
 
 	k.ICQKeeper.MakeRequest(
 		ctx,
 		connectionID,
 		chainID,
-		"cosmos.staking.v1beta1.Query/Validators",
-		bz1,
+		"store/staking/subspace",
+		stakingtypes.ValidatorsKey,
 		sdk.NewInt(period),
 		types.ModuleName,
 		"valset",
 		0,
-	)
-	k.ICQKeeper.MakeRequest(
-		ctx,
-		connectionID,
-		chainID,
-		"cosmos.staking.v1beta1.Query/Validators",
-		bz2,
-		sdk.NewInt(period),
-		types.ModuleName,
-		"valset",
-		0,
-	)
-	k.ICQKeeper.MakeRequest(
-		ctx,
-		connectionID,
-		chainID,
-		"cosmos.staking.v1beta1.Query/Validators",
-		bz3,
-		sdk.NewInt(period),
-		types.ModuleName,
-		"valset",
 		0,
+		k.queryBounty(ctx),
 	)
 	return nil
 }
+
+// ReplaceLegacyValsetQueries replaces the validator set queries of each zone, which were of the legacy gRPC form, one
+// for each bond status, with the proven subspace query.
+func (k Keeper) ReplaceLegacyValsetQueries(ctx sdk.Context) error {
+	for _, zone := range k.AllZones(ctx) {
+		zone := zone
+		k.deleteZoneQueries(ctx, &zone, "valset")
+		if err := k.EmitValsetRequery(ctx, zone.ConnectionId, zone.ChainId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
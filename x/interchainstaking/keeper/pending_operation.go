@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetPendingOperation returns the pending operation for the given chain and memo.
+func (k Keeper) GetPendingOperation(ctx sdk.Context, chainID string, memo string) (types.PendingOperation, bool) {
+	operation := types.PendingOperation{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingOperationKey(chainID, memo))
+	if len(bz) == 0 {
+		return operation, false
+	}
+	k.cdc.MustUnmarshal(bz, &operation)
+	return operation, true
+}
+
+// SetPendingOperation stores the pending operation.
+func (k Keeper) SetPendingOperation(ctx sdk.Context, operation types.PendingOperation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&operation)
+	store.Set(types.GetPendingOperationKey(operation.ChainId, operation.Memo), bz)
+}
+
+// DeletePendingOperation deletes the pending operation.
+func (k Keeper) DeletePendingOperation(ctx sdk.Context, chainID string, memo string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingOperationKey(chainID, memo))
+}
+
+// IteratePendingOperations iterates through all pending operations.
+func (k Keeper) IteratePendingOperations(ctx sdk.Context, fn func(index int64, operation types.PendingOperation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingOperation)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		operation := types.PendingOperation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &operation)
+
+		stop := fn(i, operation)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllPendingOperations returns every pending operation in the store.
+func (k Keeper) AllPendingOperations(ctx sdk.Context) []types.PendingOperation {
+	operations := []types.PendingOperation{}
+	k.IteratePendingOperations(ctx, func(_ int64, operation types.PendingOperation) (stop bool) {
+		operations = append(operations, operation)
+		return false
+	})
+	return operations
+}
+
+// AddPendingOperationChunks records that chunks packets were submitted for the operation identified by memo.
+func (k Keeper) AddPendingOperationChunks(ctx sdk.Context, chainID string, memo string, chunks uint32) {
+	operation, found := k.GetPendingOperation(ctx, chainID, memo)
+	if !found {
+		operation = types.PendingOperation{ChainId: chainID, Memo: memo}
+	}
+	operation.Outstanding += chunks
+	k.SetPendingOperation(ctx, operation)
+}
+
+// OperationMemo returns the memo of a module-initiated operation of the given kind for the given account, unique to
+// the current height, under which the packets submitted for it are tracked as a pending operation.
+func OperationMemo(ctx sdk.Context, kind string, account string) string {
+	return fmt.Sprintf("%s/%s/%d", kind, account, ctx.BlockHeight())
+}
+
+// ResolvePendingOperationChunk records the acknowledgement (or timeout, if failed is true) of a single
+// packet for the operation identified by memo. Once no packets remain outstanding, the operation is
+// removed and an event is emitted. It returns true if the operation has completed.
+func (k Keeper) ResolvePendingOperationChunk(ctx sdk.Context, chainID string, memo string, failed bool) bool {
+	operation, found := k.GetPendingOperation(ctx, chainID, memo)
+	if !found {
+		return false
+	}
+
+	if failed {
+		operation.Failed++
+	}
+	if operation.Outstanding > 0 {
+		operation.Outstanding--
+	}
+
+	if operation.Outstanding > 0 {
+		k.SetPendingOperation(ctx, operation)
+		return false
+	}
+
+	k.DeletePendingOperation(ctx, chainID, memo)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOperationComplete,
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, chainID),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+			sdk.NewAttribute(types.AttributeKeyFailedChunks, fmt.Sprintf("%d", operation.Failed)),
+		),
+	)
+	return true
+}
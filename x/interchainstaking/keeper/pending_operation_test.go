@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestPendingOperationChunks(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	chainID := "cosmoshub-4"
+	memo := "abcdef"
+
+	_, found := kpr.GetPendingOperation(ctx, chainID, memo)
+	require.False(t, found)
+
+	kpr.AddPendingOperationChunks(ctx, chainID, memo, 2)
+	kpr.AddPendingOperationChunks(ctx, chainID, memo, 1)
+	operation, found := kpr.GetPendingOperation(ctx, chainID, memo)
+	require.True(t, found)
+	require.Equal(t, uint32(3), operation.Outstanding)
+
+	require.False(t, kpr.ResolvePendingOperationChunk(ctx, chainID, memo, false))
+	require.False(t, kpr.ResolvePendingOperationChunk(ctx, chainID, memo, true))
+	operation, _ = kpr.GetPendingOperation(ctx, chainID, memo)
+	require.Equal(t, uint32(1), operation.Outstanding)
+	require.Equal(t, uint32(1), operation.Failed)
+
+	require.True(t, kpr.ResolvePendingOperationChunk(ctx, chainID, memo, false))
+	require.Empty(t, kpr.AllPendingOperations(ctx))
+
+	// resolving an unknown operation is a no-op.
+	require.False(t, kpr.ResolvePendingOperationChunk(ctx, chainID, memo, false))
+}
+
+func TestFailedPacketsResolveOperation(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	host.RejectMessages(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+	send := &banktypes.MsgSend{FromAddress: zone.DepositAddress.Address, ToAddress: zone.DelegationAddresses[0].Address, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))}
+	failedChunks := func() []string {
+		failed := []string{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeOperationComplete {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyFailedChunks {
+					failed = append(failed, string(attr.Value))
+				}
+			}
+		}
+		return failed
+	}
+
+	// 1. an error acknowledgement resolves its chunk as failed, and completes the operation.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, kpr.SubmitTx(ctx, []sdk.Msg{send}, zone.DepositAddress, "rejected"))
+	_, found := kpr.GetPendingOperation(ctx, zone.ChainId, "rejected")
+	require.True(t, found)
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	_, found = kpr.GetPendingOperation(ctx, zone.ChainId, "rejected")
+	require.False(t, found)
+	require.Equal(t, []string{"1"}, failedChunks())
+
+	// 2. so does a timeout.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, kpr.SubmitTx(ctx, []sdk.Msg{send}, zone.DepositAddress, "timedout"))
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	_, err = host.TimeoutPackets(ctx)
+	require.NoError(t, err)
+	_, found = kpr.GetPendingOperation(ctx, zone.ChainId, "timedout")
+	require.False(t, found)
+	require.Equal(t, []string{"1"}, failedChunks())
+}
+
+func TestRewardsWithdrawalCompletesWithEveryChunk(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+	zone.MaxTxMsgs = 1
+	kpr.SetZone(ctx, &zone)
+
+	// one delegation account's rewards from two validators are withdrawn in two packets, both of which fail.
+	delegator := zone.DelegationAddresses[0].Address
+	rewards := distrtypes.QueryDelegationTotalRewardsResponse{}
+	for i := 0; i < 2; i++ {
+		validator, err := bech32.ConvertAndEncode(zone.AccountPrefix+"valoper", utils.GenerateValAddressForTest())
+		require.NoError(t, err)
+		kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator, validator, sdk.NewInt64Coin(zone.BaseDenom, 1000)))
+		rewards.Rewards = append(rewards.Rewards, distrtypes.DelegationDelegatorReward{ValidatorAddress: validator, Reward: sdk.NewDecCoins(sdk.NewInt64DecCoin(zone.BaseDenom, 10))})
+	}
+	host.RejectMessages(sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}))
+	require.NoError(t, kpr.WithdrawDelegationRewardsForResponse(ctx, &zone, delegator, kpr.GetCodec().MustMarshal(&rewards)))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, uint32(1), zone.WithdrawalWaitgroup)
+
+	distributeQueries := func() int {
+		count := 0
+		for _, query := range app.InterchainQueryKeeper.AllQueries(ctx) {
+			if query.CallbackId == "distributerewards" {
+				count++
+			}
+		}
+		return count
+	}
+	require.NoError(t, host.CapturePackets(ctx.EventManager().ABCIEvents()))
+	require.Equal(t, 2, host.PendingPackets())
+	require.Zero(t, distributeQueries())
+
+	// the waitgroup is released, and the rewards distributed, only once both packets have been resolved.
+	resolved, err := host.AcknowledgePackets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, resolved)
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Zero(t, zone.WithdrawalWaitgroup)
+	require.Equal(t, 1, distributeQueries())
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// A newly registered zone remains pending, with only its deposit account registered, until the host chain is
+// found to match the registration: the host bond denom must equal the zone base denom, the deposit account
+// address must have the zone account prefix, and the host must allow the zone's interchain accounts to execute
+// every message the zone requires. The zone is activated once all checks pass, and removed if any check fails or
+// a preflight query times out.
+
+// EmitPreflightQueries emits the queries for the host chain parameters checked before the zone is activated.
+func (k Keeper) EmitPreflightQueries(ctx sdk.Context, zone *types.Zone) {
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"store/params/key",
+		types.HostBondDenomKey,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"preflightbonddenom",
+		0,
+		0,
+		k.queryBounty(ctx),
+	)
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"store/params/key",
+		types.HostAllowMessagesKey,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"preflightallowedmessages",
+		0,
+		0,
+		k.queryBounty(ctx),
+	)
+}
+
+// deletePreflightQueries removes any outstanding preflight queries for the zone.
+func (k Keeper) deletePreflightQueries(ctx sdk.Context, zone *types.Zone) {
+	for _, key := range [][]byte{types.HostBondDenomKey, types.HostAllowMessagesKey} {
+		k.ICQKeeper.DeleteQuery(ctx, interchainquerykeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "store/params/key", key, types.ModuleName))
+	}
+}
+
+// BondDenomPreflightCallback checks the proven host staking bond denom against the zone base denom.
+func BondDenomPreflightCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+	if zone.IsActive() {
+		return nil
+	}
+
+	// an empty payload is a proof that the host has no staking bond denom.
+	if len(args) == 0 {
+		return k.failPreflightCheck(ctx, &zone, types.PreflightCheckBondDenom, "host has no staking bond denom")
+	}
+	denom, err := types.ParseHostBondDenom(args)
+	if err != nil {
+		return k.failPreflightCheck(ctx, &zone, types.PreflightCheckBondDenom, err.Error())
+	}
+	if denom != zone.BaseDenom {
+		return k.failPreflightCheck(ctx, &zone, types.PreflightCheckBondDenom, fmt.Sprintf("host bond denom %s does not match base denom %s", denom, zone.BaseDenom))
+	}
+
+	zone.Preflight.BondDenom = true
+	return k.passPreflightCheck(ctx, &zone, types.PreflightCheckBondDenom)
+}
+
+// AllowedMessagesPreflightCallback checks the proven interchain accounts host allow list against the messages
+// required by the zone.
+func AllowedMessagesPreflightCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+	if zone.IsActive() {
+		return nil
+	}
+
+	// an empty payload is a proof that the host allows no interchain account messages.
+	if len(args) == 0 {
+		return k.failPreflightCheck(ctx, &zone, types.PreflightCheckAllowedMessages, "host allows no interchain account messages")
+	}
+	missing, err := zone.MissingHostMessages(args)
+	if err != nil {
+		return k.failPreflightCheck(ctx, &zone, types.PreflightCheckAllowedMessages, err.Error())
+	}
+	if len(missing) > 0 {
+		return k.failPreflightCheck(ctx, &zone, types.PreflightCheckAllowedMessages, fmt.Sprintf("host does not allow %s", strings.Join(missing, ", ")))
+	}
+
+	zone.Preflight.AllowedMessages = true
+	return k.passPreflightCheck(ctx, &zone, types.PreflightCheckAllowedMessages)
+}
+
+// PreflightTimeoutCallback removes a pending zone whose preflight query remained unanswered after its final retry,
+// so that a zone the host cannot be queried for does not remain pending forever.
+func PreflightTimeoutCallback(k Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found || zone.IsActive() {
+		// the zone was already removed, by a failed check or the timeout of its other preflight query.
+		return nil
+	}
+
+	check := types.PreflightCheckBondDenom
+	if query.CallbackId == "preflightallowedmessages" {
+		check = types.PreflightCheckAllowedMessages
+	}
+	return k.failPreflightCheck(ctx, &zone, check, "preflight query timed out")
+}
+
+// checkAccountPrefix checks the prefix of the deposit account address of a pending zone against the zone account
+// prefix. The zone is removed if they differ.
+func (k Keeper) checkAccountPrefix(ctx sdk.Context, zone *types.Zone) error {
+	hrp, _, err := bech32.DecodeAndConvert(zone.DepositAddress.Address)
+	if err != nil {
+		return k.failPreflightCheck(ctx, zone, types.PreflightCheckAccountPrefix, err.Error())
+	}
+	if hrp != zone.AccountPrefix {
+		return k.failPreflightCheck(ctx, zone, types.PreflightCheckAccountPrefix, fmt.Sprintf("deposit account prefix %s does not match account prefix %s", hrp, zone.AccountPrefix))
+	}
+
+	zone.Preflight.AccountPrefix = true
+	return k.passPreflightCheck(ctx, zone, types.PreflightCheckAccountPrefix)
+}
+
+// passPreflightCheck records a passed check, and activates the zone if every check has passed.
+func (k Keeper) passPreflightCheck(ctx sdk.Context, zone *types.Zone, check string) error {
+	k.Logger(ctx).Info("zone preflight check passed", "chain_id", zone.ChainId, "check", check)
+	if !zone.Preflight.Passed() {
+		k.SetZone(ctx, zone)
+		return nil
+	}
+	return k.activateZone(ctx, zone)
+}
+
+// failPreflightCheck removes a pending zone that has failed a check. The deposit account channel is left open,
+// and is reused if the zone is registered again.
+func (k Keeper) failPreflightCheck(ctx sdk.Context, zone *types.Zone, check string, reason string) error {
+	k.Logger(ctx).Error("zone preflight check failed; removing zone", "chain_id", zone.ChainId, "check", check, "reason", reason)
+	k.removePendingZone(ctx, zone)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventZoneRegistrationFailed{
+		ChainId:      zone.ChainId,
+		ConnectionId: zone.ConnectionId,
+		Check:        check,
+		Reason:       reason,
+	})
+}
+
+// removePendingZone removes a zone whose registration is pending, and its outstanding preflight queries.
+func (k Keeper) removePendingZone(ctx sdk.Context, zone *types.Zone) {
+	k.deletePreflightQueries(ctx, zone)
+	k.DeleteZone(ctx, zone.ChainId)
+}
+
+// activateZone clears the preflight state of the zone, registers its remaining interchain accounts and starts
+// the queries run for every active zone.
+func (k Keeper) activateZone(ctx sdk.Context, zone *types.Zone) error {
+	zone.Preflight = nil
+	k.SetZone(ctx, zone)
+
+	// generate withdrawal account
+	portOwner := zone.ChainId + ".withdrawal"
+	if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
+		return err
+	}
+
+	// generate perf account
+	portOwner = zone.ChainId + ".performance"
+	if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
+		return err
+	}
+
+	// generate delegate accounts
+	delegateAccountCount := int(k.GetZoneParam(ctx, zone, types.KeyDelegateAccountCount))
+	for i := 0; i < delegateAccountCount; i++ {
+		portOwner := fmt.Sprintf("%s.delegate.%d", zone.ChainId, i)
+		if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
+			return err
+		}
+	}
+
+	if err := k.EmitValsetRequery(ctx, zone.ConnectionId, zone.ChainId); err != nil {
+		return err
+	}
+	k.EmitGovProposalsQuery(ctx, *zone)
+	if err := k.EmitDepositBalanceQuery(ctx, zone); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("zone activated", "chain_id", zone.ChainId)
+	return ctx.EventManager().EmitTypedEvent(&types.EventZoneActivated{
+		ChainId:      zone.ChainId,
+		ConnectionId: zone.ConnectionId,
+	})
+}
+
+// EmitDepositBalanceQuery emits the periodic query for the balances of the zone's deposit account.
+func (k Keeper) EmitDepositBalanceQuery(ctx sdk.Context, zone *types.Zone) error {
+	balanceQuery := banktypes.QueryAllBalancesRequest{Address: zone.DepositAddress.Address}
+	bz, err := k.GetCodec().Marshal(&balanceQuery)
+	if err != nil {
+		return err
+	}
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.bank.v1beta1.Query/AllBalances",
+		bz,
+		sdk.NewInt(int64(k.GetZoneParam(ctx, zone, types.KeyDepositInterval))),
+		types.ModuleName,
+		"allbalances",
+		0,
+		0,
+		sdk.Coin{},
+	)
+	return nil
+}
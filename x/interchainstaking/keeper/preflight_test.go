@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestZoneRegistrationPreflight(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	require.NoError(t, host.Connect(rand.New(rand.NewSource(1)), ctx))
+
+	// 1. a zone whose base denom differs from the host bond denom is removed.
+	proposal := types.NewRegisterZoneProposal("mock host", "mock host", host.ConnectionID, "uosmo", simulation.MockHostLocalDenom, simulation.MockHostAccountPrefix, false, false)
+	require.NoError(t, icskeeper.HandleRegisterZoneProposal(ctx, kpr, proposal))
+	zone, found := kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.True(t, found)
+	require.False(t, zone.IsActive())
+
+	require.NoError(t, host.CompleteHandshakes(ctx))
+	zone, found = kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.True(t, found)
+	require.True(t, zone.Preflight.AccountPrefix)
+	require.False(t, zone.IsActive())
+
+	require.NoError(t, host.AnswerPreflightQueries(ctx))
+	_, found = kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.False(t, found)
+	require.Empty(t, kpr.ICQKeeper.AllQueries(ctx))
+	failed := typedEvents(t, ctx, &types.EventZoneRegistrationFailed{})
+	require.Len(t, failed, 1)
+	require.Equal(t, types.PreflightCheckBondDenom, failed[0].(*types.EventZoneRegistrationFailed).Check)
+
+	// 2. a corrected registration reuses the deposit account, and is activated once the checks pass.
+	proposal = types.NewRegisterZoneProposal("mock host", "mock host", host.ConnectionID, simulation.MockHostBaseDenom, simulation.MockHostLocalDenom, simulation.MockHostAccountPrefix, false, false)
+	require.NoError(t, icskeeper.HandleRegisterZoneProposal(ctx, kpr, proposal))
+	zone, found = kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.True(t, found)
+	require.NotNil(t, zone.DepositAddress)
+	require.True(t, zone.Preflight.AccountPrefix)
+	require.Nil(t, zone.WithdrawalAddress)
+
+	require.NoError(t, host.AnswerPreflightQueries(ctx))
+	require.NoError(t, host.CompleteHandshakes(ctx))
+	zone, found = kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.True(t, found)
+	require.True(t, zone.IsActive())
+	require.NotNil(t, zone.WithdrawalAddress)
+	require.NotNil(t, zone.PerformanceAddress)
+	require.Len(t, zone.DelegationAddresses, int(kpr.GetParam(ctx, types.KeyDelegateAccountCount)))
+	require.Len(t, typedEvents(t, ctx, &types.EventZoneActivated{}), 1)
+
+	// 3. an active zone cannot be registered again.
+	require.Error(t, icskeeper.HandleRegisterZoneProposal(ctx, kpr, proposal))
+}
+
+func TestZoneRegistrationPreflightAccountPrefix(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	require.NoError(t, host.Connect(rand.New(rand.NewSource(1)), ctx))
+
+	proposal := types.NewRegisterZoneProposal("mock host", "mock host", host.ConnectionID, simulation.MockHostBaseDenom, simulation.MockHostLocalDenom, "osmo", false, false)
+	require.NoError(t, icskeeper.HandleRegisterZoneProposal(ctx, kpr, proposal))
+	require.NoError(t, host.CompleteHandshakes(ctx))
+
+	_, found := kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.False(t, found)
+	require.Empty(t, kpr.ICQKeeper.AllQueries(ctx))
+	failed := typedEvents(t, ctx, &types.EventZoneRegistrationFailed{})
+	require.Len(t, failed, 1)
+	require.Equal(t, types.PreflightCheckAccountPrefix, failed[0].(*types.EventZoneRegistrationFailed).Check)
+}
+
+func TestZoneRegistrationPreflightTimeout(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	require.NoError(t, host.Connect(rand.New(rand.NewSource(1)), ctx))
+
+	proposal := types.NewRegisterZoneProposal("mock host", "mock host", host.ConnectionID, simulation.MockHostBaseDenom, simulation.MockHostLocalDenom, simulation.MockHostAccountPrefix, false, false)
+	require.NoError(t, icskeeper.HandleRegisterZoneProposal(ctx, kpr, proposal))
+	require.NoError(t, host.CompleteHandshakes(ctx))
+
+	// the preflight queries are given a deadline once emitted.
+	app.InterchainQueryKeeper.EndBlocker(ctx)
+	queries := kpr.ICQKeeper.AllQueries(ctx)
+	require.Len(t, queries, 2)
+	for _, query := range queries {
+		require.Positive(t, query.Deadline)
+	}
+
+	// the zone is removed once the unanswered preflight queries time out.
+	for height := ctx.BlockHeight() + 1; height < ctx.BlockHeight()+1000; height++ {
+		app.InterchainQueryKeeper.EndBlocker(ctx.WithBlockHeight(height))
+		if _, found := kpr.GetZone(ctx, simulation.MockHostChainID); !found {
+			break
+		}
+	}
+	_, found := kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.False(t, found)
+	require.Empty(t, kpr.ICQKeeper.AllQueries(ctx))
+	require.Len(t, typedEvents(t, ctx, &types.EventZoneRegistrationFailed{}), 1)
+}
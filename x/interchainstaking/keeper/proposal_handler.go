@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -18,9 +19,13 @@ func HandleRegisterZoneProposal(ctx sdk.Context, k Keeper, p *types.RegisterZone
 	}
 
 	// get zone
-	_, found := k.GetZone(ctx, chainID)
+	existing, found := k.GetZone(ctx, chainID)
 	if found {
-		return fmt.Errorf("invalid chain id, zone for \"%s\" already registered", chainID)
+		if existing.IsActive() {
+			return fmt.Errorf("invalid chain id, zone for \"%s\" already registered", chainID)
+		}
+		// a registration still pending its preflight checks is superseded.
+		k.removePendingZone(ctx, &existing)
 	}
 
 	zone := types.Zone{
@@ -33,37 +38,25 @@ func HandleRegisterZoneProposal(ctx sdk.Context, k Keeper, p *types.RegisterZone
 		LastRedemptionRate: sdk.NewDec(1),
 		MultiSend:          p.MultiSend,
 		LiquidityModule:    p.LiquidityModule,
+		Params:             p.Params,
+		// the liquidity buffer is disabled until enabled by governance.
+		LiquidityBufferRatio: sdk.ZeroDec(),
+		LiquidityBuffer:      sdk.ZeroInt(),
+		// the zone is activated, and its remaining accounts registered, once the preflight checks pass.
+		Preflight: &types.ZonePreflight{},
 	}
 	k.SetZone(ctx, &zone)
 
-	// generate deposit account
-	portOwner := chainID + ".deposit"
-	if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
-		return err
-	}
-
-	// generate withdrawal account
-	portOwner = chainID + ".withdrawal"
-	if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
-		return err
-	}
+	k.EmitPreflightQueries(ctx, &zone)
 
-	// generate perf account
-	portOwner = chainID + ".performance"
-	if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
-		return err
-	}
-
-	// generate delegate accounts
-	delegateAccountCount := int(k.GetParam(ctx, types.KeyDelegateAccountCount))
-	for i := 0; i < delegateAccountCount; i++ {
-		portOwner := fmt.Sprintf("%s.delegate.%d", chainID, i)
-		if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
+	// generate deposit account; the deposit account of an earlier, failed registration is reused.
+	portOwner := chainID + ".deposit"
+	portID, _ := icatypes.NewControllerPortID(portOwner)
+	if _, found := k.ICAControllerKeeper.GetOpenActiveChannel(ctx, zone.ConnectionId, portID); found {
+		if err := k.HandleChannelOpenAck(ctx, portID, zone.ConnectionId); err != nil {
 			return err
 		}
-	}
-	err = k.EmitValsetRequery(ctx, p.ConnectionId, chainID)
-	if err != nil {
+	} else if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
 		return err
 	}
 
@@ -101,12 +94,61 @@ func HandleUpdateZoneProposal(ctx sdk.Context, k Keeper, p *types.UpdateZoneProp
 	}
 
 	for _, change := range p.Changes {
-		if change.Key == "base_denom" {
+		switch change.Key {
+		case "base_denom":
 			if err := sdk.ValidateDenom(change.Value); err != nil {
 				return err
 			}
 			zone.BaseDenom = change.Value
 			k.SetZone(ctx, &zone)
+		case "max_tx_msgs":
+			value, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value for max_tx_msgs: %w", err)
+			}
+			zone.MaxTxMsgs = value
+			k.SetZone(ctx, &zone)
+		case "max_tx_gas":
+			value, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value for max_tx_gas: %w", err)
+			}
+			zone.MaxTxGas = value
+			k.SetZone(ctx, &zone)
+		case "deposit_interval":
+			value, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value for deposit_interval: %w", err)
+			}
+			if err := k.SetZoneParam(ctx, &zone, types.KeyDepositInterval, value); err != nil {
+				return err
+			}
+		case "validator_set_interval":
+			value, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value for validator_set_interval: %w", err)
+			}
+			if err := k.SetZoneParam(ctx, &zone, types.KeyValidatorSetInterval, value); err != nil {
+				return err
+			}
+		case "delegate_account_count":
+			value, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value for delegate_account_count: %w", err)
+			}
+			if err := k.SetZoneParam(ctx, &zone, types.KeyDelegateAccountCount, value); err != nil {
+				return err
+			}
+		case "liquidity_buffer_ratio":
+			value, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return fmt.Errorf("invalid value for liquidity_buffer_ratio: %w", err)
+			}
+			if value.IsNegative() || value.GT(sdk.OneDec()) {
+				return fmt.Errorf("invalid value for liquidity_buffer_ratio, must be between 0 and 1: %s", value)
+			}
+			zone.LiquidityBufferRatio = value
+			k.SetZone(ctx, &zone)
 		}
 	}
 
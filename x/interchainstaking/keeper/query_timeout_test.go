@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	icqkeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestRewardsQueryTimeout(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	zone, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+
+	// a rewards query issued at the epoch boundary holds the withdrawal waitgroup.
+	rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: zone.DelegationAddresses[0].Address}
+	bz := app.AppCodec().MustMarshal(&rewardsQuery)
+	queryType := "cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
+	kpr.ICQKeeper.MakeRequest(ctx, zone.ConnectionId, zone.ChainId, queryType, bz, sdk.NewInt(-1), types.ModuleName, "rewards", 0, 0, sdk.Coin{})
+	zone.WithdrawalWaitgroup = 1
+	kpr.SetZone(ctx, &zone)
+
+	id := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, queryType, bz, types.ModuleName)
+
+	// the query goes unanswered through each of its retries.
+	ctx = ctx.WithBlockHeight(1)
+	kpr.ICQKeeper.EndBlocker(ctx)
+	for retry := 0; retry <= icqkeeper.MaxRetries; retry++ {
+		query, found := kpr.ICQKeeper.GetQuery(ctx, id)
+		require.True(t, found)
+		require.Equal(t, uint32(retry), query.Retries)
+		ctx = ctx.WithBlockHeight(query.Deadline + 1)
+		kpr.ICQKeeper.EndBlocker(ctx)
+	}
+
+	// once timed out, the query is re-issued and the waitgroup still accounts for it.
+	query, found := kpr.ICQKeeper.GetQuery(ctx, id)
+	require.True(t, found)
+	require.Zero(t, query.Retries)
+	require.Zero(t, query.Deadline)
+
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Equal(t, uint32(1), zone.WithdrawalWaitgroup)
+
+	// the re-issued query is emitted at the next block.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	kpr.ICQKeeper.EndBlocker(ctx)
+	query, _ = kpr.ICQKeeper.GetQuery(ctx, id)
+	require.Equal(t, ctx.BlockHeight(), query.LastEmission.Int64())
+	require.Equal(t, ctx.BlockHeight()+icqkeeper.RetryInterval, query.Deadline)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
@@ -56,6 +57,20 @@ func (k Keeper) HandleReceiptTransaction(ctx sdk.Context, txr *sdk.TxResponse, t
 		return
 	}
 
+	// rewards sent to top up the liquidity buffer are not deposits.
+	if zone.WithdrawalAddress != nil && senderAddress == zone.WithdrawalAddress.Address {
+		k.Logger(ctx).Info("liquidity buffer top-up from withdrawal address. Ignoring.", "hash", hash)
+		return
+	}
+
+	// instant redemptions refunded by the host transfer module, after their transfer timed out, are not deposits.
+	if port, channel, err := k.GetRemoteTransferChannel(ctx, zone.ConnectionId); err == nil {
+		if escrow, err := bech32.ConvertAndEncode(zone.AccountPrefix, ibctransfertypes.GetEscrowAddress(port, channel)); err == nil && senderAddress == escrow {
+			k.Logger(ctx).Info("refund of timed out transfer. Ignoring.", "hash", hash)
+			return
+		}
+	}
+
 	// sdk.AccAddressFromBech32 doesn't work here as it expects the local HRP
 	_, addressBytes, err := bech32.DecodeAndConvert(senderAddress)
 	if err != nil {
@@ -72,6 +87,15 @@ func (k Keeper) HandleReceiptTransaction(ctx sdk.Context, txr *sdk.TxResponse, t
 	var accAddress sdk.AccAddress = addressBytes
 
 	k.Logger(ctx).Info("Found new deposit tx", "deposit_address", zone.DepositAddress.GetAddress(), "sender", senderAddress, "local", accAddress.String(), "chain id", zone.ChainId, "amount", coins, "hash", hash)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositReceived{
+		ChainId:   zone.ChainId,
+		Txhash:    hash,
+		Sender:    senderAddress,
+		Recipient: accAddress.String(),
+		Amount:    coins,
+	}); err != nil {
+		k.Logger(ctx).Error("unable to emit deposit event", "err", err)
+	}
 	// create receipt
 
 	if err := k.UpdateIntent(ctx, accAddress, zone, coins, memo); err != nil {
@@ -83,15 +107,25 @@ func (k Keeper) HandleReceiptTransaction(ctx sdk.Context, txr *sdk.TxResponse, t
 		return
 	}
 
-	sendPlan, err := k.DeterminePlanForDelegation(ctx, zone, coins, accAddress.String(), hash)
+	// retain part of the deposit in the deposit account to top up the liquidity buffer; delegate the remainder.
+	retained, err := k.RetainForLiquidityBuffer(ctx, zone.ChainId, coins.AmountOf(zone.BaseDenom))
 	if err != nil {
-		k.Logger(ctx).Error("unable to determine delegation plan. Ignoring.", "sender", senderAddress, "zone", zone.ChainId, "err", err)
+		k.Logger(ctx).Error("unable to top up liquidity buffer. Ignoring.", "sender", senderAddress, "zone", zone.ChainId, "err", err)
 		return
 	}
+	toDelegate := coins.Sub(sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, retained)))
 
-	if err := k.TransferToDelegate(ctx, zone, sendPlan, hash); err != nil {
-		k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "sender", senderAddress, "zone", zone.ChainId, "err", err)
-		return
+	if !toDelegate.IsZero() {
+		sendPlan, err := k.DeterminePlanForDelegation(ctx, zone, toDelegate, accAddress.String(), hash)
+		if err != nil {
+			k.Logger(ctx).Error("unable to determine delegation plan. Ignoring.", "sender", senderAddress, "zone", zone.ChainId, "err", err)
+			return
+		}
+
+		if err := k.TransferToDelegate(ctx, zone, sendPlan, hash); err != nil {
+			k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "sender", senderAddress, "zone", zone.ChainId, "err", err)
+			return
+		}
 	}
 	receipt := k.NewReceipt(ctx, zone, senderAddress, hash, coins)
 
@@ -129,7 +163,13 @@ func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, zone types.Z
 	}
 	k.Logger(ctx).Info("Transferred qAssets to sender", "assets", outCoins, "sender", sender)
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventQAssetMinted{
+		ChainId:        zone.ChainId,
+		Recipient:      sender.String(),
+		Deposit:        inCoins,
+		Minted:         outCoins,
+		RedemptionRate: zone.RedemptionRate,
+	})
 }
 
 func (k *Keeper) TransferToDelegate(ctx sdk.Context, zone types.Zone, plan types.Allocations, memo string) error {
@@ -150,7 +190,7 @@ func (k *Keeper) TransferToDelegate(ctx sdk.Context, zone types.Zone, plan types
 
 // func (k *Keeper) TransferToDelegateMulti(ctx sdk.Context, zone types.Zone, plan types.SendPlan, memo string) error {
 // 	eachAmount := sdk.Coins{}
-// 	splits := utils.MinU64(append([]uint64{}, k.GetParam(ctx, types.KeyDelegateAccountCount), uint64(len(zone.GetDelegationAccounts()))))
+// 	splits := utils.MinU64(append([]uint64{}, k.GetZoneParam(ctx, &zone, types.KeyDelegateAccountCount), uint64(len(zone.GetDelegationAccounts()))))
 
 // 	for _, asset := range inAmount {
 // 		thisAsset := sdk.Coin{Denom: asset.Denom, Amount: asset.Amount.Quo(sdk.NewIntFromUint64(splits))}
@@ -185,6 +225,9 @@ func (k *Keeper) TransferToDelegate(ctx sdk.Context, zone types.Zone, plan types
 // 	return k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, memo)
 // }
 
+// SubmitTx submits msgs to the host via the given interchain account. Msgs are split into as many
+// packets as required to respect the zone's per-transaction message and gas limits; if a memo is
+// provided, the packets are tracked as a single pending operation until all are acknowledged.
 func (k *Keeper) SubmitTx(ctx sdk.Context, msgs []sdk.Msg, account *types.ICAAccount, memo string) error {
 	portID := account.GetPortName()
 	connectionID, err := k.GetConnectionForPort(ctx, portID)
@@ -201,24 +244,39 @@ func (k *Keeper) SubmitTx(ctx sdk.Context, msgs []sdk.Msg, account *types.ICAAcc
 		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	data, err := icatypes.SerializeCosmosTx(k.cdc, msgs)
+	chainID, err := k.GetChainID(ctx, connectionID)
 	if err != nil {
 		return err
 	}
 
-	// validate memo < 256 bytes
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: data,
-		Memo: memo,
+	// use default limits if the zone is not (yet) registered.
+	zone, _ := k.GetZone(ctx, chainID)
+	chunks := types.ChunkMsgs(msgs, zone.TxMsgLimit(), zone.TxGasLimit())
+
+	for _, chunk := range chunks {
+		data, err := icatypes.SerializeCosmosTx(k.cdc, chunk)
+		if err != nil {
+			return err
+		}
+
+		// validate memo < 256 bytes
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: memo,
+		}
+
+		// timeoutTimestamp set to max value with the unsigned bit shifted to satisfy hermes timestamp conversion
+		// it is the responsibility of the auth module developer to ensure an appropriate timeout timestamp
+		timeoutTimestamp := ^uint64(0) >> 1
+		_, err = k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, timeoutTimestamp)
+		if err != nil {
+			return err
+		}
 	}
 
-	// timeoutTimestamp set to max value with the unsigned bit shifted to satisfy hermes timestamp conversion
-	// it is the responsibility of the auth module developer to ensure an appropriate timeout timestamp
-	timeoutTimestamp := ^uint64(0) >> 1
-	_, err = k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, timeoutTimestamp)
-	if err != nil {
-		return err
+	if memo != "" && len(chunks) > 0 {
+		k.AddPendingOperationChunks(ctx, chainID, memo, uint32(len(chunks)))
 	}
 
 	return nil
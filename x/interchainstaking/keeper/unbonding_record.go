@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// MaxUnbondingEntries is the maximum number of unbonding entries the host chain permits for a single
+// delegator/validator pair; an undelegation beyond this causes the whole interchain account tx to fail.
+const MaxUnbondingEntries uint32 = stakingtypes.DefaultMaxEntries
+
+// GetUnbondingRecord returns the unbonding record of the given delegator/validator pair.
+func (k Keeper) GetUnbondingRecord(ctx sdk.Context, zone *types.Zone, delegator string, validator string) (types.UnbondingRecord, bool) {
+	record := types.UnbondingRecord{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbondingRecordKey(zone.ChainId, delegator, validator))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetUnbondingRecord stores the unbonding record.
+func (k Keeper) SetUnbondingRecord(ctx sdk.Context, record *types.UnbondingRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(record)
+	store.Set(types.GetUnbondingRecordKey(record.ChainId, record.Delegator, record.Validator), bz)
+}
+
+// DeleteUnbondingRecord deletes the unbonding record of the given delegator/validator pair.
+func (k Keeper) DeleteUnbondingRecord(ctx sdk.Context, zone *types.Zone, delegator string, validator string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingRecordKey(zone.ChainId, delegator, validator))
+}
+
+// IterateZoneUnbondingRecords iterates through the unbonding records of the given zone.
+func (k Keeper) IterateZoneUnbondingRecords(ctx sdk.Context, zone *types.Zone, fn func(record types.UnbondingRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetZoneUnbondingRecordsKey(zone.ChainId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.UnbondingRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// OpenUnbondingEntries returns the number of unbonding entries of the delegator/validator pair that are open
+// on the host chain, or will be opened by undelegations not yet acknowledged.
+//
+// The host's entries are known as of the last unbonding delegation query; acknowledged withdrawal records
+// may or may not be reflected there, so the greater of the two counts is taken.
+func (k Keeper) OpenUnbondingEntries(ctx sdk.Context, zone *types.Zone, delegator string, validator string) uint32 {
+	var observed, acknowledged, pending uint32
+
+	if record, found := k.GetUnbondingRecord(ctx, zone, delegator, validator); found {
+		for _, completion := range record.CompletionTimes {
+			if completion.After(ctx.BlockTime()) {
+				observed++
+			}
+		}
+	}
+
+	k.IteratePrefixedWithdrawalRecords(ctx, types.GetDelegatorWithdrawalRecordsKey(zone.ChainId, delegator), func(_ int64, withdrawal types.WithdrawalRecord) bool {
+		if withdrawal.Validator != validator || withdrawal.Status == WithdrawStatusSend {
+			return false
+		}
+		switch {
+		case withdrawal.CompletionTime.After(ctx.BlockTime()):
+			acknowledged++
+		case !withdrawal.CompletionTime.After(time.Unix(0, 0)):
+			// undelegation not yet acknowledged by the host.
+			pending++
+		}
+		return false
+	})
+
+	if acknowledged > observed {
+		observed = acknowledged
+	}
+	return observed + pending
+}
+
+// RemainingUnbondingEntries returns the number of further unbonding entries the host chain will accept for the
+// delegator/validator pair.
+func (k Keeper) RemainingUnbondingEntries(ctx sdk.Context, zone *types.Zone, delegator string, validator string) uint32 {
+	open := k.OpenUnbondingEntries(ctx, zone, delegator, validator)
+	if open >= MaxUnbondingEntries {
+		return 0
+	}
+	return MaxUnbondingEntries - open
+}
+
+// GetUnbondingCapacity returns the open and remaining unbonding entries of each delegator/validator pair of the
+// zone that has a delegation or an unbonding record.
+func (k Keeper) GetUnbondingCapacity(ctx sdk.Context, zone *types.Zone) []types.UnbondingCapacity {
+	pairs := map[string]types.UnbondingCapacity{}
+	for _, delegation := range k.GetAllDelegations(ctx, zone) {
+		pairs[delegation.DelegationAddress+delegation.ValidatorAddress] = types.UnbondingCapacity{Delegator: delegation.DelegationAddress, Validator: delegation.ValidatorAddress}
+	}
+	k.IterateZoneUnbondingRecords(ctx, zone, func(record types.UnbondingRecord) bool {
+		pairs[record.Delegator+record.Validator] = types.UnbondingCapacity{Delegator: record.Delegator, Validator: record.Validator}
+		return false
+	})
+
+	out := make([]types.UnbondingCapacity, 0, len(pairs))
+	for _, pair := range pairs {
+		pair.OpenEntries = k.OpenUnbondingEntries(ctx, zone, pair.Delegator, pair.Validator)
+		pair.RemainingEntries = k.RemainingUnbondingEntries(ctx, zone, pair.Delegator, pair.Validator)
+		out = append(out, pair)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Delegator != out[j].Delegator {
+			return out[i].Delegator < out[j].Delegator
+		}
+		return out[i].Validator < out[j].Validator
+	})
+	return out
+}
+
+// EmitUnbondingDelegationQuery queries the host chain for the unbonding delegation of the given
+// delegator/validator pair.
+func (k Keeper) EmitUnbondingDelegationQuery(ctx sdk.Context, zone *types.Zone, delegator string, validator string) error {
+	_, delAddr, err := bech32.DecodeAndConvert(delegator)
+	if err != nil {
+		return err
+	}
+	_, valAddr, err := bech32.DecodeAndConvert(validator)
+	if err != nil {
+		return err
+	}
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"store/staking/key",
+		stakingtypes.GetUBDKey(delAddr, valAddr),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"unbondingdelegation",
+		0,
+		0,
+		k.queryBounty(ctx),
+	)
+	return nil
+}
+
+// attributeUnbondingSlashes records against each unbonding withdrawal record of the delegator/validator pair the part
+// of its amount slashed while unbonding, per the proven entries of the pair completing at the same time. Records whose
+// entries have already completed keep the amount last attributed.
+func (k Keeper) attributeUnbondingSlashes(ctx sdk.Context, zone *types.Zone, delegator string, validator string, entries []stakingtypes.UnbondingDelegationEntry) {
+	initial := map[time.Time]sdk.Int{}
+	remaining := map[time.Time]sdk.Int{}
+	for _, entry := range entries {
+		completion := entry.CompletionTime.UTC()
+		if _, found := initial[completion]; !found {
+			initial[completion] = sdk.ZeroInt()
+			remaining[completion] = sdk.ZeroInt()
+		}
+		initial[completion] = initial[completion].Add(entry.InitialBalance)
+		remaining[completion] = remaining[completion].Add(entry.Balance)
+	}
+
+	k.IteratePrefixedWithdrawalRecords(ctx, types.GetDelegatorWithdrawalRecordsKey(zone.ChainId, delegator), func(_ int64, withdrawal types.WithdrawalRecord) bool {
+		if withdrawal.Validator != validator || (withdrawal.Status != WithdrawStatusUnbond && withdrawal.Status != WithdrawStatusVerify) {
+			return false
+		}
+		completion := withdrawal.CompletionTime.UTC()
+		total, found := initial[completion]
+		if !found || !total.IsPositive() {
+			return false
+		}
+		payout := withdrawal.Amount.Amount.Mul(remaining[completion]).Quo(total)
+		if payout.GT(withdrawal.Amount.Amount) {
+			payout = withdrawal.Amount.Amount
+		}
+		slashed := sdk.NewCoin(withdrawal.Amount.Denom, withdrawal.Amount.Amount.Sub(payout))
+		if !slashed.IsEqual(withdrawal.SlashedAmount) {
+			k.Logger(ctx).Info("unbonding entry slashed", "delegator", delegator, "validator", validator, "txhash", withdrawal.Txhash, "slashed", slashed)
+			withdrawal.SlashedAmount = slashed
+			k.SetWithdrawalRecord(ctx, &withdrawal)
+		}
+		return false
+	})
+}
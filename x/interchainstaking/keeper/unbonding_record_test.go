@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// saturatedRecord returns an unbonding record for the pair with the maximum number of open entries.
+func saturatedRecord(zone types.Zone, delegator string, validator string, now time.Time) *types.UnbondingRecord {
+	record := &types.UnbondingRecord{ChainId: zone.ChainId, Delegator: delegator, Validator: validator}
+	for i := uint32(0); i < icskeeper.MaxUnbondingEntries; i++ {
+		record.CompletionTimes = append(record.CompletionTimes, now.Add(time.Duration(i+1)*time.Hour))
+	}
+	return record
+}
+
+func TestUnbondingEntries(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight(), Time: now})
+
+	encode := func(prefix string, addr []byte) string {
+		out, err := bech32.ConvertAndEncode(prefix, addr)
+		require.NoError(t, err)
+		return out
+	}
+	delAddr, valAddr := utils.GenerateAccAddressForTest(), utils.GenerateValAddressForTest()
+	delegator, validator := encode("cosmos", delAddr), encode("cosmosvaloper", valAddr)
+
+	zone := types.Zone{ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	kpr.SetZone(ctx, &zone)
+
+	require.Equal(t, uint32(0), kpr.OpenUnbondingEntries(ctx, &zone, delegator, validator))
+	require.Equal(t, icskeeper.MaxUnbondingEntries, kpr.RemainingUnbondingEntries(ctx, &zone, delegator, validator))
+
+	// 1. the host reports two open entries, and one that has since matured.
+	ubd := stakingtypes.UnbondingDelegation{DelegatorAddress: delegator, ValidatorAddress: validator}
+	for _, completion := range []time.Time{now.Add(-time.Hour), now.Add(time.Hour), now.Add(2 * time.Hour)} {
+		ubd.Entries = append(ubd.Entries, stakingtypes.UnbondingDelegationEntry{CompletionTime: completion, InitialBalance: sdk.OneInt(), Balance: sdk.OneInt()})
+	}
+	query := icqtypes.Query{ChainId: zone.ChainId, Request: stakingtypes.GetUBDKey(delAddr, valAddr)}
+	require.NoError(t, icskeeper.UnbondingDelegationCallback(kpr, ctx, kpr.GetCodec().MustMarshal(&ubd), query))
+	record, found := kpr.GetUnbondingRecord(ctx, &zone, delegator, validator)
+	require.True(t, found)
+	require.Len(t, record.CompletionTimes, 3)
+	require.Equal(t, uint32(2), kpr.OpenUnbondingEntries(ctx, &zone, delegator, validator))
+
+	// 2. acknowledged withdrawal records already reflected by the host are not double counted.
+	amount, burn := sdk.NewCoin("uatom", sdk.NewInt(10)), sdk.NewCoin("uqatom", sdk.NewInt(10))
+	kpr.AddWithdrawalRecord(ctx, &zone, delegator, validator, delegator, amount, burn, "hash1", now.Add(time.Hour))
+	require.Equal(t, uint32(2), kpr.OpenUnbondingEntries(ctx, &zone, delegator, validator))
+
+	// 3. undelegations not yet acknowledged are counted in addition to the host's entries.
+	kpr.AddWithdrawalRecord(ctx, &zone, delegator, validator, delegator, amount, burn, "hash2", time.Unix(0, 0))
+	require.Equal(t, uint32(3), kpr.OpenUnbondingEntries(ctx, &zone, delegator, validator))
+	require.Equal(t, icskeeper.MaxUnbondingEntries-3, kpr.RemainingUnbondingEntries(ctx, &zone, delegator, validator))
+
+	capacity := kpr.GetUnbondingCapacity(ctx, &zone)
+	require.Equal(t, []types.UnbondingCapacity{{Delegator: delegator, Validator: validator, OpenEntries: 3, RemainingEntries: icskeeper.MaxUnbondingEntries - 3}}, capacity)
+
+	// 4. a saturated pair has no remaining entries.
+	kpr.SetUnbondingRecord(ctx, saturatedRecord(zone, delegator, validator, now))
+	require.Equal(t, uint32(0), kpr.RemainingUnbondingEntries(ctx, &zone, delegator, validator))
+
+	// 5. an empty response, once all entries have completed, removes the record.
+	require.NoError(t, icskeeper.UnbondingDelegationCallback(kpr, ctx, []byte{}, query))
+	_, found = kpr.GetUnbondingRecord(ctx, &zone, delegator, validator)
+	require.False(t, found)
+}
+
+func TestGetRedemptionTargetsAvoidsSaturatedPairs(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight(), Time: now})
+
+	encode := func(prefix string, addr []byte) string {
+		out, err := bech32.ConvertAndEncode(prefix, addr)
+		require.NoError(t, err)
+		return out
+	}
+	delegator0, delegator1 := encode("cosmos", utils.GenerateAccAddressForTest()), encode("cosmos", utils.GenerateAccAddressForTest())
+	validator0, validator1 := encode("cosmosvaloper", utils.GenerateValAddressForTest()), encode("cosmosvaloper", utils.GenerateValAddressForTest())
+
+	zone := types.Zone{
+		ChainId:       "cosmoshub-4",
+		AccountPrefix: "cosmos",
+		LocalDenom:    "uqatom",
+		BaseDenom:     "uatom",
+		Validators: []*types.Validator{
+			{ValoperAddress: validator0, CommissionRate: sdk.ZeroDec(), DelegatorShares: sdk.ZeroDec(), VotingPower: sdk.ZeroInt()},
+			{ValoperAddress: validator1, CommissionRate: sdk.ZeroDec(), DelegatorShares: sdk.ZeroDec(), VotingPower: sdk.ZeroInt()},
+		},
+		DelegationAddresses: []*types.ICAAccount{{Address: delegator0}, {Address: delegator1}},
+	}
+	kpr.SetZone(ctx, &zone)
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator0, validator0, sdk.NewCoin("uatom", sdk.NewInt(300))))
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator0, validator1, sdk.NewCoin("uatom", sdk.NewInt(100))))
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator1, validator1, sdk.NewCoin("uatom", sdk.NewInt(200))))
+
+	request := func() types.Allocations {
+		return types.Allocations{}.Allocate(validator1, sdk.NewCoins(sdk.NewCoin(types.GenericToken, sdk.NewInt(50))))
+	}
+
+	// 1. the smallest delegation to the validator is targeted.
+	targets, err := kpr.GetRedemptionTargets(ctx, zone, request())
+	require.NoError(t, err)
+	require.Equal(t, icskeeper.RedemptionTargets{{DelegatorAddress: delegator0, ValidatorAddress: validator1, Value: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50)))}}, targets)
+
+	// 2. a saturated pair is skipped in favour of another delegation to the validator.
+	kpr.SetUnbondingRecord(ctx, saturatedRecord(zone, delegator0, validator1, now))
+	targets, err = kpr.GetRedemptionTargets(ctx, zone, request())
+	require.NoError(t, err)
+	require.Equal(t, icskeeper.RedemptionTargets{{DelegatorAddress: delegator1, ValidatorAddress: validator1, Value: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50)))}}, targets)
+
+	// 3. with every pair for the validator saturated, the largest delegation elsewhere is used.
+	kpr.SetUnbondingRecord(ctx, saturatedRecord(zone, delegator1, validator1, now))
+	targets, err = kpr.GetRedemptionTargets(ctx, zone, request())
+	require.NoError(t, err)
+	require.Equal(t, icskeeper.RedemptionTargets{{DelegatorAddress: delegator0, ValidatorAddress: validator0, Value: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50)))}}, targets)
+
+	// 4. with every pair saturated, the redemption cannot be met.
+	kpr.SetUnbondingRecord(ctx, saturatedRecord(zone, delegator0, validator0, now))
+	_, err = kpr.GetRedemptionTargets(ctx, zone, request())
+	require.ErrorContains(t, err, "redemption with remaining amount: 50")
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// SetValidatorSetForZone updates the zone from its proven, complete host validator set: the key/value pairs of a
+// subspace query of the staking store validators. As the set is proven complete, validators known to the zone but
+// absent from it are handled as proven non-members.
+func SetValidatorSetForZone(k Keeper, ctx sdk.Context, zone types.Zone, data []byte) error {
+	var pairs kv.Pairs
+	if err := pairs.Unmarshal(data); err != nil {
+		k.Logger(ctx).Error("unable to unmarshal validator set for zone", "zone", zone.ChainId, "err", err)
+		return err
+	}
+
+	present := map[string]bool{}
+	for _, pair := range pairs.Pairs {
+		validator := stakingtypes.Validator{}
+		if err := k.cdc.Unmarshal(pair.Value, &validator); err != nil {
+			return err
+		}
+		present[validator.OperatorAddress] = true
+
+		// each update is stored, so the zone is re-read for the next.
+		zone, _ = k.GetZone(ctx, zone.ChainId)
+		if err := SetValidatorForZone(k, ctx, zone, pair.Value); err != nil {
+			return err
+		}
+	}
+
+	zone, _ = k.GetZone(ctx, zone.ChainId)
+	for _, missing := range k.MissingValidators(zone, present) {
+		_, addr, err := bech32.DecodeAndConvert(missing)
+		if err != nil {
+			return err
+		}
+		zone, _ = k.GetZone(ctx, zone.ChainId)
+		if err := k.HandleValidatorNonMembership(ctx, zone, stakingtypes.GetValidatorKey(addr)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MissingValidators returns, in sorted order, the validators of the zone not found in present.
+func (k Keeper) MissingValidators(zone types.Zone, present map[string]bool) []string {
+	missing := []string{}
+	for _, validator := range zone.GetValidatorsSorted() {
+		if !present[validator.ValoperAddress] {
+			missing = append(missing, validator.ValoperAddress)
+		}
+	}
+	return missing
+}
+
+// HandleValidatorNonMembership removes the validator identified by the given staking store key from the zone,
+// following a verified proof that it no longer exists on the host. Validators that still hold protocol
+// delegations are retained, so that those delegations continue to be accounted for.
+func (k Keeper) HandleValidatorNonMembership(ctx sdk.Context, zone types.Zone, key []byte) error {
+	if len(key) <= len(stakingtypes.ValidatorsKey) {
+		return fmt.Errorf("invalid validator key: %X", key)
+	}
+	valAddr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(key))
+	valoper, err := bech32.ConvertAndEncode(zone.AccountPrefix+"valoper", valAddr)
+	if err != nil {
+		return err
+	}
+
+	if _, found := zone.GetValidatorByValoper(valoper); !found {
+		return nil
+	}
+
+	if delegations := k.GetValidatorDelegations(ctx, &zone, valAddr); len(delegations) > 0 {
+		k.Logger(ctx).Info("validator removed from host but holds protocol delegations; retaining", "zone", zone.ChainId, "valoper", valoper, "delegations", len(delegations))
+		return nil
+	}
+
+	validators := make([]*types.Validator, 0, len(zone.Validators))
+	for _, validator := range zone.Validators {
+		if validator.ValoperAddress != valoper {
+			validators = append(validators, validator)
+		}
+	}
+	zone.Validators = validators
+	k.SetZone(ctx, &zone)
+
+	k.Logger(ctx).Info("validator removed from host; pruned", "zone", zone.ChainId, "valoper", valoper)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorRemoved,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, valoper),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestPruneRemovedValidators(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	valAddrs := []sdk.ValAddress{}
+	valopers := []string{}
+	for i := 0; i < 4; i++ {
+		valAddr := utils.GenerateValAddressForTest()
+		valoper, err := bech32.ConvertAndEncode("cosmosvaloper", valAddr)
+		require.NoError(t, err)
+		valAddrs = append(valAddrs, valAddr)
+		valopers = append(valopers, valoper)
+	}
+
+	zone := types.Zone{ChainId: "cosmoshub-4", ConnectionId: "connection-0", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	for _, valoper := range valopers {
+		zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: valoper, CommissionRate: sdk.ZeroDec(), VotingPower: sdk.ZeroInt(), DelegatorShares: sdk.ZeroDec(), Score: sdk.ZeroDec()})
+	}
+	kpr.SetZone(ctx, &zone)
+
+	present := map[string]bool{valopers[0]: true, valopers[1]: true}
+	missing := kpr.MissingValidators(zone, present)
+	require.ElementsMatch(t, []string{valopers[2], valopers[3]}, missing)
+
+	// a validator holding protocol delegations is retained.
+	delegator := utils.GenerateAccAddressForTest()
+	delegatorAddr, err := bech32.ConvertAndEncode("cosmos", delegator)
+	require.NoError(t, err)
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegatorAddr, valopers[3], sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	require.NoError(t, kpr.HandleValidatorNonMembership(ctx, zone, stakingtypes.GetValidatorKey(valAddrs[3])))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Len(t, zone.Validators, 4)
+
+	// a validator without protocol delegations is pruned.
+	require.NoError(t, kpr.HandleValidatorNonMembership(ctx, zone, stakingtypes.GetValidatorKey(valAddrs[2])))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Len(t, zone.Validators, 3)
+	_, found := zone.GetValidatorByValoper(valopers[2])
+	require.False(t, found)
+
+	// pruning is idempotent.
+	require.NoError(t, kpr.HandleValidatorNonMembership(ctx, zone, stakingtypes.GetValidatorKey(valAddrs[2])))
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Len(t, zone.Validators, 3)
+}
+
+func TestValsetResponseContradicts(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	verifier := kpr.CallbackHandler()
+
+	valAddr := utils.GenerateValAddressForTest()
+	valoper, err := bech32.ConvertAndEncode("cosmosvaloper", valAddr)
+	require.NoError(t, err)
+	validator := stakingtypes.Validator{
+		OperatorAddress: valoper,
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdk.NewInt(1000),
+		DelegatorShares: sdk.NewDec(1000),
+		Commission:      stakingtypes.NewCommission(sdk.NewDecWithPrec(5, 2), sdk.OneDec(), sdk.OneDec()),
+	}
+	result := app.AppCodec().MustMarshal(&stakingtypes.QueryValidatorsResponse{Validators: []stakingtypes.Validator{validator}})
+	query := icqtypes.Query{CallbackId: "valset"}
+	key := stakingtypes.GetValidatorKey(valAddr)
+
+	contradicts := func(proven *stakingtypes.Validator) bool {
+		value := []byte{}
+		if proven != nil {
+			value = app.AppCodec().MustMarshal(proven)
+		}
+		contradicted, err := verifier.Contradicts(ctx, query, result, stakingtypes.StoreKey, key, value)
+		require.NoError(t, err)
+		return contradicted
+	}
+
+	// a matching validator does not contradict the response.
+	require.False(t, contradicts(&validator))
+
+	// a validator with other tokens, or no validator at all, does.
+	proven := validator
+	proven.Tokens = sdk.NewInt(999)
+	require.True(t, contradicts(&proven))
+	require.True(t, contradicts(nil))
+
+	// validators omitted from the response, and other queries, are not verified.
+	other, err := verifier.Contradicts(ctx, query, result, stakingtypes.StoreKey, stakingtypes.GetValidatorKey(utils.GenerateValAddressForTest()), []byte{})
+	require.NoError(t, err)
+	require.False(t, other)
+	query.CallbackId = "rewards"
+	require.False(t, contradicts(nil))
+}
+
+func TestSetValidatorSetForZone(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	valAddrs := []sdk.ValAddress{}
+	valopers := []string{}
+	for i := 0; i < 4; i++ {
+		valAddr := utils.GenerateValAddressForTest()
+		valoper, err := bech32.ConvertAndEncode("cosmosvaloper", valAddr)
+		require.NoError(t, err)
+		valAddrs = append(valAddrs, valAddr)
+		valopers = append(valopers, valoper)
+	}
+
+	zone := types.Zone{ChainId: "cosmoshub-4", ConnectionId: "connection-0", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	for _, valoper := range valopers[:3] {
+		zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: valoper, CommissionRate: sdk.ZeroDec(), VotingPower: sdk.ZeroInt(), DelegatorShares: sdk.ZeroDec(), Score: sdk.ZeroDec()})
+	}
+	kpr.SetZone(ctx, &zone)
+
+	// the second validator, absent from the host, still holds protocol delegations.
+	delegator, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+	require.NoError(t, err)
+	kpr.SetDelegation(ctx, &zone, types.NewDelegation(delegator, valopers[1], sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	pairs := kv.Pairs{}
+	for _, i := range []int{0, 3} {
+		validator := stakingtypes.Validator{
+			OperatorAddress: valopers[i],
+			Status:          stakingtypes.Bonded,
+			Tokens:          sdk.NewInt(1000),
+			DelegatorShares: sdk.NewDec(1000),
+			Commission:      stakingtypes.NewCommission(sdk.NewDecWithPrec(5, 2), sdk.OneDec(), sdk.OneDec()),
+		}
+		pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: stakingtypes.GetValidatorKey(valAddrs[i]), Value: app.AppCodec().MustMarshal(&validator)})
+	}
+	bz, err := pairs.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, icskeeper.SetValidatorSetForZone(kpr, ctx, zone, bz))
+
+	// validators in the set are updated or added; those absent are pruned, unless holding protocol delegations.
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Len(t, zone.Validators, 3)
+	for _, i := range []int{0, 3} {
+		validator, found := zone.GetValidatorByValoper(valopers[i])
+		require.True(t, found)
+		require.Equal(t, sdk.NewInt(1000), validator.VotingPower)
+		require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.CommissionRate)
+	}
+	_, found := zone.GetValidatorByValoper(valopers[1])
+	require.True(t, found)
+	_, found = zone.GetValidatorByValoper(valopers[2])
+	require.False(t, found)
+}
+
+func TestReplaceLegacyValsetQueries(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	kpr.SetParams(ctx, types.DefaultParams())
+
+	zone := types.Zone{ChainId: "cosmoshub-4", ConnectionId: "connection-0", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	kpr.SetZone(ctx, &zone)
+
+	// a zone of a previous release holds a gRPC validator set query for each bond status.
+	for _, status := range []string{stakingtypes.BondStatusBonded, stakingtypes.BondStatusUnbonding, stakingtypes.BondStatusUnbonded} {
+		bz := app.AppCodec().MustMarshal(&stakingtypes.QueryValidatorsRequest{Status: status})
+		app.InterchainQueryKeeper.MakeRequest(ctx, zone.ConnectionId, zone.ChainId, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(100), types.ModuleName, "valset", 0, 0, sdk.Coin{})
+	}
+
+	require.NoError(t, kpr.ReplaceLegacyValsetQueries(ctx))
+
+	queries := []icqtypes.Query{}
+	for _, query := range app.InterchainQueryKeeper.AllQueries(ctx) {
+		if query.CallbackId == "valset" {
+			queries = append(queries, query)
+		}
+	}
+	require.Len(t, queries, 1)
+	require.Equal(t, "store/staking/subspace", queries[0].QueryType)
+	require.Equal(t, stakingtypes.ValidatorsKey, queries[0].Request)
+}
@@ -3,9 +3,9 @@ package keeper
 import (
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ingenuity-build/quicksilver/utils"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
@@ -14,15 +14,35 @@ const (
 	WithdrawStatusTokenize int32 = iota + 1
 	WithdrawStatusUnbond   int32 = iota + 1
 	WithdrawStatusSend     int32 = iota + 1
+	// WithdrawStatusVerify marks an unbonding that has completed per local block time, pending proof of the
+	// delegation account balance.
+	WithdrawStatusVerify int32 = iota + 1
+	// WithdrawStatusTransfer marks a withdrawal returned to a local address, whose transfer packet has been sent by
+	// the host but not yet received.
+	WithdrawStatusTransfer int32 = iota + 1
+	// WithdrawStatusResend marks a withdrawal whose payout failed on the host, or whose transfer to a local address
+	// timed out and is refunded to the delegation account; the payout is resent once the proven balance of the
+	// account covers it.
+	WithdrawStatusResend int32 = iota + 1
 )
 
-func (k Keeper) AddWithdrawalRecord(ctx sdk.Context, zone *types.Zone, delegator string, validator string, recipient string, amount sdk.Coin, burnAmount sdk.Coin, hash string, completionTime time.Time) {
-	record := &types.WithdrawalRecord{ChainId: zone.ChainId, Delegator: delegator, Validator: validator, Recipient: recipient, Amount: amount, Status: WithdrawStatusTokenize, BurnAmount: burnAmount, Txhash: hash, CompletionTime: completionTime}
-	k.SetWithdrawalRecord(ctx, record)
+// PayoutTransferTimeout is the timeout of transfers returning withdrawals to local addresses, from submission of the
+// interchain account packet executing the transfer.
+const PayoutTransferTimeout = 24 * time.Hour
+
+// IsLocalRecipient returns true if the redemption recipient is not a host chain address of the zone, but a Quicksilver
+// account address, to which the redemption is returned over IBC.
+func IsLocalRecipient(zone *types.Zone, recipient string) bool {
+	if _, err := utils.AccAddressFromBech32(recipient, zone.AccountPrefix); err == nil {
+		return false
+	}
+	_, err := utils.AccAddressFromBech32(recipient, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	return err == nil
 }
 
-func GetWithdrawalKey(chainID string, delegator string, txhash string) []byte {
-	return append(types.KeyPrefixWithdrawalRecord, append([]byte(chainID), append([]byte(delegator), []byte(txhash)...)...)...)
+func (k Keeper) AddWithdrawalRecord(ctx sdk.Context, zone *types.Zone, delegator string, validator string, recipient string, amount sdk.Coin, burnAmount sdk.Coin, hash string, completionTime time.Time) {
+	record := &types.WithdrawalRecord{ChainId: zone.ChainId, Delegator: delegator, Validator: validator, Recipient: recipient, Amount: amount, Status: WithdrawStatusTokenize, BurnAmount: burnAmount, Txhash: hash, CompletionTime: completionTime, SlashedAmount: sdk.NewCoin(amount.Denom, sdk.ZeroInt())}
+	k.SetWithdrawalRecord(ctx, record)
 }
 
 ///----------------------------------------------------------------
@@ -30,8 +50,8 @@ func GetWithdrawalKey(chainID string, delegator string, txhash string) []byte {
 // GetWithdrawalRecord returns withdrawal record info by zone and delegator
 func (k Keeper) GetWithdrawalRecord(ctx sdk.Context, zone *types.Zone, txhash string, delegator string, validator string) (types.WithdrawalRecord, bool) {
 	record := types.WithdrawalRecord{}
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetWithdrawalKey(zone.ChainId, delegator, txhash))
-	bz := store.Get([]byte(validator))
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawalRecordKey(zone.ChainId, delegator, txhash, validator))
 	if bz == nil {
 		return record, false
 	}
@@ -41,20 +61,20 @@ func (k Keeper) GetWithdrawalRecord(ctx sdk.Context, zone *types.Zone, txhash st
 
 // SetWithdrawalRecord store the withdrawal record
 func (k Keeper) SetWithdrawalRecord(ctx sdk.Context, record *types.WithdrawalRecord) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetWithdrawalKey(record.ChainId, record.Delegator, record.Txhash))
+	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(record)
-	store.Set([]byte(record.Validator), bz)
+	store.Set(types.GetWithdrawalRecordKey(record.ChainId, record.Delegator, record.Txhash, record.Validator), bz)
 }
 
 // DeleteWithdrawalRecord deletes withdrawal record
 func (k Keeper) DeleteWithdrawalRecord(ctx sdk.Context, zone *types.Zone, txhash string, delegator string, validator string) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetWithdrawalKey(zone.ChainId, delegator, txhash))
-	store.Delete([]byte(validator))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetWithdrawalRecordKey(zone.ChainId, delegator, txhash, validator))
 }
 
-// IteratePrefixedWithdrawalRecords iterate through all records with given prefix
+// IteratePrefixedWithdrawalRecords iterate through all records with given store key prefix
 func (k Keeper) IteratePrefixedWithdrawalRecords(ctx sdk.Context, prefixBytes []byte, fn func(index int64, record types.WithdrawalRecord) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawalRecord)
+	store := ctx.KVStore(k.storeKey)
 
 	iterator := sdk.KVStorePrefixIterator(store, prefixBytes)
 	defer iterator.Close()
@@ -76,22 +96,22 @@ func (k Keeper) IteratePrefixedWithdrawalRecords(ctx sdk.Context, prefixBytes []
 
 // IterateWithdrawalRecords iterate through all records
 func (k Keeper) IterateWithdrawalRecords(ctx sdk.Context, fn func(index int64, record types.WithdrawalRecord) (stop bool)) {
-	k.IteratePrefixedWithdrawalRecords(ctx, nil, fn)
+	k.IteratePrefixedWithdrawalRecords(ctx, types.KeyPrefixWithdrawalRecord, fn)
 }
 
 // IterateZoneWithdrawalRecords iterate through records for a given zone
 func (k Keeper) IterateZoneWithdrawalRecords(ctx sdk.Context, zone *types.Zone, fn func(index int64, record types.WithdrawalRecord) (stop bool)) {
-	k.IteratePrefixedWithdrawalRecords(ctx, []byte(zone.ChainId), fn)
+	k.IteratePrefixedWithdrawalRecords(ctx, types.GetZoneWithdrawalRecordsKey(zone.ChainId), fn)
 }
 
 // IterateZoneDelegatorWithdrawalRecords iterate through records for a given zone / delegator tuple
 func (k Keeper) IterateZoneDelegatorWithdrawalRecords(ctx sdk.Context, zone *types.Zone, delegator string, fn func(index int64, record types.WithdrawalRecord) (stop bool)) {
-	k.IteratePrefixedWithdrawalRecords(ctx, []byte(zone.ChainId+delegator), fn)
+	k.IteratePrefixedWithdrawalRecords(ctx, types.GetDelegatorWithdrawalRecordsKey(zone.ChainId, delegator), fn)
 }
 
 // IterateWithdrawalRecords iterate through records for a given zone / delegator / hash treble
 func (k Keeper) IterateZoneDelegatorHashWithdrawalRecords(ctx sdk.Context, zone *types.Zone, txhash string, delegator string, fn func(index int64, record types.WithdrawalRecord) (stop bool)) {
-	k.IteratePrefixedWithdrawalRecords(ctx, GetWithdrawalKey(zone.ChainId, delegator, txhash), fn)
+	k.IteratePrefixedWithdrawalRecords(ctx, types.GetWithdrawalKey(zone.ChainId, delegator, txhash), fn)
 }
 
 // AllZoneDelegatorHashWithdrawalRecords returns every record in the store for the specified zone / delegator / hash treble
@@ -104,6 +124,19 @@ func (k Keeper) AllZoneDelegatorHashWithdrawalRecords(ctx sdk.Context, zone *typ
 	return records
 }
 
+// AllZoneHashWithdrawalRecords returns every record in the store for the specified zone / hash tuple, across all
+// delegation accounts
+func (k Keeper) AllZoneHashWithdrawalRecords(ctx sdk.Context, zone *types.Zone, txhash string) []types.WithdrawalRecord {
+	records := []types.WithdrawalRecord{}
+	k.IterateZoneWithdrawalRecords(ctx, zone, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+		if record.Txhash == txhash {
+			records = append(records, record)
+		}
+		return false
+	})
+	return records
+}
+
 // AllZoneDelegatorWithdrawalRecords returns every record in the store for the specified zone / delegator tuple
 func (k Keeper) AllZoneDelegatorWithdrawalRecords(ctx sdk.Context, zone *types.Zone, delegator string) []types.WithdrawalRecord {
 	records := []types.WithdrawalRecord{}
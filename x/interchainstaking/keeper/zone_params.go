@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetZoneParam returns the zone override of the module parameter with the given key, or the module parameter if
+// the zone does not override it.
+func (k *Keeper) GetZoneParam(ctx sdk.Context, zone *types.Zone, key []byte) uint64 {
+	if out := zone.Params.Get(key); out != 0 {
+		return out
+	}
+	return k.GetParam(ctx, key)
+}
+
+// GetEffectiveZoneParams returns the parameters in effect for the zone.
+func (k *Keeper) GetEffectiveZoneParams(ctx sdk.Context, zone *types.Zone) types.ZoneParams {
+	return types.ZoneParams{
+		DepositInterval:      k.GetZoneParam(ctx, zone, types.KeyDepositInterval),
+		ValidatorSetInterval: k.GetZoneParam(ctx, zone, types.KeyValidatorSetInterval),
+		DelegateAccountCount: k.GetZoneParam(ctx, zone, types.KeyDelegateAccountCount),
+	}
+}
+
+// SetZoneParam sets the zone override of the module parameter with the given key; zero removes the override. The
+// periodic queries and interchain accounts of an active zone are brought in line with the new value. A pending
+// zone needs no changes, as its queries and accounts are created on activation.
+func (k *Keeper) SetZoneParam(ctx sdk.Context, zone *types.Zone, key []byte, value uint64) error {
+	previous := k.GetZoneParam(ctx, zone, key)
+
+	switch {
+	case bytes.Equal(key, types.KeyDepositInterval):
+		zone.Params.DepositInterval = value
+	case bytes.Equal(key, types.KeyValidatorSetInterval):
+		zone.Params.ValidatorSetInterval = value
+	case bytes.Equal(key, types.KeyDelegateAccountCount):
+		zone.Params.DelegateAccountCount = value
+	default:
+		return fmt.Errorf("unable to override parameter %s for zone", key)
+	}
+
+	current := k.GetZoneParam(ctx, zone, key)
+	if !zone.IsActive() || current == previous {
+		k.SetZone(ctx, zone)
+		return nil
+	}
+
+	switch {
+	case bytes.Equal(key, types.KeyDepositInterval):
+		k.SetZone(ctx, zone)
+		// the period of an existing query is not updated on re-request, so the query is replaced.
+		k.deleteZoneQueries(ctx, zone, "allbalances")
+		if zone.DepositAddress == nil {
+			return nil
+		}
+		return k.EmitDepositBalanceQuery(ctx, zone)
+
+	case bytes.Equal(key, types.KeyValidatorSetInterval):
+		k.SetZone(ctx, zone)
+		k.deleteZoneQueries(ctx, zone, "valset", "govproposals")
+		if err := k.EmitValsetRequery(ctx, zone.ConnectionId, zone.ChainId); err != nil {
+			return err
+		}
+		k.EmitGovProposalsQuery(ctx, *zone)
+		return nil
+
+	default:
+		// delegate accounts may hold delegations, so are never removed.
+		if current < previous {
+			return fmt.Errorf("delegate account count of active zone %s cannot be reduced from %d to %d", zone.ChainId, previous, current)
+		}
+		k.SetZone(ctx, zone)
+		for i := previous; i < current; i++ {
+			portOwner := fmt.Sprintf("%s.delegate.%d", zone.ChainId, i)
+			if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// deleteZoneQueries removes the queries for the zone with the given callbacks.
+func (k *Keeper) deleteZoneQueries(ctx sdk.Context, zone *types.Zone, callbackIDs ...string) {
+	for _, query := range k.ICQKeeper.AllQueries(ctx) {
+		if query.ChainId != zone.ChainId || query.ConnectionId != zone.ConnectionId {
+			continue
+		}
+		for _, callbackID := range callbackIDs {
+			if query.CallbackId == callbackID {
+				k.ICQKeeper.DeleteQuery(ctx, query.Id)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestZoneParamOverrides(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	host := simulation.NewMockHost(kpr)
+	require.NoError(t, host.Connect(rand.New(rand.NewSource(1)), ctx))
+
+	// queryPeriods returns the periods of the zone's queries with the given callback.
+	queryPeriods := func(callbackID string) []int64 {
+		out := []int64{}
+		for _, q := range kpr.ICQKeeper.AllQueries(ctx) {
+			if q.ChainId == simulation.MockHostChainID && q.CallbackId == callbackID {
+				out = append(out, q.Period.Int64())
+			}
+		}
+		return out
+	}
+
+	// 1. overrides set at registration are used when the zone is activated.
+	proposal := types.NewRegisterZoneProposal("mock host", "mock host", host.ConnectionID, simulation.MockHostBaseDenom, simulation.MockHostLocalDenom, simulation.MockHostAccountPrefix, false, false)
+	proposal.Params = types.ZoneParams{DepositInterval: 7, DelegateAccountCount: 5}
+	require.NoError(t, icskeeper.HandleRegisterZoneProposal(ctx, kpr, proposal))
+	require.NoError(t, host.CompleteHandshakes(ctx))
+	require.NoError(t, host.AnswerPreflightQueries(ctx))
+	require.NoError(t, host.CompleteHandshakes(ctx))
+
+	zone, found := kpr.GetZone(ctx, simulation.MockHostChainID)
+	require.True(t, found)
+	require.True(t, zone.IsActive())
+	require.Len(t, zone.DelegationAddresses, 5)
+	require.Equal(t, []int64{7}, queryPeriods("allbalances"))
+
+	valsetInterval := kpr.GetParam(ctx, types.KeyValidatorSetInterval)
+	require.Equal(t, types.ZoneParams{DepositInterval: 7, ValidatorSetInterval: valsetInterval, DelegateAccountCount: 5}, kpr.GetEffectiveZoneParams(ctx, &zone))
+
+	res, err := kpr.ZoneInfos(sdk.WrapSDKContext(ctx), &types.QueryZonesInfoRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.EffectiveParams, 1)
+	require.Equal(t, kpr.GetEffectiveZoneParams(ctx, &zone), res.EffectiveParams[0])
+
+	// 2. updated overrides replace the zone's periodic queries and add delegate accounts.
+	update := types.NewUpdateZoneProposal("update", "update", zone.ChainId, []*types.UpdateZoneValue{
+		{Key: "deposit_interval", Value: "3"},
+		{Key: "validator_set_interval", Value: "50"},
+		{Key: "delegate_account_count", Value: "8"},
+	})
+	require.NoError(t, icskeeper.HandleUpdateZoneProposal(ctx, kpr, update))
+	require.NoError(t, host.CompleteHandshakes(ctx))
+
+	zone, _ = kpr.GetZone(ctx, zone.ChainId)
+	require.Len(t, zone.DelegationAddresses, 8)
+	require.Equal(t, []int64{3}, queryPeriods("allbalances"))
+	require.Equal(t, []int64{50}, queryPeriods("valset"))
+	require.Equal(t, []int64{50}, queryPeriods("govproposals"))
+
+	// 3. removing an override restores the module parameter.
+	update = types.NewUpdateZoneProposal("update", "update", zone.ChainId, []*types.UpdateZoneValue{{Key: "validator_set_interval", Value: "0"}})
+	require.NoError(t, icskeeper.HandleUpdateZoneProposal(ctx, kpr, update))
+	require.Equal(t, []int64{int64(valsetInterval)}, queryPeriods("valset"))
+
+	// 4. delegate accounts are never removed.
+	update = types.NewUpdateZoneProposal("update", "update", zone.ChainId, []*types.UpdateZoneValue{{Key: "delegate_account_count", Value: "2"}})
+	require.ErrorContains(t, icskeeper.HandleUpdateZoneProposal(ctx, kpr, update), "cannot be reduced")
+}
+
+func TestGetParamsOfUpgradedChain(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	// a chain upgraded from v1 holds only the parameters that existed then.
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyDelegateAccountCount, uint64(10))
+	subspace.Set(ctx, types.KeyDepositInterval, uint64(7))
+	subspace.Set(ctx, types.KeyValidatorSetInterval, uint64(50))
+	subspace.Set(ctx, types.KeyCommissionRate, sdk.MustNewDecFromStr("0.02"))
+
+	params := kpr.GetParams(ctx)
+	require.Equal(t, uint64(7), params.DepositInterval)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), params.CommissionRate)
+	require.Equal(t, types.DefaultMaxCuratorWeight, params.MaxCuratorWeight)
+	require.Equal(t, types.DefaultInstantRedemptionFee, params.InstantRedemptionFee)
+	require.Equal(t, types.DefaultQueryBounty, params.QueryBounty)
+
+	// the exported genesis of the chain carries the defaults of the missing parameters.
+	require.NotPanics(t, func() { interchainstaking.ExportGenesis(ctx, kpr) })
+}
+
+func TestQueryBountyAttached(t *testing.T) {
+	app := newInitialisedQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight(), Time: time.Unix(1_700_000_000, 0).UTC()}).WithEventManager(sdk.NewEventManager())
+
+	params := kpr.GetParams(ctx)
+	params.QueryBounty = sdk.NewInt(25)
+	kpr.SetParams(ctx, params)
+
+	host := simulation.NewMockHost(kpr)
+	_, err := host.Setup(rand.New(rand.NewSource(1)), ctx)
+	require.NoError(t, err)
+
+	// each proven query of the zone carries the bounty, in the interchainquery bounty denom; unproven queries do not.
+	bounty := sdk.NewCoin(kpr.ICQKeeper.BountyDenom(ctx), sdk.NewInt(25))
+	proven, unproven := 0, 0
+	for _, q := range kpr.ICQKeeper.AllQueries(ctx) {
+		if q.ChainId != simulation.MockHostChainID || q.Module != types.ModuleName {
+			continue
+		}
+		if icqtypes.IsProven(q.QueryType) {
+			require.Equal(t, bounty, q.Bounty, q.CallbackId)
+			proven++
+		} else {
+			require.False(t, q.HasBounty(), q.CallbackId)
+			unproven++
+		}
+	}
+	require.NotZero(t, proven)
+	require.NotZero(t, unproven)
+}
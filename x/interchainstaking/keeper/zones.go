@@ -19,8 +19,8 @@ import (
 // GetZone returns zone info by chainID
 func (k Keeper) GetZone(ctx sdk.Context, chainID string) (types.Zone, bool) {
 	zone := types.Zone{}
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZone)
-	bz := store.Get([]byte(chainID))
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetZoneKey(chainID))
 	if len(bz) == 0 {
 		return zone, false
 	}
@@ -29,17 +29,87 @@ func (k Keeper) GetZone(ctx sdk.Context, chainID string) (types.Zone, bool) {
 	return zone, true
 }
 
-// SetZone set zone info
+// SetZone set zone info, and updates the account and denom indexes of the zone
 func (k Keeper) SetZone(ctx sdk.Context, zone *types.Zone) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZone)
+	existing, _ := k.GetZone(ctx, zone.ChainId)
+	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(zone)
-	store.Set([]byte(zone.ChainId), bz)
+	store.Set(types.GetZoneKey(zone.ChainId), bz)
+	k.updateZoneIndexes(ctx, &existing, zone)
 }
 
-// DeleteZone delete zone info
+// DeleteZone delete zone info, and removes the zone from the account and denom indexes
 func (k Keeper) DeleteZone(ctx sdk.Context, chainID string) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZone)
-	store.Delete([]byte(chainID))
+	if existing, found := k.GetZone(ctx, chainID); found {
+		k.updateZoneIndexes(ctx, &existing, &types.Zone{ChainId: chainID})
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetZoneKey(chainID))
+}
+
+// updateZoneIndexes updates the index entries of the zone from those of its previous state, removing the
+// accounts and local denom it no longer has and adding those it did not have. Unchanged entries are not
+// rewritten, as SetZone is called for most updates of a zone.
+func (k Keeper) updateZoneIndexes(ctx sdk.Context, previous *types.Zone, zone *types.Zone) {
+	store := ctx.KVStore(k.storeKey)
+
+	current := map[string]bool{}
+	for _, address := range zone.GetAccountAddresses() {
+		current[address] = true
+	}
+	indexed := map[string]bool{}
+	for _, address := range previous.GetAccountAddresses() {
+		indexed[address] = true
+		if !current[address] {
+			store.Delete(types.GetAccountZoneIndexKey(address, zone.ChainId))
+		}
+	}
+	for _, address := range zone.GetAccountAddresses() {
+		if !indexed[address] {
+			store.Set(types.GetAccountZoneIndexKey(address, zone.ChainId), []byte(zone.ChainId))
+		}
+	}
+
+	if previous.LocalDenom == zone.LocalDenom {
+		return
+	}
+	if previous.LocalDenom != "" {
+		store.Delete(types.GetDenomZoneIndexKey(previous.LocalDenom, zone.ChainId))
+	}
+	if zone.LocalDenom != "" {
+		store.Set(types.GetDenomZoneIndexKey(zone.LocalDenom, zone.ChainId), []byte(zone.ChainId))
+	}
+}
+
+// iterateIndexedZones iterates through the zones referenced by the index entries under the given prefix,
+// in chain id order.
+func (k Keeper) iterateIndexedZones(ctx sdk.Context, indexPrefix []byte, fn func(zone types.Zone) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), indexPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		zone, found := k.GetZone(ctx, string(iterator.Value()))
+		if !found {
+			continue
+		}
+		if fn(zone) {
+			break
+		}
+	}
+}
+
+// GetZoneForLocalDenom returns the zone whose qAsset has the given denom.
+func (k Keeper) GetZoneForLocalDenom(ctx sdk.Context, denom string) (types.Zone, bool) {
+	var zone types.Zone
+	found := false
+	k.iterateIndexedZones(ctx, types.GetDenomZoneIndexPrefix(denom), func(zoneInfo types.Zone) (stop bool) {
+		if zoneInfo.LocalDenom == denom {
+			zone = zoneInfo
+			found = true
+		}
+		return found
+	})
+	return zone, found
 }
 
 // IterateZones iterate through zones
@@ -101,7 +171,7 @@ func (k Keeper) GetZoneFromContext(ctx sdk.Context) (*types.Zone, error) {
 // GetZoneForDelegateAccount determines the zone for a given address.
 func (k Keeper) GetZoneForDelegateAccount(ctx sdk.Context, address string) *types.Zone {
 	var zone *types.Zone
-	k.IterateZones(ctx, func(_ int64, zoneInfo types.Zone) (stop bool) {
+	k.iterateIndexedZones(ctx, types.GetAccountZoneIndexPrefix(address), func(zoneInfo types.Zone) (stop bool) {
 		for _, ica := range zoneInfo.GetDelegationAccounts() {
 			if ica.Address == address {
 				zone = &zoneInfo
@@ -113,9 +183,22 @@ func (k Keeper) GetZoneForDelegateAccount(ctx sdk.Context, address string) *type
 	return zone
 }
 
+// GetZoneForDepositAccount determines the zone for a given deposit account address.
+func (k Keeper) GetZoneForDepositAccount(ctx sdk.Context, address string) *types.Zone {
+	var zone *types.Zone
+	k.iterateIndexedZones(ctx, types.GetAccountZoneIndexPrefix(address), func(zoneInfo types.Zone) (stop bool) {
+		if zoneInfo.DepositAddress != nil && zoneInfo.DepositAddress.Address == address {
+			zone = &zoneInfo
+			return true
+		}
+		return false
+	})
+	return zone
+}
+
 func (k Keeper) GetZoneForPerformanceAccount(ctx sdk.Context, address string) *types.Zone {
 	var zone *types.Zone
-	k.IterateZones(ctx, func(_ int64, zoneInfo types.Zone) (stop bool) {
+	k.iterateIndexedZones(ctx, types.GetAccountZoneIndexPrefix(address), func(zoneInfo types.Zone) (stop bool) {
 		if zoneInfo.PerformanceAddress != nil && zoneInfo.PerformanceAddress.Address == address {
 			zone = &zoneInfo
 			return true
@@ -129,7 +212,7 @@ func (k Keeper) GetZoneForPerformanceAccount(ctx sdk.Context, address string) *t
 func (k Keeper) GetICAForDelegateAccount(ctx sdk.Context, address string) (*types.Zone, *types.ICAAccount) {
 	var ica *types.ICAAccount
 	var zone *types.Zone
-	k.IterateZones(ctx, func(_ int64, zoneInfo types.Zone) (stop bool) {
+	k.iterateIndexedZones(ctx, types.GetAccountZoneIndexPrefix(address), func(zoneInfo types.Zone) (stop bool) {
 		for _, delegateAccount := range zoneInfo.GetDelegationAccounts() {
 			if delegateAccount.Address == address {
 				ica = delegateAccount
@@ -152,7 +235,7 @@ func (k *Keeper) EnsureWithdrawalAddresses(ctx sdk.Context, zone *types.Zone) er
 	for _, da := range zone.GetDelegationAccounts() {
 		if da.WithdrawalAddress != zone.WithdrawalAddress.Address {
 			msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: da.Address, WithdrawAddress: withdrawalAddress}
-			err := k.SubmitTx(ctx, []sdk.Msg{&msg}, da, "")
+			err := k.SubmitTx(ctx, []sdk.Msg{&msg}, da, OperationMemo(ctx, "setwithdrawaddress", da.Address))
 			if err != nil {
 				return err
 			}
@@ -162,7 +245,7 @@ func (k *Keeper) EnsureWithdrawalAddresses(ctx sdk.Context, zone *types.Zone) er
 	// set withdrawal address for performance address, if it exists
 	if zone.PerformanceAddress != nil && zone.PerformanceAddress.WithdrawalAddress != withdrawalAddress {
 		msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: zone.PerformanceAddress.Address, WithdrawAddress: withdrawalAddress}
-		err := k.SubmitTx(ctx, []sdk.Msg{&msg}, zone.PerformanceAddress, "")
+		err := k.SubmitTx(ctx, []sdk.Msg{&msg}, zone.PerformanceAddress, OperationMemo(ctx, "setwithdrawaddress", zone.PerformanceAddress.Address))
 		if err != nil {
 			return err
 		}
@@ -170,8 +253,9 @@ func (k *Keeper) EnsureWithdrawalAddresses(ctx sdk.Context, zone *types.Zone) er
 	return nil
 }
 
-// SetAccountBalanceForDenom sets the balance on an account for a given denominination.
-func SetAccountBalanceForDenom(k Keeper, ctx sdk.Context, zone types.Zone, address string, coin sdk.Coin) error {
+// SetAccountBalanceForDenom sets the balance on an account for a given denominination, as proven at the given host
+// height; zero if the query was not pinned to a height.
+func SetAccountBalanceForDenom(k Keeper, ctx sdk.Context, zone types.Zone, address string, coin sdk.Coin, height int64) error {
 	// ? is this switch statement still required ?
 	// prior to callback we had no way to distinguish the originator
 	// with the query type in setAccountCb this is probably superfluous...
@@ -233,11 +317,19 @@ func SetAccountBalanceForDenom(k Keeper, ctx sdk.Context, zone types.Zone, addre
 			}
 		}
 
+		if coin.Denom == zone.BaseDenom {
+			// pay out completed unbondings from the proven balance, and don't re-delegate funds owed to redeemers.
+			coin, err = k.SettleUnbondedWithdrawals(ctx, &zone, icaAccount, coin, height)
+			if err != nil {
+				return err
+			}
+		}
+
 		icaAccount.Balance = icaAccount.Balance.Add(coin)
 		k.Logger(ctx).Info("Matched delegate address", "address", address, "wg", icaAccount.BalanceWaitgroup, "balance", icaAccount.Balance)
 
 		if zone.WithdrawalAddress.BalanceWaitgroup == 0 {
-			if !icaAccount.Balance.Empty() {
+			if !icaAccount.Balance.Empty() && coin.IsPositive() {
 				k.Logger(ctx).Info("Delegate account balance is non-zero; delegating!", "to_delegate", icaAccount.Balance)
 				valPlan, err := types.DelegationPlanFromGlobalIntent(k.GetDelegatedAmount(ctx, &zone), k.GetDelegationBinsMap(ctx, &zone), coin, zone.GetAggregateIntentOrDefault())
 				if err != nil {
@@ -304,6 +396,8 @@ func (k Keeper) SetAccountBalance(ctx sdk.Context, zone types.Zone, address stri
 				types.ModuleName,
 				"accountbalance",
 				0,
+				0,
+				k.queryBounty(ctx),
 			)
 			icaAccount.BalanceWaitgroup++
 
@@ -323,6 +417,8 @@ func (k Keeper) SetAccountBalance(ctx sdk.Context, zone types.Zone, address stri
 			types.ModuleName,
 			"accountbalance",
 			0,
+			0,
+			k.queryBounty(ctx),
 		)
 		icaAccount.BalanceWaitgroup++
 	}
@@ -353,9 +449,9 @@ func (r RedemptionTargets) Get(delAddr string, valAddr string) *RedemptionTarget
 }
 
 func (r RedemptionTargets) Add(delAddr string, valAddr string, amount sdk.Coins) RedemptionTargets {
-	for _, rt := range r.Sorted() {
-		if rt.DelegatorAddress == delAddr && rt.ValidatorAddress == valAddr {
-			rt.Value = rt.Value.Add(amount...)
+	for idx := range r {
+		if r[idx].DelegatorAddress == delAddr && r[idx].ValidatorAddress == valAddr {
+			r[idx].Value = r[idx].Value.Add(amount...)
 			return r
 		}
 	}
@@ -409,8 +505,12 @@ func SatisfyRequestsForBins(requests types.Allocations, bins types.Allocations,
 	return requests
 }
 
+// GetRedemptionTargets determines the delegator/validator pairs from which the requested allocations are
+// undelegated. Pairs that have no remaining unbonding entries on the host are skipped, and any amount that cannot
+// be met from the requested validator is taken from other delegations that can still be undelegated.
 func (k *Keeper) GetRedemptionTargets(ctx sdk.Context, zone types.Zone, requests types.Allocations) (RedemptionTargets, error) {
 	out := RedemptionTargets{}
+	shortfall := sdk.ZeroInt()
 
 	bins := k.GetDelegationBinsMap(ctx, &zone)
 
@@ -434,6 +534,13 @@ func (k *Keeper) GetRedemptionTargets(ctx sdk.Context, zone types.Zone, requests
 		})
 
 		for _, delegation := range delegations {
+			if !remainingTokens.IsPositive() {
+				break
+			}
+			if k.RemainingUnbondingEntries(ctx, &zone, delegation.DelegationAddress, delegation.ValidatorAddress) == 0 {
+				// the host will reject further undelegations for this pair.
+				continue
+			}
 			if delegation.Amount.Amount.GTE(remainingTokens) {
 				out = out.Add(delegation.DelegationAddress, delegation.ValidatorAddress, sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, remainingTokens)))
 				remainingTokens = sdk.ZeroInt()
@@ -445,11 +552,40 @@ func (k *Keeper) GetRedemptionTargets(ctx sdk.Context, zone types.Zone, requests
 			}
 		}
 
-		// panic if any tokens still remain
-		if remainingTokens.GT(sdk.ZeroInt()) {
-			return RedemptionTargets{}, fmt.Errorf("redemption with remaining amount: %s", remainingTokens.String())
+		shortfall = shortfall.Add(remainingTokens)
+	}
+
+	if shortfall.IsPositive() {
+		// take the shortfall from the largest delegations with undelegated tokens and remaining unbonding entries.
+		delegations := k.GetAllDelegations(ctx, &zone)
+		sort.SliceStable(delegations, func(i, j int) bool {
+			return delegations[i].Amount.Amount.GT(delegations[j].Amount.Amount)
+		})
+		for _, delegation := range delegations {
+			if !shortfall.IsPositive() {
+				break
+			}
+			if k.RemainingUnbondingEntries(ctx, &zone, delegation.DelegationAddress, delegation.ValidatorAddress) == 0 {
+				continue
+			}
+			available := delegation.Amount.Amount
+			if target := out.Get(delegation.DelegationAddress, delegation.ValidatorAddress); target != nil {
+				available = available.Sub(target.Value.AmountOf(zone.BaseDenom))
+			}
+			if !available.IsPositive() {
+				continue
+			}
+			if available.GT(shortfall) {
+				available = shortfall
+			}
+			out = out.Add(delegation.DelegationAddress, delegation.ValidatorAddress, sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, available)))
+			shortfall = shortfall.Sub(available)
 		}
+	}
 
+	// error if any tokens still remain
+	if shortfall.IsPositive() {
+		return RedemptionTargets{}, fmt.Errorf("redemption with remaining amount: %s", shortfall.String())
 	}
 
 	return out, nil
@@ -503,5 +639,5 @@ func (k Keeper) InitPerformanceDelegations(ctx sdk.Context, zone types.Zone, res
 		})
 	}
 
-	return k.SubmitTx(ctx, msgs, zone.PerformanceAddress, "")
+	return k.SubmitTx(ctx, msgs, zone.PerformanceAddress, OperationMemo(ctx, "performance", zone.PerformanceAddress.Address))
 }
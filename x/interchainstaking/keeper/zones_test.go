@@ -258,3 +258,164 @@ func TestDeltasAndIntents(t *testing.T) {
 		fmt.Println(i)
 	}
 }
+
+func TestZoneIndexes(t *testing.T) {
+	app := newQuicksilver(t)
+	kpr := app.InterchainstakingKeeper
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	zone := types.Zone{
+		ConnectionId:        "connection-0",
+		ChainId:             "cosmoshub-4",
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		PerformanceAddress:  &types.ICAAccount{Address: "cosmos1performance"},
+		DelegationAddresses: []*types.ICAAccount{{Address: "cosmos1delegate0"}, {Address: "cosmos1delegate1"}},
+	}
+	kpr.SetZone(ctx, &zone)
+
+	// 1. Lookups resolve through the indexes.
+	require.Equal(t, &zone, kpr.GetZoneForDelegateAccount(ctx, "cosmos1delegate1"))
+	require.Equal(t, &zone, kpr.GetZoneForPerformanceAccount(ctx, "cosmos1performance"))
+	gotZone, ica := kpr.GetICAForDelegateAccount(ctx, "cosmos1delegate0")
+	require.Equal(t, &zone, gotZone)
+	require.Equal(t, zone.DelegationAddresses[0], ica)
+	gotLocal, found := kpr.GetZoneForLocalDenom(ctx, "uqatom")
+	require.True(t, found)
+	require.Equal(t, zone, gotLocal)
+
+	// 2. Updating a zone removes the index entries of accounts and denoms it no longer has.
+	zone.DelegationAddresses = zone.DelegationAddresses[:1]
+	zone.LocalDenom = "uqatom2"
+	kpr.SetZone(ctx, &zone)
+	require.Nil(t, kpr.GetZoneForDelegateAccount(ctx, "cosmos1delegate1"))
+	require.Equal(t, &zone, kpr.GetZoneForDelegateAccount(ctx, "cosmos1delegate0"))
+	_, found = kpr.GetZoneForLocalDenom(ctx, "uqatom")
+	require.False(t, found)
+	_, found = kpr.GetZoneForLocalDenom(ctx, "uqatom2")
+	require.True(t, found)
+
+	// 3. Unchanged entries are not rewritten: an entry removed behind the keeper's back stays removed.
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.GetAccountZoneIndexKey("cosmos1performance", zone.ChainId))
+	zone.DelegationAddresses = append(zone.DelegationAddresses, &types.ICAAccount{Address: "cosmos1delegate2"})
+	kpr.SetZone(ctx, &zone)
+	require.Nil(t, kpr.GetZoneForPerformanceAccount(ctx, "cosmos1performance"))
+	require.Equal(t, &zone, kpr.GetZoneForDelegateAccount(ctx, "cosmos1delegate2"))
+
+	// 4. Deleting a zone removes all of its index entries.
+	kpr.DeleteZone(ctx, zone.ChainId)
+	require.Nil(t, kpr.GetZoneForDelegateAccount(ctx, "cosmos1delegate0"))
+	require.Nil(t, kpr.GetZoneForDelegateAccount(ctx, "cosmos1delegate2"))
+	_, found = kpr.GetZoneForLocalDenom(ctx, "uqatom2")
+	require.False(t, found)
+}
+
+const (
+	benchmarkZoneCount             = 50
+	benchmarkDelegateAccountsCount = 100
+)
+
+// setupBenchmarkZones registers benchmarkZoneCount zones, each with benchmarkDelegateAccountsCount delegate
+// accounts, and returns the delegate account and local denom of the last zone, the worst case for a scan.
+func setupBenchmarkZones(b *testing.B) (*app.Quicksilver, sdk.Context, string, string) {
+	b.Helper()
+
+	quicksilver := app.NewQuicksilver(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		io.Discard,
+		true,
+		map[int64]bool{},
+		b.TempDir(),
+		5,
+		app.MakeEncodingConfig(),
+		simapp.EmptyAppOptions{},
+	)
+	// write directly to the root store, as committed state, rather than to a cached context.
+	ctx := quicksilver.NewUncachedContext(true, tmproto.Header{Height: quicksilver.LastBlockHeight()})
+
+	var delegateAccount, localDenom string
+	for i := 0; i < benchmarkZoneCount; i++ {
+		chainID := fmt.Sprintf("testchain-%d", i)
+		zone := types.Zone{
+			ConnectionId: fmt.Sprintf("connection-%d", i),
+			ChainId:      chainID,
+			LocalDenom:   fmt.Sprintf("uqtest%d", i),
+			BaseDenom:    fmt.Sprintf("utest%d", i),
+		}
+		for j := 0; j < benchmarkDelegateAccountsCount; j++ {
+			zone.DelegationAddresses = append(zone.DelegationAddresses, &types.ICAAccount{
+				Address:  fmt.Sprintf("cosmos1%s-delegate-%d", chainID, j),
+				PortName: fmt.Sprintf("%s.delegate.%d", chainID, j),
+			})
+		}
+		quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+		delegateAccount = zone.DelegationAddresses[benchmarkDelegateAccountsCount-1].Address
+		localDenom = zone.LocalDenom
+	}
+	return quicksilver, ctx, delegateAccount, localDenom
+}
+
+func BenchmarkGetZoneForDelegateAccount(b *testing.B) {
+	quicksilver, ctx, delegateAccount, _ := setupBenchmarkZones(b)
+	kpr := quicksilver.InterchainstakingKeeper
+
+	b.Run("indexed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if kpr.GetZoneForDelegateAccount(ctx, delegateAccount) == nil {
+				b.Fatal("zone not found")
+			}
+		}
+	})
+
+	b.Run("scan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var zone *types.Zone
+			kpr.IterateZones(ctx, func(_ int64, zoneInfo types.Zone) (stop bool) {
+				for _, ica := range zoneInfo.GetDelegationAccounts() {
+					if ica.Address == delegateAccount {
+						zone = &zoneInfo
+						return true
+					}
+				}
+				return false
+			})
+			if zone == nil {
+				b.Fatal("zone not found")
+			}
+		}
+	})
+}
+
+func BenchmarkGetZoneForLocalDenom(b *testing.B) {
+	quicksilver, ctx, _, localDenom := setupBenchmarkZones(b)
+	kpr := quicksilver.InterchainstakingKeeper
+
+	b.Run("indexed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, found := kpr.GetZoneForLocalDenom(ctx, localDenom); !found {
+				b.Fatal("zone not found")
+			}
+		}
+	})
+
+	b.Run("scan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var zone *types.Zone
+			kpr.IterateZones(ctx, func(_ int64, zoneInfo types.Zone) (stop bool) {
+				if zoneInfo.LocalDenom == localDenom {
+					zone = &zoneInfo
+					return true
+				}
+				return false
+			})
+			if zone == nil {
+				b.Fatal("zone not found")
+			}
+		}
+	})
+}
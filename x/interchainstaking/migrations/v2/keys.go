@@ -0,0 +1,40 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Key layouts are pinned here, rather than taken from the types package, so that the migration
+// remains correct if the current layout changes again.
+
+var (
+	KeyPrefixZone             = []byte{0x01}
+	KeyPrefixWithdrawalRecord = []byte{0x05}
+)
+
+// v1ZoneKey returns the v1 store key of a zone: prefix | chain id.
+func v1ZoneKey(chainID string) []byte {
+	return append(KeyPrefixZone, []byte(chainID)...)
+}
+
+// v1WithdrawalRecordKey returns the v1 store key of a withdrawal record: prefix | chain id | delegator | txhash | validator.
+func v1WithdrawalRecordKey(chainID string, delegator string, txhash string, validator string) []byte {
+	key := append(KeyPrefixWithdrawalRecord, []byte(chainID)...)
+	key = append(key, []byte(delegator)...)
+	key = append(key, []byte(txhash)...)
+	return append(key, []byte(validator)...)
+}
+
+// ZoneKey returns the v2 store key of a zone: prefix | len(chain id) | chain id.
+func ZoneKey(chainID string) []byte {
+	return append(KeyPrefixZone, address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// WithdrawalRecordKey returns the v2 store key of a withdrawal record:
+// prefix | len(chain id) | chain id | len(delegator) | delegator | len(txhash) | txhash | validator.
+func WithdrawalRecordKey(chainID string, delegator string, txhash string, validator string) []byte {
+	key := append(KeyPrefixWithdrawalRecord, address.MustLengthPrefix([]byte(chainID))...)
+	key = append(key, address.MustLengthPrefix([]byte(delegator))...)
+	key = append(key, address.MustLengthPrefix([]byte(txhash))...)
+	return append(key, []byte(validator)...)
+}
@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration includes:
+//
+// - Zones are keyed by length-prefixed chain id, so that no zone key is a prefix of another.
+// - Withdrawal records are keyed by length-prefixed chain id, delegator and txhash, followed by the
+// validator, so that records can be iterated unambiguously by zone, delegator and redemption.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateZones(store, cdc); err != nil {
+		return err
+	}
+	return migrateWithdrawalRecords(store, cdc)
+}
+
+type entry struct {
+	oldKey []byte
+	newKey []byte
+	value  []byte
+}
+
+// rekey replaces each entry's old key with its new key. Old and new keys share a prefix, so all entries
+// are collected before any are rewritten.
+func rekey(store sdk.KVStore, entries []entry) {
+	for _, e := range entries {
+		store.Delete(e.oldKey)
+	}
+	for _, e := range entries {
+		store.Set(e.newKey, e.value)
+	}
+}
+
+func migrateZones(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	entries := []entry{}
+
+	iterator := sdk.KVStorePrefixIterator(store, KeyPrefixZone)
+	for ; iterator.Valid(); iterator.Next() {
+		zone := types.Zone{}
+		if err := cdc.Unmarshal(iterator.Value(), &zone); err != nil {
+			iterator.Close()
+			return fmt.Errorf("unable to unmarshal zone at key %X: %w", iterator.Key(), err)
+		}
+		if !bytes.Equal(iterator.Key(), v1ZoneKey(zone.ChainId)) {
+			iterator.Close()
+			return fmt.Errorf("zone %s stored at unexpected key %X", zone.ChainId, iterator.Key())
+		}
+		entries = append(entries, entry{oldKey: iterator.Key(), newKey: ZoneKey(zone.ChainId), value: iterator.Value()})
+	}
+	iterator.Close()
+
+	rekey(store, entries)
+	return nil
+}
+
+func migrateWithdrawalRecords(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	entries := []entry{}
+
+	iterator := sdk.KVStorePrefixIterator(store, KeyPrefixWithdrawalRecord)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.WithdrawalRecord{}
+		if err := cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			iterator.Close()
+			return fmt.Errorf("unable to unmarshal withdrawal record at key %X: %w", iterator.Key(), err)
+		}
+		if !bytes.Equal(iterator.Key(), v1WithdrawalRecordKey(record.ChainId, record.Delegator, record.Txhash, record.Validator)) {
+			iterator.Close()
+			return fmt.Errorf("withdrawal record %s/%s/%s/%s stored at unexpected key %X", record.ChainId, record.Delegator, record.Txhash, record.Validator, iterator.Key())
+		}
+		entries = append(entries, entry{
+			oldKey: iterator.Key(),
+			newKey: WithdrawalRecordKey(record.ChainId, record.Delegator, record.Txhash, record.Validator),
+			value:  iterator.Value(),
+		})
+	}
+	iterator.Close()
+
+	rekey(store, entries)
+	return nil
+}
@@ -0,0 +1,139 @@
+package v2_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/ingenuity-build/quicksilver/x/interchainstaking/migrations/v2"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// v1State is the fixture of v1 store contents in testdata/v1_state.json. It includes a pair of chain ids
+// where one is a prefix of the other, which the v1 layout cannot distinguish when iterating.
+type v1State struct {
+	Zones             []json.RawMessage `json:"zones"`
+	WithdrawalRecords []json.RawMessage `json:"withdrawal_records"`
+}
+
+func loadFixtures(t *testing.T, cdc codec.Codec) ([]types.Zone, []types.WithdrawalRecord, []string) {
+	t.Helper()
+
+	bz, err := os.ReadFile("testdata/v1_state.json")
+	require.NoError(t, err)
+	state := v1State{}
+	require.NoError(t, json.Unmarshal(bz, &state))
+
+	zones := make([]types.Zone, len(state.Zones))
+	for i, raw := range state.Zones {
+		require.NoError(t, cdc.UnmarshalJSON(raw, &zones[i]))
+	}
+	records := make([]types.WithdrawalRecord, len(state.WithdrawalRecords))
+	for i, raw := range state.WithdrawalRecords {
+		require.NoError(t, cdc.UnmarshalJSON(raw, &records[i]))
+	}
+
+	bz, err = os.ReadFile("testdata/v2_keys.json")
+	require.NoError(t, err)
+	expected := struct {
+		Keys []string `json:"keys"`
+	}{}
+	require.NoError(t, json.Unmarshal(bz, &expected))
+
+	return zones, records, expected.Keys
+}
+
+// setupV1Store writes the fixtures to a store using the v1 key layout.
+func setupV1Store(t *testing.T, cdc codec.Codec, zones []types.Zone, records []types.WithdrawalRecord) (sdk.Context, sdk.StoreKey) {
+	t.Helper()
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	for i := range zones {
+		store.Set(append([]byte{0x01}, []byte(zones[i].ChainId)...), cdc.MustMarshal(&zones[i]))
+	}
+	for i := range records {
+		key := append([]byte{0x05}, []byte(records[i].ChainId+records[i].Delegator+records[i].Txhash+records[i].Validator)...)
+		store.Set(key, cdc.MustMarshal(&records[i]))
+	}
+
+	return ctx, storeKey
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	zones, records, expectedKeys := loadFixtures(t, cdc)
+	ctx, storeKey := setupV1Store(t, cdc, zones, records)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	// the store holds exactly the expected v2 keys.
+	store := ctx.KVStore(storeKey)
+	keys := []string{}
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, hex.EncodeToString(iterator.Key()))
+	}
+	iterator.Close()
+	sort.Strings(keys)
+	require.Equal(t, expectedKeys, keys)
+
+	// values are unchanged and found at their v2 keys.
+	for _, zone := range zones {
+		zone := zone
+		require.Equal(t, cdc.MustMarshal(&zone), store.Get(v2.ZoneKey(zone.ChainId)))
+	}
+	for _, record := range records {
+		record := record
+		require.Equal(t, cdc.MustMarshal(&record), store.Get(v2.WithdrawalRecordKey(record.ChainId, record.Delegator, record.Txhash, record.Validator)))
+	}
+
+	// withdrawal records are iterable by zone without matching a zone whose chain id shares a prefix.
+	iterator = sdk.KVStorePrefixIterator(store, types.GetZoneWithdrawalRecordsKey("cosmoshub-4"))
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.WithdrawalRecord{}
+		cdc.MustUnmarshal(iterator.Value(), &record)
+		require.Equal(t, "cosmoshub-4", record.ChainId)
+		count++
+	}
+	iterator.Close()
+	require.Equal(t, 3, count)
+}
+
+func TestMigrateStoreMatchesCurrentKeys(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	zones, records, _ := loadFixtures(t, cdc)
+
+	for _, zone := range zones {
+		require.Equal(t, types.GetZoneKey(zone.ChainId), v2.ZoneKey(zone.ChainId))
+	}
+	for _, record := range records {
+		require.Equal(t,
+			types.GetWithdrawalRecordKey(record.ChainId, record.Delegator, record.Txhash, record.Validator),
+			v2.WithdrawalRecordKey(record.ChainId, record.Delegator, record.Txhash, record.Validator),
+		)
+	}
+}
+
+func TestMigrateStoreUnexpectedKey(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	zones, _, _ := loadFixtures(t, cdc)
+	ctx, storeKey := setupV1Store(t, cdc, nil, nil)
+
+	// a zone stored under a key that does not match its chain id cannot be safely rekeyed.
+	ctx.KVStore(storeKey).Set(append([]byte{0x01}, []byte("other-1")...), cdc.MustMarshal(&zones[0]))
+
+	require.ErrorContains(t, v2.MigrateStore(ctx, storeKey, cdc), "unexpected key")
+}
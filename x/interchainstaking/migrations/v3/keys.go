@@ -0,0 +1,28 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Key layouts are pinned here, rather than taken from the types package, so that the migration
+// remains correct if the current layout changes again.
+
+var (
+	KeyPrefixZone             = []byte{0x01}
+	KeyPrefixAccountZoneIndex = []byte{0x0e}
+	KeyPrefixDenomZoneIndex   = []byte{0x0f}
+)
+
+// AccountZoneIndexKey returns the v3 store key of an account index entry:
+// prefix | len(address) | address | len(chain id) | chain id.
+func AccountZoneIndexKey(accountAddress string, chainID string) []byte {
+	key := append(KeyPrefixAccountZoneIndex, address.MustLengthPrefix([]byte(accountAddress))...)
+	return append(key, address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// DenomZoneIndexKey returns the v3 store key of a denom index entry:
+// prefix | len(denom) | denom | len(chain id) | chain id.
+func DenomZoneIndexKey(denom string, chainID string) []byte {
+	key := append(KeyPrefixDenomZoneIndex, address.MustLengthPrefix([]byte(denom))...)
+	return append(key, address.MustLengthPrefix([]byte(chainID))...)
+}
@@ -0,0 +1,42 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migration includes:
+//
+// - Zones are indexed by each of their interchain account addresses, so that the zone owning an
+// account can be found without iterating all zones.
+// - Zones are indexed by their local denom, so that the zone of a qAsset can be found without
+// iterating all zones.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	zones := []types.Zone{}
+	iterator := sdk.KVStorePrefixIterator(store, KeyPrefixZone)
+	for ; iterator.Valid(); iterator.Next() {
+		zone := types.Zone{}
+		if err := cdc.Unmarshal(iterator.Value(), &zone); err != nil {
+			iterator.Close()
+			return fmt.Errorf("unable to unmarshal zone at key %X: %w", iterator.Key(), err)
+		}
+		zones = append(zones, zone)
+	}
+	iterator.Close()
+
+	for _, zone := range zones {
+		for _, accountAddress := range zone.GetAccountAddresses() {
+			store.Set(AccountZoneIndexKey(accountAddress, zone.ChainId), []byte(zone.ChainId))
+		}
+		if zone.LocalDenom != "" {
+			store.Set(DenomZoneIndexKey(zone.LocalDenom, zone.ChainId), []byte(zone.ChainId))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/ingenuity-build/quicksilver/x/interchainstaking/migrations/v2"
+	v3 "github.com/ingenuity-build/quicksilver/x/interchainstaking/migrations/v3"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func testZones() []types.Zone {
+	return []types.Zone{
+		{
+			ChainId:            "cosmoshub-4",
+			ConnectionId:       "connection-0",
+			LocalDenom:         "uqatom",
+			BaseDenom:          "uatom",
+			DepositAddress:     &types.ICAAccount{Address: "cosmos1deposit", PortName: "cosmoshub-4.deposit"},
+			WithdrawalAddress:  &types.ICAAccount{Address: "cosmos1withdrawal", PortName: "cosmoshub-4.withdrawal"},
+			PerformanceAddress: &types.ICAAccount{Address: "cosmos1performance", PortName: "cosmoshub-4.performance"},
+			DelegationAddresses: []*types.ICAAccount{
+				{Address: "cosmos1delegate0", PortName: "cosmoshub-4.delegate.0"},
+				{Address: "cosmos1delegate1", PortName: "cosmoshub-4.delegate.1"},
+			},
+		},
+		{
+			// a zone whose accounts are not yet registered.
+			ChainId:      "osmosis-1",
+			ConnectionId: "connection-1",
+			LocalDenom:   "uqosmo",
+			BaseDenom:    "uosmo",
+		},
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	zones := testZones()
+	for i := range zones {
+		store.Set(v2.ZoneKey(zones[i].ChainId), cdc.MustMarshal(&zones[i]))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	for _, zone := range zones {
+		zone := zone
+		// zones are unchanged.
+		require.Equal(t, cdc.MustMarshal(&zone), store.Get(v2.ZoneKey(zone.ChainId)))
+
+		// every account and the local denom of the zone are indexed, at the current key layout.
+		for _, accountAddress := range zone.GetAccountAddresses() {
+			require.Equal(t, types.GetAccountZoneIndexKey(accountAddress, zone.ChainId), v3.AccountZoneIndexKey(accountAddress, zone.ChainId))
+			require.Equal(t, []byte(zone.ChainId), store.Get(v3.AccountZoneIndexKey(accountAddress, zone.ChainId)))
+		}
+		require.Equal(t, types.GetDenomZoneIndexKey(zone.LocalDenom, zone.ChainId), v3.DenomZoneIndexKey(zone.LocalDenom, zone.ChainId))
+		require.Equal(t, []byte(zone.ChainId), store.Get(v3.DenomZoneIndexKey(zone.LocalDenom, zone.ChainId)))
+	}
+
+	// no other account index entries are written.
+	count := 0
+	iterator := sdk.KVStorePrefixIterator(store, v3.KeyPrefixAccountZoneIndex)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	iterator.Close()
+	require.Equal(t, 5, count)
+}
@@ -0,0 +1,117 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// Simulation parameter constants
+const (
+	DelegationAccountCount = "delegation_account_count"
+	DepositInterval        = "deposit_interval"
+	ValidatorSetInterval   = "validatorset_interval"
+	CommissionRate         = "commission_rate"
+	MaxCuratorWeight       = "max_curator_weight"
+	InstantRedemptionFee   = "instant_redemption_fee"
+	QueryBounty            = "query_bounty"
+)
+
+// GenDelegationAccountCount randomized DelegationAccountCount. Each delegation account is an
+// interchain account on the mock host, so the count is kept small.
+func GenDelegationAccountCount(r *rand.Rand) uint64 {
+	return uint64(2 + r.Intn(9))
+}
+
+// GenDepositInterval randomized DepositInterval
+func GenDepositInterval(r *rand.Rand) uint64 {
+	return uint64(10 + r.Intn(41))
+}
+
+// GenValidatorSetInterval randomized ValidatorSetInterval
+func GenValidatorSetInterval(r *rand.Rand) uint64 {
+	return uint64(20 + r.Intn(181))
+}
+
+// GenCommissionRate randomized CommissionRate
+func GenCommissionRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// GenMaxCuratorWeight randomized MaxCuratorWeight
+func GenMaxCuratorWeight(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(5+r.Intn(46)), 2)
+}
+
+// GenInstantRedemptionFee randomized InstantRedemptionFee
+func GenInstantRedemptionFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(21)), 3)
+}
+
+// GenQueryBounty randomized QueryBounty
+func GenQueryBounty(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(r.Intn(1001)))
+}
+
+// RandomizedGenState generates a random GenesisState for interchainstaking. No zones are
+// registered at genesis; the mock host zone is registered by the first simulated operation.
+func RandomizedGenState(simState *module.SimulationState) {
+	var delegationAccountCount uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DelegationAccountCount, &delegationAccountCount, simState.Rand,
+		func(r *rand.Rand) { delegationAccountCount = GenDelegationAccountCount(r) },
+	)
+
+	var depositInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositInterval, &depositInterval, simState.Rand,
+		func(r *rand.Rand) { depositInterval = GenDepositInterval(r) },
+	)
+
+	var validatorSetInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ValidatorSetInterval, &validatorSetInterval, simState.Rand,
+		func(r *rand.Rand) { validatorSetInterval = GenValidatorSetInterval(r) },
+	)
+
+	var commissionRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CommissionRate, &commissionRate, simState.Rand,
+		func(r *rand.Rand) { commissionRate = GenCommissionRate(r) },
+	)
+
+	var maxCuratorWeight sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCuratorWeight, &maxCuratorWeight, simState.Rand,
+		func(r *rand.Rand) { maxCuratorWeight = GenMaxCuratorWeight(r) },
+	)
+
+	var instantRedemptionFee sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InstantRedemptionFee, &instantRedemptionFee, simState.Rand,
+		func(r *rand.Rand) { instantRedemptionFee = GenInstantRedemptionFee(r) },
+	)
+
+	var queryBounty sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, QueryBounty, &queryBounty, simState.Rand,
+		func(r *rand.Rand) { queryBounty = GenQueryBounty(r) },
+	)
+
+	params := types.NewParams(delegationAccountCount, depositInterval, validatorSetInterval, commissionRate, maxCuratorWeight, instantRedemptionFee, queryBounty)
+	icsGenesis := types.NewGenesisState(params, []types.Zone{})
+
+	bz, err := json.MarshalIndent(&icsGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated interchainstaking parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(icsGenesis)
+}
@@ -0,0 +1,772 @@
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	// MockHostChainID is the chain id of the simulated host chain.
+	MockHostChainID = "mockhost-1"
+	// MockHostAccountPrefix is the bech32 account prefix of the simulated host chain.
+	MockHostAccountPrefix = "cosmos"
+	// MockHostBaseDenom is the staking denom of the simulated host chain.
+	MockHostBaseDenom = "uatom"
+	// MockHostLocalDenom is the qAsset denom of the simulated host chain.
+	MockHostLocalDenom = "uqatom"
+	// MockHostUnbondingTime is the unbonding period of the simulated host chain.
+	MockHostUnbondingTime = 21 * 24 * time.Hour
+)
+
+// MockHostAllowMessages are the messages the mock host allows interchain accounts to execute.
+var MockHostAllowMessages = []string{
+	"/cosmos.bank.v1beta1.MsgSend",
+	"/cosmos.bank.v1beta1.MsgMultiSend",
+	"/cosmos.staking.v1beta1.MsgDelegate",
+	"/cosmos.staking.v1beta1.MsgUndelegate",
+	"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+	"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress",
+	"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+	"/cosmos.gov.v1beta1.MsgVoteWeighted",
+	"/ibc.applications.transfer.v1.MsgTransfer",
+}
+
+// MockHost is an in-process stand-in for a host chain. It owns the light client, connection and
+// interchain account channels of a single zone, answers interchain queries from its own staking
+// state and acknowledges interchain account packets by applying their messages to that state.
+//
+// Queries are answered directly through the interchainstaking callbacks, as the mock host cannot
+// produce proofs. Only packets sent by simulated operations are acknowledged; packets sent from
+// BeginBlock or EndBlock are never observed by the simulator and remain unacknowledged.
+type MockHost struct {
+	k            keeper.Keeper
+	ConnectionID string
+	state        hostState
+	packets      []channeltypes.Packet
+	// rejected holds the type URLs of the messages the host fails to execute.
+	rejected map[string]bool
+}
+
+// hostState is the staking state of the mock host.
+type hostState struct {
+	validators  []stakingtypes.Validator
+	delegations map[string]map[string]sdk.Int
+	// unbondings holds the completion times of the unbonding entries of each delegator/validator pair.
+	unbondings map[string][]time.Time
+}
+
+// NewMockHost returns a MockHost for the given keeper. The zone is registered lazily by Setup.
+func NewMockHost(k keeper.Keeper) *MockHost {
+	return &MockHost{k: k, state: hostState{delegations: map[string]map[string]sdk.Int{}, unbondings: map[string][]time.Time{}}, rejected: map[string]bool{}}
+}
+
+// RejectMessages makes the host fail to execute messages of the given type URLs, so that packets containing them
+// are acknowledged with an error.
+func (h *MockHost) RejectMessages(typeURLs ...string) {
+	for _, typeURL := range typeURLs {
+		h.rejected[typeURL] = true
+	}
+}
+
+// AcceptMessages reverses RejectMessages for the given type URLs.
+func (h *MockHost) AcceptMessages(typeURLs ...string) {
+	for _, typeURL := range typeURLs {
+		delete(h.rejected, typeURL)
+	}
+}
+
+// Setup registers the mock host zone, if not already registered, and returns it. The light client,
+// connection and interchain account channels are written directly to the IBC stores as if the
+// handshakes had completed, and the registration preflight queries are answered.
+func (h *MockHost) Setup(r *rand.Rand, ctx sdk.Context) (types.Zone, error) {
+	if zone, found := h.k.GetZone(ctx, MockHostChainID); found {
+		return zone, nil
+	}
+
+	if err := h.Connect(r, ctx); err != nil {
+		return types.Zone{}, err
+	}
+
+	proposal := types.NewRegisterZoneProposal("mock host", "register the simulated host chain", h.ConnectionID, MockHostBaseDenom, MockHostLocalDenom, MockHostAccountPrefix, false, false)
+	if err := keeper.HandleRegisterZoneProposal(ctx, h.k, proposal); err != nil {
+		return types.Zone{}, err
+	}
+
+	// the deposit account is opened, and the preflight queries answered, before the zone is activated and
+	// its remaining accounts are opened.
+	if err := h.CompleteHandshakes(ctx); err != nil {
+		return types.Zone{}, err
+	}
+	if err := h.AnswerPreflightQueries(ctx); err != nil {
+		return types.Zone{}, err
+	}
+	if err := h.CompleteHandshakes(ctx); err != nil {
+		return types.Zone{}, err
+	}
+
+	zone, found := h.k.GetZone(ctx, MockHostChainID)
+	if !found {
+		return types.Zone{}, fmt.Errorf("mock host zone not registered")
+	}
+	if !zone.IsActive() {
+		return types.Zone{}, fmt.Errorf("mock host zone not activated")
+	}
+	return zone, nil
+}
+
+// Connect creates the light client and connection of the mock host, without registering its zone.
+func (h *MockHost) Connect(r *rand.Rand, ctx sdk.Context) error {
+	h.state.validators = randomValidators(r)
+
+	clientID := h.k.IBCKeeper.ClientKeeper.GenerateClientIdentifier(ctx, "07-tendermint")
+	height := clienttypes.NewHeight(1, 1)
+	clientState := ibctmtypes.NewClientState(
+		MockHostChainID, ibctmtypes.DefaultTrustLevel, 100*365*24*time.Hour, 100*365*24*time.Hour+MockHostUnbondingTime, 10*time.Second,
+		height, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"}, false, false,
+	)
+	h.k.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, clientState)
+	consensusState := ibctmtypes.NewConsensusState(ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), []byte("mockhost-validators"))
+	h.k.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, height, consensusState)
+
+	h.ConnectionID = h.k.IBCKeeper.ConnectionKeeper.GenerateConnectionIdentifier(ctx)
+	connection := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, clientID, mockHostCounterparty, connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0)
+	h.k.IBCKeeper.ConnectionKeeper.SetConnection(ctx, h.ConnectionID, connection)
+	return nil
+}
+
+// UpdateClient advances the light client of the mock host to the next height, with a consensus state at the block
+// time of the context.
+func (h *MockHost) UpdateClient(ctx sdk.Context) error {
+	connection, found := h.k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, h.ConnectionID)
+	if !found {
+		return fmt.Errorf("mock host connection not found")
+	}
+	clientState, found := h.k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return fmt.Errorf("mock host client not found")
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return fmt.Errorf("unexpected mock host client type %T", clientState)
+	}
+	tmClientState.LatestHeight = tmClientState.LatestHeight.Increment().(clienttypes.Height)
+	h.k.IBCKeeper.ClientKeeper.SetClientState(ctx, connection.ClientId, tmClientState)
+	consensusState := ibctmtypes.NewConsensusState(ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), []byte("mockhost-validators"))
+	h.k.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, connection.ClientId, tmClientState.LatestHeight, consensusState)
+	return nil
+}
+
+// mockHostCounterparty is the host end of the mock host connection.
+var mockHostCounterparty = connectiontypes.NewCounterparty("07-tendermint-0", "connection-0", commitmenttypes.NewMerklePrefix([]byte("ibc")))
+
+// CompleteHandshakes completes the handshake of each interchain account channel opened on the mock host connection.
+func (h *MockHost) CompleteHandshakes(ctx sdk.Context) error {
+	moduleAddress := authtypes.NewModuleAddress(icatypes.ModuleName)
+	for i, channel := range h.k.IBCKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		if channel.State != channeltypes.INIT || len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != h.ConnectionID {
+			continue
+		}
+		address, err := bech32.ConvertAndEncode(MockHostAccountPrefix, icatypes.GenerateAddress(moduleAddress, h.ConnectionID, channel.PortId))
+		if err != nil {
+			return err
+		}
+		metadata := icatypes.NewMetadata(icatypes.Version, h.ConnectionID, mockHostCounterparty.ConnectionId, address, icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+		version := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+		if err := h.k.ICAControllerKeeper.OnChanOpenAck(ctx, channel.PortId, channel.ChannelId, version); err != nil {
+			return err
+		}
+		h.k.IBCKeeper.ChannelKeeper.SetChannel(ctx, channel.PortId, channel.ChannelId, channeltypes.NewChannel(
+			channeltypes.OPEN, channel.Ordering, channeltypes.NewCounterparty(icatypes.PortID, "channel-"+strconv.Itoa(i)), channel.ConnectionHops, version,
+		))
+		if err := h.k.HandleChannelOpenAck(ctx, channel.PortId, h.ConnectionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delegate records a delegation of amount tokens by delegator to validator on the host.
+func (h *MockHost) Delegate(delegator string, validator string, amount sdk.Int) error {
+	return h.state.delegate(delegator, validator, amount)
+}
+
+// GetDelegation returns the tokens delegated by delegator to validator on the host.
+func (h *MockHost) GetDelegation(delegator string, validator string) sdk.Int {
+	if amount, found := h.state.delegations[delegator][validator]; found {
+		return amount
+	}
+	return sdk.ZeroInt()
+}
+
+// CapturePackets queues the interchain account packets found in events for acknowledgement.
+func (h *MockHost) CapturePackets(events []abci.Event) error {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		if attributes[channeltypes.AttributeKeyConnection] != h.ConnectionID {
+			continue
+		}
+		data, err := hex.DecodeString(attributes[channeltypes.AttributeKeyDataHex])
+		if err != nil {
+			return err
+		}
+		sequence, err := strconv.ParseUint(attributes[channeltypes.AttributeKeySequence], 10, 64)
+		if err != nil {
+			return err
+		}
+		timeoutTimestamp, err := strconv.ParseUint(attributes[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64)
+		if err != nil {
+			return err
+		}
+		h.packets = append(h.packets, channeltypes.NewPacket(
+			data,
+			sequence,
+			attributes[channeltypes.AttributeKeySrcPort],
+			attributes[channeltypes.AttributeKeySrcChannel],
+			attributes[channeltypes.AttributeKeyDstPort],
+			attributes[channeltypes.AttributeKeyDstChannel],
+			clienttypes.ZeroHeight(),
+			timeoutTimestamp,
+		))
+	}
+	return nil
+}
+
+// PendingPackets returns the number of packets awaiting acknowledgement.
+func (h *MockHost) PendingPackets() int {
+	return len(h.packets)
+}
+
+// AnswerQueries answers every emitted query for the mock host that has not been answered since its emission,
+// and returns the number of queries answered. Query types the mock host does not serve are left unanswered.
+func (h *MockHost) AnswerQueries(ctx sdk.Context) (int, error) {
+	callbacks := h.k.CallbackHandler().RegisterCallbacks()
+	answered := 0
+
+	for _, q := range h.k.ICQKeeper.AllQueries(ctx) {
+		if q.ChainId != MockHostChainID || !callbacks.Has(q.CallbackId) {
+			continue
+		}
+		if q.LastEmission.IsNil() || !q.LastEmission.GT(q.LastHeight) {
+			continue
+		}
+
+		ok, err := h.answer(ctx, callbacks, q)
+		if err != nil {
+			return answered, err
+		}
+		if ok {
+			answered++
+		}
+	}
+
+	return answered, nil
+}
+
+// answer delivers the mock host's response to the query to interchainstaking, and returns false if the query
+// type is not served.
+func (h *MockHost) answer(ctx sdk.Context, callbacks icqtypes.QueryCallbacks, q icqtypes.Query) (bool, error) {
+	result, ok, err := h.respond(ctx, q)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// mirror interchainquery SubmitQueryResponse, less proof validation.
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	noDelete := false
+	if err := callbacks.Call(cacheCtx, q.CallbackId, result, q); err != nil {
+		if !errors.Is(err, icqtypes.ErrSucceededNoDelete) {
+			return false, fmt.Errorf("callback %s for query %s failed: %w", q.CallbackId, q.QueryType, err)
+		}
+		noDelete = true
+	}
+	write()
+
+	if q.Period.IsNegative() && !noDelete {
+		h.k.ICQKeeper.DeleteQuery(ctx, q.Id)
+	} else {
+		q.LastHeight = sdk.NewInt(ctx.BlockHeight())
+		q.Deadline = 0
+		q.Retries = 0
+		h.k.ICQKeeper.SetQuery(ctx, q)
+	}
+
+	events := cacheCtx.EventManager().ABCIEvents()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return true, h.CapturePackets(events)
+}
+
+// AnswerPreflightQueries answers the registration preflight queries of the mock host zone, whether or not they
+// have been emitted.
+func (h *MockHost) AnswerPreflightQueries(ctx sdk.Context) error {
+	callbacks := h.k.CallbackHandler().RegisterCallbacks()
+	for _, q := range h.k.ICQKeeper.AllQueries(ctx) {
+		if q.ChainId != MockHostChainID || q.QueryType != "store/params/key" {
+			continue
+		}
+		// a failed check removes the outstanding preflight queries.
+		if _, found := h.k.ICQKeeper.GetQuery(ctx, q.Id); !found {
+			continue
+		}
+		if _, err := h.answer(ctx, callbacks, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AcknowledgePackets executes the messages of every queued packet against the host state and delivers the
+// resulting acknowledgements to interchainstaking. It returns the number of packets acknowledged.
+func (h *MockHost) AcknowledgePackets(ctx sdk.Context) (int, error) {
+	packets := h.packets
+	h.packets = nil
+
+	for _, packet := range packets {
+		acknowledgement, err := h.execute(ctx, packet)
+		if err != nil {
+			return 0, err
+		}
+
+		// mirror IBCModule.OnAcknowledgementPacket.
+		connectionID, _, err := h.k.IBCKeeper.ChannelKeeper.GetChannelConnection(ctx, packet.SourcePort, packet.SourceChannel)
+		if err != nil {
+			return 0, err
+		}
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		cacheCtx = cacheCtx.WithContext(context.WithValue(cacheCtx.Context(), utils.ContextKey("connectionID"), connectionID))
+
+		if err := h.k.HandleAcknowledgement(cacheCtx, packet, acknowledgement); err != nil {
+			// the relayer's MsgAcknowledgement would fail and its state changes be discarded.
+			h.k.Logger(ctx).Error("mock host acknowledgement failed", "port", packet.SourcePort, "sequence", packet.Sequence, "error", err)
+			continue
+		}
+		write()
+
+		events := cacheCtx.EventManager().ABCIEvents()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		if err := h.CapturePackets(events); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(packets), nil
+}
+
+// TimeoutPackets times out every queued packet, without executing its messages, and delivers the timeouts to
+// interchainstaking. It returns the number of packets timed out.
+func (h *MockHost) TimeoutPackets(ctx sdk.Context) (int, error) {
+	packets := h.packets
+	h.packets = nil
+
+	for _, packet := range packets {
+		// mirror IBCModule.OnTimeoutPacket.
+		connectionID, _, err := h.k.IBCKeeper.ChannelKeeper.GetChannelConnection(ctx, packet.SourcePort, packet.SourceChannel)
+		if err != nil {
+			return 0, err
+		}
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		cacheCtx = cacheCtx.WithContext(context.WithValue(cacheCtx.Context(), utils.ContextKey("connectionID"), connectionID))
+
+		if err := h.k.HandleTimeout(cacheCtx, packet); err != nil {
+			h.k.Logger(ctx).Error("mock host timeout failed", "port", packet.SourcePort, "sequence", packet.Sequence, "error", err)
+			continue
+		}
+		write()
+
+		events := cacheCtx.EventManager().ABCIEvents()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		if err := h.CapturePackets(events); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(packets), nil
+}
+
+// execute applies the messages of an interchain account packet to the host state, atomically, and returns
+// the acknowledgement the host would write.
+func (h *MockHost) execute(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		return nil, err
+	}
+	msgs, err := icatypes.DeserializeCosmosTx(h.k.GetCodec(), packetData.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	state := h.state.clone()
+	txMsgData := &sdk.TxMsgData{Data: make([]*sdk.MsgData, 0, len(msgs))}
+	for _, msg := range msgs {
+		if h.rejected[sdk.MsgTypeURL(msg)] {
+			return channeltypes.NewErrorAcknowledgement(fmt.Sprintf("mock host rejected %s", sdk.MsgTypeURL(msg))).Acknowledgement(), nil
+		}
+		response, err := state.deliver(ctx, msg)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err.Error()).Acknowledgement(), nil
+		}
+		data, err := proto.Marshal(response)
+		if err != nil {
+			return nil, err
+		}
+		txMsgData.Data = append(txMsgData.Data, &sdk.MsgData{MsgType: sdk.MsgTypeURL(msg), Data: data})
+	}
+	h.state = state
+
+	result, err := proto.Marshal(txMsgData)
+	if err != nil {
+		return nil, err
+	}
+	return channeltypes.NewResultAcknowledgement(result).Acknowledgement(), nil
+}
+
+// respond returns the host's response to the query, and false if the query type is not served.
+func (h *MockHost) respond(ctx sdk.Context, q icqtypes.Query) ([]byte, bool, error) {
+	cdc := h.k.GetCodec()
+
+	switch q.QueryType {
+	case "cosmos.staking.v1beta1.Query/DelegatorDelegations":
+		request := stakingtypes.QueryDelegatorDelegationsRequest{}
+		if err := cdc.Unmarshal(q.Request, &request); err != nil {
+			return nil, false, err
+		}
+		response := stakingtypes.QueryDelegatorDelegationsResponse{Pagination: &query.PageResponse{}}
+		for _, validator := range sortedKeys(h.state.delegations[request.DelegatorAddr]) {
+			amount := h.state.delegations[request.DelegatorAddr][validator]
+			// addresses are host bech32 strings, so the response is not built with NewDelegationResp.
+			response.DelegationResponses = append(response.DelegationResponses, stakingtypes.DelegationResponse{
+				Delegation: stakingtypes.Delegation{DelegatorAddress: request.DelegatorAddr, ValidatorAddress: validator, Shares: amount.ToDec()},
+				Balance:    sdk.NewCoin(MockHostBaseDenom, amount),
+			})
+		}
+		bz, err := cdc.Marshal(&response)
+		return bz, true, err
+
+	case "store/staking/subspace":
+		if len(q.Request) == 0 {
+			return nil, false, nil
+		}
+		pairs := kv.Pairs{}
+		switch q.Request[0] {
+		case stakingtypes.ValidatorsKey[0]:
+			for _, validator := range h.state.validators {
+				_, valAddr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+				if err != nil {
+					return nil, false, err
+				}
+				bz, err := cdc.Marshal(&validator)
+				if err != nil {
+					return nil, false, err
+				}
+				pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: stakingtypes.GetValidatorKey(valAddr), Value: bz})
+			}
+		case stakingtypes.DelegationKey[0]:
+			delAddr, err := types.ParseStakingDelegationsKey(q.Request)
+			if err != nil {
+				return nil, false, err
+			}
+			delegator, err := bech32.ConvertAndEncode(MockHostAccountPrefix, delAddr)
+			if err != nil {
+				return nil, false, err
+			}
+			for _, validator := range sortedKeys(h.state.delegations[delegator]) {
+				amount := h.state.delegations[delegator][validator]
+				if amount.IsZero() {
+					continue
+				}
+				_, valAddr, err := bech32.DecodeAndConvert(validator)
+				if err != nil {
+					return nil, false, err
+				}
+				delegation := stakingtypes.Delegation{DelegatorAddress: delegator, ValidatorAddress: validator, Shares: amount.ToDec()}
+				bz, err := cdc.Marshal(&delegation)
+				if err != nil {
+					return nil, false, err
+				}
+				pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: stakingtypes.GetDelegationKey(delAddr, valAddr), Value: bz})
+			}
+		default:
+			return nil, false, nil
+		}
+		// pairs are returned in store order.
+		sort.Slice(pairs.Pairs, func(i, j int) bool { return bytes.Compare(pairs.Pairs[i].Key, pairs.Pairs[j].Key) < 0 })
+		bz, err := pairs.Marshal()
+		return bz, true, err
+
+	case "store/staking/key":
+		if len(q.Request) == 0 {
+			return nil, false, nil
+		}
+		switch q.Request[0] {
+		case stakingtypes.ValidatorsKey[0]:
+			valoper, err := bech32.ConvertAndEncode(MockHostAccountPrefix+"valoper", stakingtypes.AddressFromValidatorsKey(q.Request))
+			if err != nil {
+				return nil, false, err
+			}
+			for _, validator := range h.state.validators {
+				if validator.OperatorAddress == valoper {
+					bz, err := cdc.Marshal(&validator)
+					return bz, true, err
+				}
+			}
+			// proof of non-membership.
+			return []byte{}, true, nil
+		case stakingtypes.DelegationKey[0]:
+			delAddr, valAddr, err := types.ParseStakingDelegationKey(q.Request)
+			if err != nil {
+				return nil, false, err
+			}
+			delegator, err := bech32.ConvertAndEncode(MockHostAccountPrefix, delAddr)
+			if err != nil {
+				return nil, false, err
+			}
+			validator, err := bech32.ConvertAndEncode(MockHostAccountPrefix+"valoper", valAddr)
+			if err != nil {
+				return nil, false, err
+			}
+			amount := h.GetDelegation(delegator, validator)
+			if amount.IsZero() {
+				// proof of non-membership.
+				return []byte{}, true, nil
+			}
+			delegation := stakingtypes.Delegation{DelegatorAddress: delegator, ValidatorAddress: validator, Shares: amount.ToDec()}
+			bz, err := cdc.Marshal(&delegation)
+			return bz, true, err
+		case stakingtypes.UnbondingDelegationKey[0]:
+			delAddr, valAddr, err := types.ParseStakingUnbondingDelegationKey(q.Request)
+			if err != nil {
+				return nil, false, err
+			}
+			delegator, err := bech32.ConvertAndEncode(MockHostAccountPrefix, delAddr)
+			if err != nil {
+				return nil, false, err
+			}
+			validator, err := bech32.ConvertAndEncode(MockHostAccountPrefix+"valoper", valAddr)
+			if err != nil {
+				return nil, false, err
+			}
+			entries := h.state.unbondingEntries(ctx, delegator, validator)
+			if len(entries) == 0 {
+				// proof of non-membership.
+				return []byte{}, true, nil
+			}
+			ubd := stakingtypes.UnbondingDelegation{DelegatorAddress: delegator, ValidatorAddress: validator}
+			for _, completion := range entries {
+				ubd.Entries = append(ubd.Entries, stakingtypes.UnbondingDelegationEntry{CompletionTime: completion, InitialBalance: sdk.ZeroInt(), Balance: sdk.ZeroInt()})
+			}
+			bz, err := cdc.Marshal(&ubd)
+			return bz, true, err
+		}
+		return nil, false, nil
+
+	case "store/params/key":
+		switch string(q.Request) {
+		case string(types.HostBondDenomKey):
+			bz, err := json.Marshal(MockHostBaseDenom)
+			return bz, true, err
+		case string(types.HostAllowMessagesKey):
+			bz, err := json.Marshal(MockHostAllowMessages)
+			return bz, true, err
+		}
+		// proof of non-membership.
+		return []byte{}, true, nil
+
+	case "cosmos.bank.v1beta1.Query/AllBalances":
+		// the mock host does not track account balances.
+		bz, err := cdc.Marshal(&banktypes.QueryAllBalancesResponse{Pagination: &query.PageResponse{}})
+		return bz, true, err
+
+	case "cosmos.gov.v1beta1.Query/Proposals":
+		bz, err := cdc.Marshal(&govtypes.QueryProposalsResponse{Pagination: &query.PageResponse{}})
+		return bz, true, err
+	}
+
+	return nil, false, nil
+}
+
+// clone returns a deep copy of the host state.
+func (s hostState) clone() hostState {
+	out := hostState{
+		validators:  make([]stakingtypes.Validator, len(s.validators)),
+		delegations: make(map[string]map[string]sdk.Int, len(s.delegations)),
+		unbondings:  make(map[string][]time.Time, len(s.unbondings)),
+	}
+	copy(out.validators, s.validators)
+	for pair, entries := range s.unbondings {
+		out.unbondings[pair] = append([]time.Time{}, entries...)
+	}
+	for delegator, delegations := range s.delegations {
+		out.delegations[delegator] = make(map[string]sdk.Int, len(delegations))
+		for validator, amount := range delegations {
+			out.delegations[delegator][validator] = amount
+		}
+	}
+	return out
+}
+
+// deliver applies a single interchain account message to the host state and returns its response.
+func (s *hostState) deliver(ctx sdk.Context, msg sdk.Msg) (proto.Message, error) {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		if err := s.delegate(msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgDelegateResponse{}, nil
+	case *stakingtypes.MsgUndelegate:
+		entries := s.unbondingEntries(ctx, msg.DelegatorAddress, msg.ValidatorAddress)
+		if uint32(len(entries)) >= stakingtypes.DefaultMaxEntries {
+			return nil, stakingtypes.ErrMaxUnbondingDelegationEntries
+		}
+		if err := s.delegate(msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount.Neg()); err != nil {
+			return nil, err
+		}
+		completion := ctx.BlockTime().Add(MockHostUnbondingTime)
+		s.unbondings[unbondingPair(msg.DelegatorAddress, msg.ValidatorAddress)] = append(entries, completion)
+		return &stakingtypes.MsgUndelegateResponse{CompletionTime: completion}, nil
+	case *banktypes.MsgSend:
+		return &banktypes.MsgSendResponse{}, nil
+	case *ibctransfertypes.MsgTransfer:
+		return &ibctransfertypes.MsgTransferResponse{}, nil
+	case *distrtypes.MsgSetWithdrawAddress:
+		return &distrtypes.MsgSetWithdrawAddressResponse{}, nil
+	case *govtypes.MsgVoteWeighted:
+		return &govtypes.MsgVoteWeightedResponse{}, nil
+	}
+	return nil, fmt.Errorf("mock host does not support %s", sdk.MsgTypeURL(msg))
+}
+
+// unbondingPair returns the key of a delegator/validator pair in hostState.unbondings.
+func unbondingPair(delegator string, validator string) string {
+	return delegator + "/" + validator
+}
+
+// unbondingEntries returns the completion times of the unbonding entries of the delegator/validator pair that
+// have not yet matured, pruning any that have.
+func (s *hostState) unbondingEntries(ctx sdk.Context, delegator string, validator string) []time.Time {
+	pair := unbondingPair(delegator, validator)
+	entries := []time.Time{}
+	for _, completion := range s.unbondings[pair] {
+		if completion.After(ctx.BlockTime()) {
+			entries = append(entries, completion)
+		}
+	}
+	if len(entries) == 0 {
+		delete(s.unbondings, pair)
+	} else {
+		s.unbondings[pair] = entries
+	}
+	return entries
+}
+
+// delegate adds amount, which may be negative, to the delegation of delegator to validator.
+func (s *hostState) delegate(delegator string, validator string, amount sdk.Int) error {
+	idx := -1
+	for i, v := range s.validators {
+		if v.OperatorAddress == validator {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return fmt.Errorf("validator %s does not exist", validator)
+	}
+
+	current, found := s.delegations[delegator][validator]
+	if !found {
+		current = sdk.ZeroInt()
+	}
+	updated := current.Add(amount)
+	if updated.IsNegative() {
+		return fmt.Errorf("insufficient delegation of %s to %s: %s < %s", delegator, validator, current, amount.Neg())
+	}
+
+	if _, found := s.delegations[delegator]; !found {
+		s.delegations[delegator] = map[string]sdk.Int{}
+	}
+	if updated.IsZero() {
+		delete(s.delegations[delegator], validator)
+	} else {
+		s.delegations[delegator][validator] = updated
+	}
+
+	// tokens and shares are kept equal; the mock host never slashes.
+	s.validators[idx].Tokens = s.validators[idx].Tokens.Add(amount)
+	s.validators[idx].DelegatorShares = s.validators[idx].Tokens.ToDec()
+	return nil
+}
+
+// randomValidators returns between 4 and 15 bonded validators with random tokens and commission.
+func randomValidators(r *rand.Rand) []stakingtypes.Validator {
+	n := 4 + r.Intn(12)
+	validators := make([]stakingtypes.Validator, 0, n)
+	for i := 0; i < n; i++ {
+		addr := make([]byte, 20)
+		r.Read(addr)
+		valoper, err := bech32.ConvertAndEncode(MockHostAccountPrefix+"valoper", addr)
+		if err != nil {
+			panic(err)
+		}
+		tokens := sdk.NewInt(1_000_000 + r.Int63n(1_000_000_000))
+		validators = append(validators, stakingtypes.Validator{
+			OperatorAddress:   valoper,
+			Status:            stakingtypes.Bonded,
+			Tokens:            tokens,
+			DelegatorShares:   tokens.ToDec(),
+			Commission:        stakingtypes.NewCommission(sdk.NewDecWithPrec(int64(r.Intn(21)), 2), sdk.OneDec(), sdk.NewDecWithPrec(1, 2)),
+			MinSelfDelegation: sdk.OneInt(),
+		})
+	}
+	sort.Slice(validators, func(i, j int) bool { return validators[i].OperatorAddress < validators[j].OperatorAddress })
+	return validators
+}
+
+func sortedKeys(m map[string]sdk.Int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
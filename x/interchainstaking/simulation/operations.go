@@ -0,0 +1,243 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSignalIntent        = "op_weight_msg_signal_intent"
+	OpWeightMsgRequestRedemption   = "op_weight_msg_request_redemption"
+	OpWeightMockHostDeposit        = "op_weight_mock_host_deposit"
+	OpWeightMockHostRelay          = "op_weight_mock_host_relay"
+	DefaultWeightMsgSignalIntent   = 50
+	DefaultWeightRequestRedemption = 30
+	DefaultWeightMockHostDeposit   = 60
+	DefaultWeightMockHostRelay     = 100
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights. All
+// operations share a single MockHost, which registers its zone on first use.
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper) simulation.WeightedOperations {
+	var (
+		weightMsgSignalIntent      int
+		weightMsgRequestRedemption int
+		weightMockHostDeposit      int
+		weightMockHostRelay        int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSignalIntent, &weightMsgSignalIntent, nil,
+		func(_ *rand.Rand) { weightMsgSignalIntent = DefaultWeightMsgSignalIntent },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestRedemption, &weightMsgRequestRedemption, nil,
+		func(_ *rand.Rand) { weightMsgRequestRedemption = DefaultWeightRequestRedemption },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMockHostDeposit, &weightMockHostDeposit, nil,
+		func(_ *rand.Rand) { weightMockHostDeposit = DefaultWeightMockHostDeposit },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMockHostRelay, &weightMockHostRelay, nil,
+		func(_ *rand.Rand) { weightMockHostRelay = DefaultWeightMockHostRelay },
+	)
+
+	host := NewMockHost(k)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSignalIntent, SimulateMsgSignalIntent(k, host)),
+		simulation.NewWeightedOperation(weightMsgRequestRedemption, SimulateMsgRequestRedemption(k, host)),
+		simulation.NewWeightedOperation(weightMockHostDeposit, SimulateMockHostDeposit(k, host)),
+		simulation.NewWeightedOperation(weightMockHostRelay, SimulateMockHostRelay(host)),
+	}
+}
+
+// SimulateMsgSignalIntent generates a MsgSignalIntent with random weights across the zone's validators.
+func SimulateMsgSignalIntent(k keeper.Keeper, host *MockHost) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		zone, err := host.Setup(r, ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSignalIntent, "unable to set up mock host"), nil, err
+		}
+		if len(zone.Validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSignalIntent, "zone validator set not yet known"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// pick up to five validators and split a weight of exactly one between them.
+		n := 1 + r.Intn(5)
+		if n > len(zone.Validators) {
+			n = len(zone.Validators)
+		}
+		remaining := sdk.OneDec()
+		intents := make([]*types.ValidatorIntent, 0, n)
+		for i, idx := range r.Perm(len(zone.Validators))[:n] {
+			weight := remaining
+			if i < n-1 {
+				weight = remaining.MulInt64(int64(1 + r.Intn(99))).QuoInt64(100)
+			}
+			remaining = remaining.Sub(weight)
+			intents = append(intents, &types.ValidatorIntent{ValoperAddress: zone.Validators[idx].ValoperAddress, Weight: weight})
+		}
+
+		msg := types.NewMsgSignalIntent(zone.ChainId, intents, simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   k.AccountKeeper,
+			Bankkeeper:      k.BankKeeper,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgRequestRedemption generates a MsgRequestRedemption for a random amount of an account's qAssets,
+// and queues the resulting interchain account packets for acknowledgement by the mock host.
+func SimulateMsgRequestRedemption(k keeper.Keeper, host *MockHost) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		zone, err := host.Setup(r, ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRedemption, "unable to set up mock host"), nil, err
+		}
+
+		var simAccount simtypes.Account
+		var balance sdk.Coin
+		for _, idx := range r.Perm(len(accs)) {
+			balance = k.BankKeeper.GetBalance(ctx, accs[idx].Address, zone.LocalDenom)
+			if balance.IsPositive() {
+				simAccount = accs[idx]
+				break
+			}
+		}
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRedemption, "no account holds qAssets"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRedemption, "unable to generate redemption amount"), nil, err
+		}
+
+		destination, err := bech32.ConvertAndEncode(zone.AccountPrefix, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRedemption, "unable to encode destination address"), nil, err
+		}
+
+		msg := types.NewMsgRequestRedemption(sdk.NewCoin(zone.LocalDenom, amount), destination, simAccount.Address)
+
+		account := k.AccountKeeper.GetAccount(ctx, simAccount.Address)
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			sdk.NewCoins(),
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, res, err := app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			// redemptions may legitimately fail, e.g. when delegations cannot cover the requested amount.
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		if err := host.CapturePackets(res.Events); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to capture packets"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// SimulateMockHostDeposit simulates a deposit on the mock host: native tokens are delegated by a random
+// delegation account to a random validator, the delegation is recorded, and qAssets are minted to a random
+// account at the zone's redemption rate.
+func SimulateMockHostDeposit(k keeper.Keeper, host *MockHost) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		zone, err := host.Setup(r, ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_deposit", "unable to set up mock host"), nil, err
+		}
+		delegationAccounts := zone.GetDelegationAccounts()
+		if len(zone.Validators) == 0 || len(delegationAccounts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_deposit", "zone not yet initialised"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegator := delegationAccounts[r.Intn(len(delegationAccounts))].Address
+		validator := zone.Validators[r.Intn(len(zone.Validators))].ValoperAddress
+		amount := sdk.NewInt(1_000 + r.Int63n(1_000_000_000))
+
+		if err := host.Delegate(delegator, validator, amount); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_deposit", err.Error()), nil, nil
+		}
+		total := sdk.NewCoin(zone.BaseDenom, host.GetDelegation(delegator, validator))
+		if err := k.UpdateDelegationRecordForAddress(ctx, delegator, validator, total, &zone, true); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_deposit", "unable to update delegation record"), nil, err
+		}
+		if err := k.MintQAsset(ctx, simAccount.Address, zone, sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, amount))); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_deposit", "unable to mint qAssets"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, "mock_host_deposit", fmt.Sprintf("%s%s", amount, zone.BaseDenom), true, nil), nil, nil
+	}
+}
+
+// SimulateMockHostRelay answers outstanding interchain queries and acknowledges queued packets on behalf of
+// the mock host, as a relayer would.
+func SimulateMockHostRelay(host *MockHost) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if _, err := host.Setup(r, ctx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_relay", "unable to set up mock host"), nil, err
+		}
+
+		queries, err := host.AnswerQueries(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_relay", "unable to answer queries"), nil, err
+		}
+		packets, err := host.AcknowledgePackets(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_relay", "unable to acknowledge packets"), nil, err
+		}
+		if queries == 0 && packets == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, "mock_host_relay", "nothing to relay"), nil, nil
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, "mock_host_relay", fmt.Sprintf("%d queries, %d packets", queries, packets), true, nil), nil, nil
+	}
+}
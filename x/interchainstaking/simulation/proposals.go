@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// OpWeightSubmitUpdateZoneProposal app params key for update zone proposal
+const OpWeightSubmitUpdateZoneProposal = "op_weight_submit_update_zone_proposal"
+
+// DefaultWeightUpdateZoneProposal is the default weight of the update zone proposal
+const DefaultWeightUpdateZoneProposal = 5
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitUpdateZoneProposal,
+			DefaultWeightUpdateZoneProposal,
+			SimulateUpdateZoneProposalContent(k),
+		),
+	}
+}
+
+// SimulateUpdateZoneProposalContent generates random update zone proposal content, changing the
+// ICA transaction limits of a registered zone.
+func SimulateUpdateZoneProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		zones := k.AllZones(ctx)
+		if len(zones) == 0 {
+			return nil
+		}
+		zone := zones[r.Intn(len(zones))]
+
+		changes := []*types.UpdateZoneValue{
+			{Key: "max_tx_msgs", Value: strconv.Itoa(1 + r.Intn(50))},
+			{Key: "max_tx_gas", Value: strconv.Itoa(1_000_000 + r.Intn(9_000_001))},
+		}
+
+		return types.NewUpdateZoneProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			zone.ChainId,
+			changes,
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// DefaultMaxTxMsgs is the maximum number of messages submitted in a single ICA packet, unless overridden by the zone.
+	DefaultMaxTxMsgs uint64 = 20
+	// DefaultMaxTxGas is the maximum estimated gas of a single ICA packet, unless overridden by the zone.
+	DefaultMaxTxGas uint64 = 3_000_000
+	// DefaultMsgGasEstimate is the estimated gas for message types not found in MsgGasEstimates.
+	DefaultMsgGasEstimate uint64 = 200_000
+
+	// MsgGasEstimates holds conservative host gas estimates, by message type url.
+	MsgGasEstimates = map[string]uint64{
+		"/cosmos.bank.v1beta1.MsgSend":                            100_000,
+		"/cosmos.bank.v1beta1.MsgMultiSend":                       150_000,
+		"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress":      100_000,
+		"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward": 150_000,
+		"/cosmos.gov.v1beta1.MsgVoteWeighted":                     100_000,
+		"/cosmos.staking.v1beta1.MsgDelegate":                     250_000,
+		"/cosmos.staking.v1beta1.MsgUndelegate":                   300_000,
+		"/cosmos.staking.v1beta1.MsgBeginRedelegate":              350_000,
+		"/cosmos.staking.v1beta1.MsgTokenizeShares":               300_000,
+		"/cosmos.staking.v1beta1.MsgRedeemTokensforShares":        300_000,
+		"/ibc.applications.transfer.v1.MsgTransfer":               150_000,
+	}
+)
+
+// TxMsgLimit returns the maximum number of messages per ICA packet for the zone.
+func (z Zone) TxMsgLimit() uint64 {
+	if z.MaxTxMsgs == 0 {
+		return DefaultMaxTxMsgs
+	}
+	return z.MaxTxMsgs
+}
+
+// TxGasLimit returns the maximum estimated gas per ICA packet for the zone.
+func (z Zone) TxGasLimit() uint64 {
+	if z.MaxTxGas == 0 {
+		return DefaultMaxTxGas
+	}
+	return z.MaxTxGas
+}
+
+// EstimateMsgGas returns the estimated host gas consumption of the given message.
+func EstimateMsgGas(msg sdk.Msg) uint64 {
+	if gas, ok := MsgGasEstimates[sdk.MsgTypeURL(msg)]; ok {
+		return gas
+	}
+	return DefaultMsgGasEstimate
+}
+
+// ChunkMsgs splits msgs, preserving order, into chunks of no more than maxMsgs messages and
+// maxGas estimated gas. A message whose estimate alone exceeds maxGas is placed in a chunk of its own.
+func ChunkMsgs(msgs []sdk.Msg, maxMsgs uint64, maxGas uint64) [][]sdk.Msg {
+	chunks := [][]sdk.Msg{}
+	chunk := []sdk.Msg{}
+	chunkGas := uint64(0)
+	for _, msg := range msgs {
+		gas := EstimateMsgGas(msg)
+		if len(chunk) > 0 && (uint64(len(chunk)) >= maxMsgs || chunkGas+gas > maxGas) {
+			chunks = append(chunks, chunk)
+			chunk = []sdk.Msg{}
+			chunkGas = 0
+		}
+		chunk = append(chunk, msg)
+		chunkGas += gas
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
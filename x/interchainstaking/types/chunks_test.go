@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestChunkMsgs(t *testing.T) {
+	undelegate := &stakingtypes.MsgUndelegate{}
+	send := &banktypes.MsgSend{}
+
+	msgs := make([]sdk.Msg, 0)
+	for i := 0; i < 25; i++ {
+		msgs = append(msgs, undelegate)
+	}
+
+	// limited by message count.
+	chunks := types.ChunkMsgs(msgs, 10, 100_000_000)
+	require.Len(t, chunks, 3)
+	require.Len(t, chunks[0], 10)
+	require.Len(t, chunks[1], 10)
+	require.Len(t, chunks[2], 5)
+
+	// limited by gas; 300k per undelegation.
+	chunks = types.ChunkMsgs(msgs, 100, 1_000_000)
+	require.Len(t, chunks, 9)
+	require.Len(t, chunks[0], 3)
+	require.Len(t, chunks[8], 1)
+
+	// order is preserved, and mixed message types are estimated independently.
+	chunks = types.ChunkMsgs([]sdk.Msg{undelegate, send, send, undelegate}, 100, 500_000)
+	require.Equal(t, [][]sdk.Msg{{undelegate, send, send}, {undelegate}}, chunks)
+
+	// a message exceeding the gas limit alone is placed in its own chunk.
+	chunks = types.ChunkMsgs([]sdk.Msg{send, undelegate, send}, 100, 200_000)
+	require.Equal(t, [][]sdk.Msg{{send}, {undelegate}, {send}}, chunks)
+
+	require.Empty(t, types.ChunkMsgs([]sdk.Msg{}, 10, 1_000_000))
+}
+
+func TestZoneTxLimits(t *testing.T) {
+	zone := types.Zone{}
+	require.Equal(t, types.DefaultMaxTxMsgs, zone.TxMsgLimit())
+	require.Equal(t, types.DefaultMaxTxGas, zone.TxGasLimit())
+
+	zone.MaxTxMsgs = 5
+	zone.MaxTxGas = 1_000_000
+	require.Equal(t, uint64(5), zone.TxMsgLimit())
+	require.Equal(t, uint64(1_000_000), zone.TxGasLimit())
+}
@@ -17,6 +17,9 @@ var (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSignalIntent{}, "quicksilver/MsgSignalIntent", nil)
 	cdc.RegisterConcrete(&MsgRequestRedemption{}, "quicksilver/MsgRequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgInstantRedeem{}, "quicksilver/MsgInstantRedeem", nil)
+	cdc.RegisterConcrete(&MsgDelegateIntent{}, "quicksilver/MsgDelegateIntent", nil)
+	cdc.RegisterConcrete(&MsgVoteOnHostProposal{}, "quicksilver/MsgVoteOnHostProposal", nil)
 	cdc.RegisterConcrete(&RegisterZoneProposal{}, "quicksilver/RegisterZoneProposal", nil)
 	cdc.RegisterConcrete(&UpdateZoneProposal{}, "quicksilver/UpdateZoneProposal", nil)
 }
@@ -27,6 +30,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSignalIntent{},
 		&MsgRequestRedemption{},
+		&MsgInstantRedeem{},
+		&MsgDelegateIntent{},
+		&MsgVoteOnHostProposal{},
 	)
 
 	registry.RegisterImplementations(
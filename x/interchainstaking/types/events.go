@@ -3,6 +3,11 @@ package types
 const (
 	EventTypeRegisterZone      = "register_zone"
 	EventTypeRedemptionRequest = "request_redemption"
+	EventTypeDelegateIntent    = "delegate_intent"
+	EventTypeHostVote          = "host_vote"
+	EventTypeHostVoteCast      = "host_vote_cast"
+	EventTypeOperationComplete = "operation_complete"
+	EventTypeValidatorRemoved  = "validator_removed"
 
 	AttributeKeyConnectionID     = "connection_id"
 	AttributeKeyRecipientChain   = "chain_id"
@@ -10,6 +15,12 @@ const (
 	AttributeKeyBurnAmount       = "burn_amount"
 	AttributeKeyRedeemAmount     = "redeem_amount"
 	AttributeKeySourceAddress    = "source"
+	AttributeKeyCurator          = "curator"
+	AttributeKeyProposalID       = "proposal_id"
+	AttributeKeyVoteOptions      = "options"
+	AttributeKeyMemo             = "memo"
+	AttributeKeyFailedChunks     = "failed_chunks"
+	AttributeKeyValidator        = "validator"
 
 	AttributeValueCategory = ModuleName
 )
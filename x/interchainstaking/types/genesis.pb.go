@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/gov/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -51,6 +52,21 @@ type Zone struct {
 	ValidatorSelectionAllocation github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=validator_selection_allocation,json=validatorSelectionAllocation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"validator_selection_allocation"`
 	HoldingsAllocation           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=holdings_allocation,json=holdingsAllocation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"holdings_allocation"`
 	LastEpochHeight              int64                                    `protobuf:"varint,20,opt,name=last_epoch_height,json=lastEpochHeight,proto3" json:"last_epoch_height,omitempty"`
+	MaxTxMsgs                    uint64                                   `protobuf:"varint,21,opt,name=max_tx_msgs,json=maxTxMsgs,proto3" json:"max_tx_msgs,omitempty"`
+	MaxTxGas                     uint64                                   `protobuf:"varint,22,opt,name=max_tx_gas,json=maxTxGas,proto3" json:"max_tx_gas,omitempty"`
+	// liquidity_buffer_ratio is the share of the zone's native assets to be held
+	// undelegated in the deposit account, from which instant redemptions are
+	// paid. Zero disables the buffer.
+	LiquidityBufferRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=liquidity_buffer_ratio,json=liquidityBufferRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_buffer_ratio"`
+	// liquidity_buffer is the amount of base denom held in the deposit account
+	// for instant redemptions.
+	LiquidityBuffer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,24,opt,name=liquidity_buffer,json=liquidityBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity_buffer"`
+	// preflight records the checks made against the host chain while the zone
+	// registration is pending; it is cleared when the zone is activated. A zone
+	// without preflight state is active.
+	Preflight *ZonePreflight `protobuf:"bytes,25,opt,name=preflight,proto3" json:"preflight,omitempty"`
+	// params overrides the module parameters of the same name for the zone.
+	Params ZoneParams `protobuf:"bytes,26,opt,name=params,proto3" json:"params"`
 }
 
 func (m *Zone) Reset()         { *m = Zone{} }
@@ -212,6 +228,164 @@ func (m *Zone) GetLastEpochHeight() int64 {
 	return 0
 }
 
+func (m *Zone) GetMaxTxMsgs() uint64 {
+	if m != nil {
+		return m.MaxTxMsgs
+	}
+	return 0
+}
+
+func (m *Zone) GetMaxTxGas() uint64 {
+	if m != nil {
+		return m.MaxTxGas
+	}
+	return 0
+}
+
+func (m *Zone) GetPreflight() *ZonePreflight {
+	if m != nil {
+		return m.Preflight
+	}
+	return nil
+}
+
+func (m *Zone) GetParams() ZoneParams {
+	if m != nil {
+		return m.Params
+	}
+	return ZoneParams{}
+}
+
+// ZoneParams holds the zone overrides of module parameters. A zero value means
+// the module parameter applies.
+type ZoneParams struct {
+	DepositInterval      uint64 `protobuf:"varint,1,opt,name=deposit_interval,json=depositInterval,proto3" json:"deposit_interval,omitempty" yaml:"deposit_interval"`
+	ValidatorSetInterval uint64 `protobuf:"varint,2,opt,name=validator_set_interval,json=validatorSetInterval,proto3" json:"validator_set_interval,omitempty" yaml:"validator_set_interval"`
+	DelegateAccountCount uint64 `protobuf:"varint,3,opt,name=delegate_account_count,json=delegateAccountCount,proto3" json:"delegate_account_count,omitempty" yaml:"delegate_account_count"`
+}
+
+func (m *ZoneParams) Reset()         { *m = ZoneParams{} }
+func (m *ZoneParams) String() string { return proto.CompactTextString(m) }
+func (*ZoneParams) ProtoMessage()    {}
+func (*ZoneParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{1}
+}
+func (m *ZoneParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneParams.Merge(m, src)
+}
+func (m *ZoneParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneParams proto.InternalMessageInfo
+
+func (m *ZoneParams) GetDepositInterval() uint64 {
+	if m != nil {
+		return m.DepositInterval
+	}
+	return 0
+}
+
+func (m *ZoneParams) GetValidatorSetInterval() uint64 {
+	if m != nil {
+		return m.ValidatorSetInterval
+	}
+	return 0
+}
+
+func (m *ZoneParams) GetDelegateAccountCount() uint64 {
+	if m != nil {
+		return m.DelegateAccountCount
+	}
+	return 0
+}
+
+// ZonePreflight records which of the checks made against the host chain before
+// a zone is activated have passed.
+type ZonePreflight struct {
+	// bond_denom is set once the host staking bond denom is proven to equal the
+	// zone base denom.
+	BondDenom bool `protobuf:"varint,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// account_prefix is set once the deposit account address is found to have
+	// the zone account prefix.
+	AccountPrefix bool `protobuf:"varint,2,opt,name=account_prefix,json=accountPrefix,proto3" json:"account_prefix,omitempty"`
+	// allowed_messages is set once the host interchain accounts module is proven
+	// to allow every message type the zone requires.
+	AllowedMessages bool `protobuf:"varint,3,opt,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+}
+
+func (m *ZonePreflight) Reset()         { *m = ZonePreflight{} }
+func (m *ZonePreflight) String() string { return proto.CompactTextString(m) }
+func (*ZonePreflight) ProtoMessage()    {}
+func (*ZonePreflight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{2}
+}
+func (m *ZonePreflight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZonePreflight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZonePreflight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZonePreflight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZonePreflight.Merge(m, src)
+}
+func (m *ZonePreflight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZonePreflight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZonePreflight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZonePreflight proto.InternalMessageInfo
+
+func (m *ZonePreflight) GetBondDenom() bool {
+	if m != nil {
+		return m.BondDenom
+	}
+	return false
+}
+
+func (m *ZonePreflight) GetAccountPrefix() bool {
+	if m != nil {
+		return m.AccountPrefix
+	}
+	return false
+}
+
+func (m *ZonePreflight) GetAllowedMessages() bool {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return false
+}
+
 type ICAAccount struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// balance defines the different coins this balance holds.
@@ -225,7 +399,7 @@ func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
 func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
 func (*ICAAccount) ProtoMessage()    {}
 func (*ICAAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{1}
+	return fileDescriptor_196cdf77e041fc72, []int{3}
 }
 func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,6 +463,84 @@ func (m *ICAAccount) GetBalanceWaitgroup() uint32 {
 	return 0
 }
 
+// UnbondingRecord is the host chain's view of the unbonding entries of a
+// delegator/validator pair, as last observed at the given (local) height.
+type UnbondingRecord struct {
+	ChainId         string      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Delegator       string      `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Validator       string      `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	CompletionTimes []time.Time `protobuf:"bytes,4,rep,name=completion_times,json=completionTimes,proto3,stdtime" json:"completion_times"`
+	Height          int64       `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *UnbondingRecord) Reset()         { *m = UnbondingRecord{} }
+func (m *UnbondingRecord) String() string { return proto.CompactTextString(m) }
+func (*UnbondingRecord) ProtoMessage()    {}
+func (*UnbondingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{4}
+}
+func (m *UnbondingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingRecord.Merge(m, src)
+}
+func (m *UnbondingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingRecord proto.InternalMessageInfo
+
+func (m *UnbondingRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *UnbondingRecord) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *UnbondingRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *UnbondingRecord) GetCompletionTimes() []time.Time {
+	if m != nil {
+		return m.CompletionTimes
+	}
+	return nil
+}
+
+func (m *UnbondingRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type WithdrawalRecord struct {
 	ChainId        string                                  `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Delegator      string                                  `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
@@ -299,13 +551,24 @@ type WithdrawalRecord struct {
 	Txhash         string                                  `protobuf:"bytes,7,opt,name=txhash,proto3" json:"txhash,omitempty"`
 	Status         int32                                   `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
 	CompletionTime time.Time                               `protobuf:"bytes,9,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// slashed_amount is the part of the undelegated amount lost to slashing
+	// during unbonding, as attributed from the proven unbonding delegation
+	// entries of its validator. amount is reduced by it when paid out.
+	SlashedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,10,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"slashed_amount"`
+	// redeemer is the local account whose qAssets are escrowed for an instant
+	// redemption, to which they are refunded if the payout fails on the host.
+	Redeemer string `protobuf:"bytes,11,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	// transfer_timeout is the timeout of the transfer returning the withdrawal
+	// to a local address. Once passed, the transfer can no longer be received,
+	// and is refunded to the sending account by the host.
+	TransferTimeout time.Time `protobuf:"bytes,12,opt,name=transfer_timeout,json=transferTimeout,proto3,stdtime" json:"transfer_timeout"`
 }
 
 func (m *WithdrawalRecord) Reset()         { *m = WithdrawalRecord{} }
 func (m *WithdrawalRecord) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalRecord) ProtoMessage()    {}
 func (*WithdrawalRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{2}
+	return fileDescriptor_196cdf77e041fc72, []int{5}
 }
 func (m *WithdrawalRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,6 +646,20 @@ func (m *WithdrawalRecord) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *WithdrawalRecord) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
+	}
+	return ""
+}
+
+func (m *WithdrawalRecord) GetTransferTimeout() time.Time {
+	if m != nil {
+		return m.TransferTimeout
+	}
+	return time.Time{}
+}
+
 type TransferRecord struct {
 	Sender    string                                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Recipient string                                  `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
@@ -393,7 +670,7 @@ func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
 func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
 func (*TransferRecord) ProtoMessage()    {}
 func (*TransferRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{3}
+	return fileDescriptor_196cdf77e041fc72, []int{6}
 }
 func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +725,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{4}
+	return fileDescriptor_196cdf77e041fc72, []int{7}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,23 +761,23 @@ func (m *Validator) GetValoperAddress() string {
 	return ""
 }
 
-type DelegatorIntent struct {
-	Delegator string             `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Intents   []*ValidatorIntent `protobuf:"bytes,2,rep,name=intents,proto3" json:"intents,omitempty"`
+type IntentDelegation struct {
+	Follower string `protobuf:"bytes,1,opt,name=follower,proto3" json:"follower,omitempty"`
+	Curator  string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
 }
 
-func (m *DelegatorIntent) Reset()         { *m = DelegatorIntent{} }
-func (m *DelegatorIntent) String() string { return proto.CompactTextString(m) }
-func (*DelegatorIntent) ProtoMessage()    {}
-func (*DelegatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{5}
+func (m *IntentDelegation) Reset()         { *m = IntentDelegation{} }
+func (m *IntentDelegation) String() string { return proto.CompactTextString(m) }
+func (*IntentDelegation) ProtoMessage()    {}
+func (*IntentDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{8}
 }
-func (m *DelegatorIntent) XXX_Unmarshal(b []byte) error {
+func (m *IntentDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IntentDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorIntent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IntentDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -510,49 +787,51 @@ func (m *DelegatorIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DelegatorIntent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorIntent.Merge(m, src)
+func (m *IntentDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntentDelegation.Merge(m, src)
 }
-func (m *DelegatorIntent) XXX_Size() int {
+func (m *IntentDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorIntent) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorIntent.DiscardUnknown(m)
+func (m *IntentDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntentDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorIntent proto.InternalMessageInfo
+var xxx_messageInfo_IntentDelegation proto.InternalMessageInfo
 
-func (m *DelegatorIntent) GetDelegator() string {
+func (m *IntentDelegation) GetFollower() string {
 	if m != nil {
-		return m.Delegator
+		return m.Follower
 	}
 	return ""
 }
 
-func (m *DelegatorIntent) GetIntents() []*ValidatorIntent {
+func (m *IntentDelegation) GetCurator() string {
 	if m != nil {
-		return m.Intents
+		return m.Curator
 	}
-	return nil
+	return ""
 }
 
-type ValidatorIntent struct {
-	ValoperAddress string                                 `protobuf:"bytes,1,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
-	Weight         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"relative_weight"`
+type PendingOperation struct {
+	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Memo        string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Outstanding uint32 `protobuf:"varint,3,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	Failed      uint32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
-func (m *ValidatorIntent) Reset()         { *m = ValidatorIntent{} }
-func (m *ValidatorIntent) String() string { return proto.CompactTextString(m) }
-func (*ValidatorIntent) ProtoMessage()    {}
-func (*ValidatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{6}
+func (m *PendingOperation) Reset()         { *m = PendingOperation{} }
+func (m *PendingOperation) String() string { return proto.CompactTextString(m) }
+func (*PendingOperation) ProtoMessage()    {}
+func (*PendingOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{9}
 }
-func (m *ValidatorIntent) XXX_Unmarshal(b []byte) error {
+func (m *PendingOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorIntent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingOperation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -562,45 +841,65 @@ func (m *ValidatorIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ValidatorIntent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorIntent.Merge(m, src)
+func (m *PendingOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOperation.Merge(m, src)
 }
-func (m *ValidatorIntent) XXX_Size() int {
+func (m *PendingOperation) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorIntent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorIntent.DiscardUnknown(m)
+func (m *PendingOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOperation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorIntent proto.InternalMessageInfo
+var xxx_messageInfo_PendingOperation proto.InternalMessageInfo
 
-func (m *ValidatorIntent) GetValoperAddress() string {
+func (m *PendingOperation) GetChainId() string {
 	if m != nil {
-		return m.ValoperAddress
+		return m.ChainId
 	}
 	return ""
 }
 
-type Delegation struct {
-	DelegationAddress string                                  `protobuf:"bytes,1,opt,name=delegation_address,json=delegationAddress,proto3" json:"delegation_address,omitempty"`
-	ValidatorAddress  string                                  `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	Amount            github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
-	Height            int64                                   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
-	RedelegationEnd   int64                                   `protobuf:"varint,5,opt,name=redelegation_end,json=redelegationEnd,proto3" json:"redelegation_end,omitempty"`
+func (m *PendingOperation) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
 
-func (m *Delegation) Reset()         { *m = Delegation{} }
-func (m *Delegation) String() string { return proto.CompactTextString(m) }
-func (*Delegation) ProtoMessage()    {}
-func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{7}
+func (m *PendingOperation) GetOutstanding() uint32 {
+	if m != nil {
+		return m.Outstanding
+	}
+	return 0
 }
-func (m *Delegation) XXX_Unmarshal(b []byte) error {
+
+func (m *PendingOperation) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+type HostProposal struct {
+	ChainId       string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProposalId    uint64    `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	VotingEndTime time.Time `protobuf:"bytes,3,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+	Status        int32     `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *HostProposal) Reset()         { *m = HostProposal{} }
+func (m *HostProposal) String() string { return proto.CompactTextString(m) }
+func (*HostProposal) ProtoMessage()    {}
+func (*HostProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{10}
+}
+func (m *HostProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HostProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HostProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -610,40 +909,274 @@ func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Delegation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Delegation.Merge(m, src)
+func (m *HostProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostProposal.Merge(m, src)
 }
-func (m *Delegation) XXX_Size() int {
+func (m *HostProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *Delegation) XXX_DiscardUnknown() {
-	xxx_messageInfo_Delegation.DiscardUnknown(m)
+func (m *HostProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Delegation proto.InternalMessageInfo
+var xxx_messageInfo_HostProposal proto.InternalMessageInfo
 
-func (m *Delegation) GetDelegationAddress() string {
+func (m *HostProposal) GetChainId() string {
 	if m != nil {
-		return m.DelegationAddress
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *Delegation) GetValidatorAddress() string {
+func (m *HostProposal) GetProposalId() uint64 {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.ProposalId
 	}
-	return ""
+	return 0
 }
 
-func (m *Delegation) GetHeight() int64 {
+func (m *HostProposal) GetVotingEndTime() time.Time {
 	if m != nil {
-		return m.Height
+		return m.VotingEndTime
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *Delegation) GetRedelegationEnd() int64 {
+func (m *HostProposal) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type HostVote struct {
+	ChainId    string                      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProposalId uint64                      `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string                      `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	Options    []types1.WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+}
+
+func (m *HostVote) Reset()         { *m = HostVote{} }
+func (m *HostVote) String() string { return proto.CompactTextString(m) }
+func (*HostVote) ProtoMessage()    {}
+func (*HostVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{11}
+}
+func (m *HostVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostVote.Merge(m, src)
+}
+func (m *HostVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostVote proto.InternalMessageInfo
+
+func (m *HostVote) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *HostVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *HostVote) GetOptions() []types1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type DelegatorIntent struct {
+	Delegator string             `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Intents   []*ValidatorIntent `protobuf:"bytes,2,rep,name=intents,proto3" json:"intents,omitempty"`
+}
+
+func (m *DelegatorIntent) Reset()         { *m = DelegatorIntent{} }
+func (m *DelegatorIntent) String() string { return proto.CompactTextString(m) }
+func (*DelegatorIntent) ProtoMessage()    {}
+func (*DelegatorIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{12}
+}
+func (m *DelegatorIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorIntent.Merge(m, src)
+}
+func (m *DelegatorIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorIntent proto.InternalMessageInfo
+
+func (m *DelegatorIntent) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *DelegatorIntent) GetIntents() []*ValidatorIntent {
+	if m != nil {
+		return m.Intents
+	}
+	return nil
+}
+
+type ValidatorIntent struct {
+	ValoperAddress string                                 `protobuf:"bytes,1,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	Weight         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"relative_weight"`
+}
+
+func (m *ValidatorIntent) Reset()         { *m = ValidatorIntent{} }
+func (m *ValidatorIntent) String() string { return proto.CompactTextString(m) }
+func (*ValidatorIntent) ProtoMessage()    {}
+func (*ValidatorIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{13}
+}
+func (m *ValidatorIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorIntent.Merge(m, src)
+}
+func (m *ValidatorIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorIntent proto.InternalMessageInfo
+
+func (m *ValidatorIntent) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
+type Delegation struct {
+	DelegationAddress string                                  `protobuf:"bytes,1,opt,name=delegation_address,json=delegationAddress,proto3" json:"delegation_address,omitempty"`
+	ValidatorAddress  string                                  `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount            github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Height            int64                                   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	RedelegationEnd   int64                                   `protobuf:"varint,5,opt,name=redelegation_end,json=redelegationEnd,proto3" json:"redelegation_end,omitempty"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{14}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegationAddress() string {
+	if m != nil {
+		return m.DelegationAddress
+	}
+	return ""
+}
+
+func (m *Delegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *Delegation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Delegation) GetRedelegationEnd() int64 {
 	if m != nil {
 		return m.RedelegationEnd
 	}
@@ -659,7 +1192,7 @@ func (m *PortConnectionTuple) Reset()         { *m = PortConnectionTuple{} }
 func (m *PortConnectionTuple) String() string { return proto.CompactTextString(m) }
 func (*PortConnectionTuple) ProtoMessage()    {}
 func (*PortConnectionTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{8}
+	return fileDescriptor_196cdf77e041fc72, []int{15}
 }
 func (m *PortConnectionTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +1246,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{9}
+	return fileDescriptor_196cdf77e041fc72, []int{16}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +1313,7 @@ func (m *DelegationPlan) Reset()         { *m = DelegationPlan{} }
 func (m *DelegationPlan) String() string { return proto.CompactTextString(m) }
 func (*DelegationPlan) ProtoMessage()    {}
 func (*DelegationPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{10}
+	return fileDescriptor_196cdf77e041fc72, []int{17}
 }
 func (m *DelegationPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,12 +1368,17 @@ type Params struct {
 	DepositInterval        uint64                                 `protobuf:"varint,2,opt,name=deposit_interval,json=depositInterval,proto3" json:"deposit_interval,omitempty"`
 	ValidatorsetInterval   uint64                                 `protobuf:"varint,3,opt,name=validatorset_interval,json=validatorsetInterval,proto3" json:"validatorset_interval,omitempty"`
 	CommissionRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	MaxCuratorWeight       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_curator_weight,json=maxCuratorWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_curator_weight"`
+	InstantRedemptionFee   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=instant_redemption_fee,json=instantRedemptionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_redemption_fee"`
+	// query_bounty is the bounty, in the interchainquery bounty denom, attached
+	// to each proven query emitted for a zone.
+	QueryBounty github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=query_bounty,json=queryBounty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"query_bounty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{11}
+	return fileDescriptor_196cdf77e041fc72, []int{18}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +1437,7 @@ func (m *DelegationsForZone) Reset()         { *m = DelegationsForZone{} }
 func (m *DelegationsForZone) String() string { return proto.CompactTextString(m) }
 func (*DelegationsForZone) ProtoMessage()    {}
 func (*DelegationsForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{12}
+	return fileDescriptor_196cdf77e041fc72, []int{19}
 }
 func (m *DelegationsForZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,7 +1489,7 @@ func (m *DelegationPlansForZone) Reset()         { *m = DelegationPlansForZone{}
 func (m *DelegationPlansForZone) String() string { return proto.CompactTextString(m) }
 func (*DelegationPlansForZone) ProtoMessage()    {}
 func (*DelegationPlansForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{13}
+	return fileDescriptor_196cdf77e041fc72, []int{20}
 }
 func (m *DelegationPlansForZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1542,7 @@ func (m *DelegatorIntentsForZone) Reset()         { *m = DelegatorIntentsForZone
 func (m *DelegatorIntentsForZone) String() string { return proto.CompactTextString(m) }
 func (*DelegatorIntentsForZone) ProtoMessage()    {}
 func (*DelegatorIntentsForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{14}
+	return fileDescriptor_196cdf77e041fc72, []int{21}
 }
 func (m *DelegatorIntentsForZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,22 +1592,78 @@ func (m *DelegatorIntentsForZone) GetSnapshot() bool {
 	return false
 }
 
+type IntentDelegationsForZone struct {
+	ChainId           string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IntentDelegations []IntentDelegation `protobuf:"bytes,2,rep,name=intent_delegations,json=intentDelegations,proto3" json:"intent_delegations"`
+}
+
+func (m *IntentDelegationsForZone) Reset()         { *m = IntentDelegationsForZone{} }
+func (m *IntentDelegationsForZone) String() string { return proto.CompactTextString(m) }
+func (*IntentDelegationsForZone) ProtoMessage()    {}
+func (*IntentDelegationsForZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{22}
+}
+func (m *IntentDelegationsForZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntentDelegationsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntentDelegationsForZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntentDelegationsForZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntentDelegationsForZone.Merge(m, src)
+}
+func (m *IntentDelegationsForZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntentDelegationsForZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntentDelegationsForZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntentDelegationsForZone proto.InternalMessageInfo
+
+func (m *IntentDelegationsForZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *IntentDelegationsForZone) GetIntentDelegations() []IntentDelegation {
+	if m != nil {
+		return m.IntentDelegations
+	}
+	return nil
+}
+
 // GenesisState defines the interchainstaking module's genesis state.
 type GenesisState struct {
-	Params           Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Zones            []Zone                    `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones"`
-	Receipts         []Receipt                 `protobuf:"bytes,3,rep,name=receipts,proto3" json:"receipts"`
-	Delegations      []DelegationsForZone      `protobuf:"bytes,4,rep,name=delegations,proto3" json:"delegations"`
-	DelegationPlans  []DelegationPlansForZone  `protobuf:"bytes,5,rep,name=delegation_plans,json=delegationPlans,proto3" json:"delegation_plans"`
-	DelegatorIntents []DelegatorIntentsForZone `protobuf:"bytes,6,rep,name=delegator_intents,json=delegatorIntents,proto3" json:"delegator_intents"`
-	PortConnections  []PortConnectionTuple     `protobuf:"bytes,7,rep,name=port_connections,json=portConnections,proto3" json:"port_connections"`
+	Params            Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Zones             []Zone                     `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones"`
+	Receipts          []Receipt                  `protobuf:"bytes,3,rep,name=receipts,proto3" json:"receipts"`
+	Delegations       []DelegationsForZone       `protobuf:"bytes,4,rep,name=delegations,proto3" json:"delegations"`
+	DelegationPlans   []DelegationPlansForZone   `protobuf:"bytes,5,rep,name=delegation_plans,json=delegationPlans,proto3" json:"delegation_plans"`
+	DelegatorIntents  []DelegatorIntentsForZone  `protobuf:"bytes,6,rep,name=delegator_intents,json=delegatorIntents,proto3" json:"delegator_intents"`
+	PortConnections   []PortConnectionTuple      `protobuf:"bytes,7,rep,name=port_connections,json=portConnections,proto3" json:"port_connections"`
+	IntentDelegations []IntentDelegationsForZone `protobuf:"bytes,8,rep,name=intent_delegations,json=intentDelegations,proto3" json:"intent_delegations"`
+	HostProposals     []HostProposal             `protobuf:"bytes,9,rep,name=host_proposals,json=hostProposals,proto3" json:"host_proposals"`
+	HostVotes         []HostVote                 `protobuf:"bytes,10,rep,name=host_votes,json=hostVotes,proto3" json:"host_votes"`
+	PendingOperations []PendingOperation         `protobuf:"bytes,11,rep,name=pending_operations,json=pendingOperations,proto3" json:"pending_operations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{15}
+	return fileDescriptor_196cdf77e041fc72, []int{23}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,13 +1741,48 @@ func (m *GenesisState) GetPortConnections() []PortConnectionTuple {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Zone)(nil), "quicksilver.interchainstaking.v1.Zone")
-	proto.RegisterMapType((map[string]*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.Zone.AggregateIntentEntry")
-	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
-	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
-	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
+func (m *GenesisState) GetIntentDelegations() []IntentDelegationsForZone {
+	if m != nil {
+		return m.IntentDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostProposals() []HostProposal {
+	if m != nil {
+		return m.HostProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostVotes() []HostVote {
+	if m != nil {
+		return m.HostVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOperations() []PendingOperation {
+	if m != nil {
+		return m.PendingOperations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Zone)(nil), "quicksilver.interchainstaking.v1.Zone")
+	proto.RegisterMapType((map[string]*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.Zone.AggregateIntentEntry")
+	proto.RegisterType((*ZoneParams)(nil), "quicksilver.interchainstaking.v1.ZoneParams")
+	proto.RegisterType((*ZonePreflight)(nil), "quicksilver.interchainstaking.v1.ZonePreflight")
+	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
+	proto.RegisterType((*UnbondingRecord)(nil), "quicksilver.interchainstaking.v1.UnbondingRecord")
+	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
+	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
 	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
+	proto.RegisterType((*IntentDelegation)(nil), "quicksilver.interchainstaking.v1.IntentDelegation")
+	proto.RegisterType((*PendingOperation)(nil), "quicksilver.interchainstaking.v1.PendingOperation")
+	proto.RegisterType((*HostProposal)(nil), "quicksilver.interchainstaking.v1.HostProposal")
+	proto.RegisterType((*HostVote)(nil), "quicksilver.interchainstaking.v1.HostVote")
 	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
 	proto.RegisterType((*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.ValidatorIntent")
 	proto.RegisterType((*Delegation)(nil), "quicksilver.interchainstaking.v1.Delegation")
@@ -1165,6 +1794,7 @@ func init() {
 	proto.RegisterType((*DelegationPlansForZone)(nil), "quicksilver.interchainstaking.v1.DelegationPlansForZone")
 	proto.RegisterMapType((map[string]*DelegationPlan)(nil), "quicksilver.interchainstaking.v1.DelegationPlansForZone.DelegationPlansEntry")
 	proto.RegisterType((*DelegatorIntentsForZone)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsForZone")
+	proto.RegisterType((*IntentDelegationsForZone)(nil), "quicksilver.interchainstaking.v1.IntentDelegationsForZone")
 	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainstaking.v1.GenesisState")
 }
 
@@ -1173,123 +1803,168 @@ func init() {
 }
 
 var fileDescriptor_196cdf77e041fc72 = []byte{
-	// 1851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x52, 0x14, 0x45, 0x3e, 0xca, 0x22, 0x35, 0x62, 0x9c, 0xb5, 0xda, 0x8a, 0x02, 0x8b,
-	0xa6, 0x72, 0x53, 0x91, 0x96, 0xd2, 0x0f, 0xd7, 0x2d, 0x8a, 0x52, 0x96, 0xed, 0x08, 0x86, 0x0d,
-	0x61, 0xe5, 0x26, 0x80, 0xfb, 0xb1, 0x18, 0xee, 0x8e, 0xc8, 0x81, 0x97, 0x3b, 0xeb, 0x9d, 0x21,
-	0x25, 0x05, 0x05, 0x7a, 0xeb, 0x39, 0x45, 0x81, 0x22, 0x47, 0x03, 0xbd, 0xf5, 0xd4, 0x83, 0xff,
-	0x83, 0xf6, 0x90, 0x63, 0xe0, 0x5c, 0x8a, 0x1e, 0x9c, 0xc2, 0xbe, 0xf4, 0xd2, 0x4b, 0xff, 0x81,
-	0x16, 0x33, 0x3b, 0xfb, 0x41, 0x4a, 0x15, 0x29, 0x45, 0xf1, 0xc5, 0xe6, 0xbc, 0x37, 0xef, 0xf7,
-	0x66, 0xe6, 0xbd, 0xf9, 0xbd, 0x37, 0x2b, 0x68, 0x3e, 0x1d, 0x50, 0xe7, 0x09, 0xa7, 0xde, 0x90,
-	0x84, 0x2d, 0xea, 0x0b, 0x12, 0x3a, 0x3d, 0x4c, 0x7d, 0x2e, 0xf0, 0x13, 0xea, 0x77, 0x5b, 0xc3,
-	0xcd, 0x56, 0x97, 0xf8, 0x84, 0x53, 0xde, 0x0c, 0x42, 0x26, 0x18, 0x5a, 0xcb, 0xcc, 0x6f, 0x9e,
-	0x98, 0xdf, 0x1c, 0x6e, 0xae, 0xd4, 0xba, 0xac, 0xcb, 0xd4, 0xe4, 0x96, 0xfc, 0x15, 0xd9, 0xad,
-	0x5c, 0x73, 0x18, 0xef, 0x33, 0x6e, 0x47, 0x8a, 0x68, 0xa0, 0x55, 0xab, 0xd1, 0xa8, 0xd5, 0xc1,
-	0x9c, 0xb4, 0x86, 0x9b, 0x1d, 0x22, 0xf0, 0x66, 0xcb, 0x61, 0xd4, 0xd7, 0xfa, 0x7a, 0x97, 0xb1,
-	0xae, 0x47, 0x5a, 0x6a, 0xd4, 0x19, 0x1c, 0xb4, 0x04, 0xed, 0x13, 0x2e, 0x70, 0x3f, 0x88, 0x26,
-	0x34, 0x3e, 0x59, 0x80, 0xfc, 0x63, 0xe6, 0x13, 0xf4, 0x4d, 0xb8, 0xe2, 0x30, 0xdf, 0x27, 0x8e,
-	0xa0, 0xcc, 0xb7, 0xa9, 0x6b, 0x1a, 0x6b, 0xc6, 0x7a, 0xc9, 0x5a, 0x48, 0x85, 0xbb, 0x2e, 0xba,
-	0x06, 0x45, 0xb5, 0x64, 0xa9, 0xcf, 0x29, 0xfd, 0xbc, 0x1a, 0xef, 0xba, 0xe8, 0xe7, 0x50, 0x71,
-	0x49, 0xc0, 0x38, 0x15, 0x36, 0x76, 0xdd, 0x90, 0x70, 0x6e, 0xce, 0xae, 0x19, 0xeb, 0xe5, 0xad,
-	0xef, 0x36, 0x27, 0x6d, 0xbb, 0xb9, 0x7b, 0xbb, 0xdd, 0x76, 0x1c, 0x36, 0xf0, 0x85, 0xb5, 0xa8,
-	0x41, 0xda, 0x11, 0x06, 0xfa, 0x05, 0xa0, 0x43, 0x2a, 0x7a, 0x6e, 0x88, 0x0f, 0xb1, 0x97, 0x20,
-	0xe7, 0x2f, 0x80, 0xbc, 0x94, 0xe2, 0xc4, 0xe0, 0xbf, 0x82, 0xe5, 0x80, 0x84, 0x07, 0x2c, 0xec,
-	0x63, 0xdf, 0x21, 0x09, 0xfa, 0xdc, 0x05, 0xd0, 0x51, 0x06, 0x28, 0x86, 0xb7, 0xa1, 0xe6, 0x12,
-	0x8f, 0x74, 0xb1, 0x3a, 0x52, 0x8d, 0x4e, 0xb8, 0x59, 0x58, 0x9b, 0x3d, 0x37, 0xfe, 0x72, 0x8a,
-	0xd4, 0x8e, 0x81, 0xd0, 0xb7, 0x60, 0x11, 0x47, 0x7a, 0x3b, 0x08, 0xc9, 0x01, 0x3d, 0x32, 0xe7,
-	0x55, 0x50, 0xae, 0x68, 0xe9, 0x9e, 0x12, 0xa2, 0x3a, 0x94, 0x3d, 0xe6, 0x60, 0xcf, 0x76, 0x89,
-	0xcf, 0xfa, 0x66, 0x51, 0xcd, 0x01, 0x25, 0xda, 0x91, 0x12, 0xf4, 0x0d, 0x00, 0x99, 0x40, 0x5a,
-	0x5f, 0x52, 0xfa, 0x92, 0x94, 0x44, 0x6a, 0x02, 0x95, 0x90, 0xb8, 0xa4, 0x1f, 0xa8, 0x7d, 0x84,
-	0x58, 0x10, 0x13, 0xe4, 0x9c, 0xed, 0x9f, 0x7c, 0xfa, 0xb2, 0x3e, 0xf3, 0x8f, 0x97, 0xf5, 0x77,
-	0xba, 0x54, 0xf4, 0x06, 0x9d, 0xa6, 0xc3, 0xfa, 0x3a, 0x3d, 0xf5, 0x7f, 0x1b, 0xdc, 0x7d, 0xd2,
-	0x12, 0xc7, 0x01, 0xe1, 0xcd, 0x1d, 0xe2, 0xbc, 0x78, 0xbe, 0x01, 0x3a, 0x7b, 0x77, 0x88, 0x63,
-	0x2d, 0xa6, 0xa0, 0x16, 0x16, 0x04, 0xf9, 0x50, 0xf3, 0x30, 0x17, 0xf6, 0xb8, 0xaf, 0xf2, 0x25,
-	0xf8, 0x42, 0x12, 0xd9, 0x1a, 0xf5, 0x77, 0x1f, 0x60, 0x88, 0x3d, 0xea, 0x62, 0xc1, 0x42, 0x6e,
-	0x2e, 0xa8, 0xa0, 0xbc, 0x3b, 0x39, 0x28, 0x1f, 0xc4, 0x36, 0x56, 0xc6, 0x1c, 0x1d, 0x40, 0x15,
-	0x77, 0xbb, 0xa1, 0x0c, 0x11, 0xb1, 0xa5, 0x9d, 0x2f, 0xcc, 0x2b, 0x0a, 0xf2, 0xc7, 0x93, 0x21,
-	0xe5, 0x05, 0x6c, 0xb6, 0x63, 0xf3, 0x5d, 0x65, 0x7d, 0xc7, 0x17, 0xe1, 0xb1, 0x55, 0xc1, 0xa3,
-	0x52, 0x19, 0xaa, 0xfe, 0xc0, 0x13, 0xd4, 0xe6, 0xc4, 0x77, 0xcd, 0xc5, 0x35, 0x63, 0xbd, 0x68,
-	0x95, 0x94, 0x64, 0x9f, 0xf8, 0x2e, 0xba, 0x0e, 0x55, 0x8f, 0x3e, 0x1d, 0x50, 0x97, 0x8a, 0x63,
-	0xbb, 0xcf, 0xdc, 0x81, 0x47, 0xcc, 0x8a, 0x9a, 0x54, 0x49, 0xe4, 0x0f, 0x94, 0x18, 0x6d, 0x42,
-	0x2d, 0x73, 0xb3, 0x0e, 0x31, 0x15, 0xdd, 0x90, 0x0d, 0x02, 0xb3, 0xba, 0x66, 0xac, 0x5f, 0xb1,
-	0x96, 0x53, 0xdd, 0x87, 0xb1, 0x0a, 0xfd, 0x10, 0x4c, 0xda, 0x71, 0x6c, 0x9f, 0x1c, 0x09, 0x3b,
-	0xdd, 0xbb, 0xdd, 0xc3, 0xbc, 0x67, 0x2e, 0xad, 0x19, 0xeb, 0x0b, 0xd6, 0x5b, 0xb4, 0xe3, 0x3c,
-	0x24, 0x47, 0x22, 0x39, 0x24, 0xfe, 0x3e, 0xe6, 0x3d, 0xf4, 0x7b, 0x03, 0x56, 0x13, 0x03, 0x9b,
-	0x13, 0x4f, 0xd3, 0x0c, 0xf6, 0x64, 0x16, 0xca, 0x9f, 0x26, 0x52, 0x87, 0x75, 0xad, 0xa9, 0x83,
-	0x26, 0xb3, 0xaf, 0xa9, 0x09, 0xad, 0x79, 0x9b, 0x51, 0x7f, 0xfb, 0x86, 0x4c, 0x80, 0x3f, 0x7f,
-	0x51, 0x5f, 0x9f, 0x22, 0x01, 0xa4, 0x01, 0xb7, 0xbe, 0x9e, 0xb8, 0xdc, 0x8f, 0x3d, 0xb6, 0x13,
-	0x87, 0xe8, 0x37, 0xb0, 0xdc, 0x63, 0x9e, 0x4b, 0xfd, 0x2e, 0xcf, 0xae, 0x63, 0xf9, 0xf2, 0xd7,
-	0x81, 0x62, 0x3f, 0x19, 0xef, 0xdf, 0x81, 0x25, 0x95, 0xec, 0x24, 0x60, 0x4e, 0xcf, 0xee, 0x11,
-	0xda, 0xed, 0x09, 0xb3, 0xb6, 0x66, 0xac, 0xcf, 0x5a, 0x15, 0xa9, 0xb8, 0x23, 0xe5, 0xef, 0x2b,
-	0xf1, 0xca, 0x00, 0x6a, 0xa7, 0x25, 0x07, 0xaa, 0xc2, 0xec, 0x13, 0x72, 0xac, 0x89, 0x5a, 0xfe,
-	0x44, 0xf7, 0x60, 0x6e, 0x88, 0xbd, 0x01, 0x51, 0xe4, 0x5c, 0xde, 0xda, 0x3c, 0x47, 0x36, 0x47,
-	0xc0, 0x56, 0x64, 0x7f, 0x2b, 0x77, 0xd3, 0x68, 0x3c, 0xcb, 0x01, 0xa4, 0x0c, 0x84, 0xb6, 0x60,
-	0x3e, 0x26, 0x48, 0xe5, 0x71, 0xdb, 0x7c, 0xf1, 0x7c, 0xa3, 0xa6, 0x8f, 0x49, 0x73, 0xd2, 0xbe,
-	0x08, 0xa9, 0xdf, 0xb5, 0xe2, 0x89, 0x88, 0xc0, 0x7c, 0x07, 0x7b, 0x92, 0x13, 0xcd, 0xdc, 0xe5,
-	0x9f, 0x6b, 0x8c, 0x8d, 0xbe, 0x06, 0xa5, 0x80, 0x85, 0xc2, 0xf6, 0x71, 0x9f, 0xa8, 0xaa, 0x53,
-	0xb2, 0x8a, 0x52, 0xf0, 0x10, 0xf7, 0x09, 0xda, 0xf8, 0xbf, 0x15, 0xa4, 0x74, 0x5a, 0x4d, 0x78,
-	0x17, 0x96, 0x34, 0x6c, 0xe6, 0x4e, 0xcc, 0xa9, 0x3b, 0x51, 0xd5, 0x8a, 0xe4, 0x42, 0x34, 0xfe,
-	0x9a, 0x87, 0xea, 0x87, 0x09, 0x84, 0x45, 0x1c, 0x16, 0x8e, 0x16, 0x49, 0x63, 0xb4, 0x48, 0xfe,
-	0x00, 0x4a, 0x9a, 0xc7, 0x59, 0x18, 0x15, 0xd0, 0x33, 0x4e, 0x31, 0x9d, 0x2a, 0xed, 0x92, 0x5c,
-	0x8e, 0x36, 0x78, 0x96, 0x5d, 0x32, 0x55, 0xda, 0x85, 0xc4, 0xa1, 0x01, 0x95, 0x74, 0x94, 0x9f,
-	0x64, 0x97, 0x4c, 0x45, 0x4f, 0xa1, 0x80, 0xfb, 0x32, 0xea, 0xba, 0x16, 0x9e, 0x11, 0xb6, 0x9f,
-	0x6a, 0x5e, 0xfe, 0xf6, 0x94, 0x61, 0x7b, 0xf1, 0x7c, 0xa3, 0xac, 0xc1, 0xe4, 0xd0, 0xd2, 0x8e,
-	0xd0, 0x47, 0x50, 0xee, 0x0c, 0x42, 0xdf, 0xd6, 0x7e, 0x0b, 0x5f, 0xb5, 0x5f, 0x90, 0xde, 0xda,
-	0x91, 0xef, 0xab, 0x50, 0x10, 0x47, 0x8a, 0xc5, 0xa2, 0xfa, 0xa9, 0x47, 0x52, 0xce, 0x05, 0x16,
-	0x03, 0xae, 0x6a, 0xe6, 0x9c, 0xa5, 0x47, 0xe8, 0x01, 0x54, 0x1c, 0xd6, 0x0f, 0x3c, 0xa2, 0x48,
-	0x4c, 0xb6, 0x54, 0xaa, 0x68, 0x96, 0xb7, 0x56, 0x9a, 0x51, 0xbf, 0xd5, 0x8c, 0xfb, 0xad, 0xe6,
-	0xa3, 0xb8, 0xdf, 0xda, 0x2e, 0xca, 0x05, 0x7f, 0xfc, 0x45, 0xdd, 0xb0, 0x16, 0x53, 0x63, 0xa9,
-	0x6e, 0xfc, 0xdb, 0x80, 0xc5, 0x47, 0x21, 0xf6, 0xf9, 0x01, 0x09, 0x75, 0x0e, 0xdd, 0x80, 0x82,
-	0x24, 0x78, 0x12, 0x4e, 0xbc, 0x6b, 0x7a, 0xde, 0x68, 0xa8, 0x73, 0x17, 0x09, 0xf5, 0xec, 0x1b,
-	0x0a, 0x75, 0xe3, 0xf3, 0x59, 0x28, 0x25, 0xbc, 0x83, 0xda, 0x50, 0x19, 0x62, 0x8f, 0x05, 0x24,
-	0xb4, 0xa7, 0xe5, 0x97, 0x45, 0x6d, 0xd0, 0x4e, 0x68, 0x46, 0xc6, 0xa3, 0x4f, 0x39, 0x4f, 0x9a,
-	0x86, 0xdc, 0x65, 0x34, 0x28, 0x29, 0xa8, 0x6a, 0x18, 0xba, 0x50, 0x4d, 0xae, 0xa4, 0xcd, 0x7b,
-	0x38, 0x24, 0x5c, 0x5f, 0xc6, 0x2f, 0xe7, 0xa7, 0x92, 0xa0, 0xee, 0x2b, 0x50, 0x64, 0xc3, 0xc2,
-	0x90, 0x09, 0xea, 0x77, 0xed, 0x80, 0x1d, 0x92, 0x50, 0xdf, 0xdc, 0xf3, 0x38, 0xd9, 0xf5, 0x45,
-	0xc6, 0xc9, 0xae, 0x2f, 0xac, 0x72, 0x84, 0xb8, 0x27, 0x01, 0x91, 0x05, 0x73, 0xdc, 0x61, 0x21,
-	0x51, 0xd7, 0xfb, 0xcb, 0x2e, 0x3f, 0x82, 0x6a, 0xfc, 0xd1, 0x80, 0xca, 0x4e, 0xbc, 0x11, 0xdd,
-	0xad, 0x8c, 0xf0, 0x9d, 0x31, 0x3d, 0xdf, 0xdd, 0x87, 0xf9, 0xa8, 0x87, 0xe2, 0xba, 0x6e, 0x5c,
-	0xa0, 0x92, 0xc5, 0x08, 0x8d, 0xbf, 0x19, 0x50, 0x19, 0x53, 0x5e, 0x46, 0xd2, 0xf9, 0x50, 0x38,
-	0x8c, 0xca, 0x76, 0x94, 0x6b, 0x1f, 0x9c, 0xef, 0x10, 0xff, 0xf3, 0xb2, 0x7e, 0xf5, 0x18, 0xf7,
-	0xbd, 0x5b, 0x8d, 0x90, 0x78, 0x58, 0xd0, 0x21, 0xb1, 0x23, 0xb8, 0xc6, 0xd8, 0xf1, 0x16, 0x62,
-	0x71, 0x0e, 0x60, 0x27, 0x79, 0x04, 0xa0, 0x7b, 0x80, 0x4e, 0x3e, 0x2e, 0x26, 0x6e, 0x62, 0xe9,
-	0xc4, 0x33, 0x02, 0xdd, 0x81, 0xa5, 0xb4, 0x35, 0x8b, 0x71, 0x26, 0x11, 0x48, 0x35, 0x31, 0x89,
-	0x61, 0xde, 0x3c, 0x8f, 0x48, 0x7a, 0xd6, 0x8d, 0x53, 0x5e, 0x35, 0x4e, 0x7a, 0x24, 0x9b, 0x60,
-	0xf9, 0x86, 0x48, 0x0e, 0x47, 0x76, 0xca, 0x73, 0x51, 0x6b, 0x95, 0x95, 0xdf, 0xf1, 0xdd, 0xc6,
-	0x3e, 0x2c, 0xef, 0xb1, 0x50, 0xdc, 0x4e, 0x1e, 0xb9, 0x8f, 0x06, 0x81, 0x37, 0xe5, 0x63, 0xf8,
-	0x6d, 0x98, 0x57, 0x5d, 0x47, 0xf2, 0x16, 0x2e, 0xc8, 0xe1, 0xae, 0xdb, 0xf8, 0xdc, 0x80, 0x79,
-	0x8b, 0x38, 0x84, 0x06, 0xe2, 0xac, 0x66, 0x20, 0xe5, 0xf8, 0xdc, 0x94, 0x1c, 0x9f, 0xd6, 0xa9,
-	0xd9, 0x91, 0x3a, 0xe5, 0x24, 0x67, 0x9f, 0xbf, 0xfc, 0x2e, 0x2b, 0x66, 0xed, 0xff, 0x1a, 0xb0,
-	0x98, 0xe6, 0xdf, 0x9e, 0x87, 0x7d, 0xb4, 0x03, 0x27, 0xf2, 0x60, 0x62, 0x06, 0x9e, 0xcc, 0x9c,
-	0x9d, 0x0c, 0xad, 0xb6, 0xa7, 0xcd, 0xbf, 0x71, 0x0b, 0x84, 0xe3, 0xd6, 0x77, 0xf6, 0xf2, 0x8f,
-	0x20, 0x42, 0x6e, 0xfc, 0x21, 0x07, 0x85, 0x3d, 0x1c, 0xe2, 0x3e, 0x47, 0x37, 0xc1, 0xcc, 0xde,
-	0x3e, 0xfd, 0x08, 0x57, 0xff, 0xaa, 0x13, 0xc8, 0x5b, 0x57, 0x33, 0x37, 0x2d, 0x52, 0xdf, 0x56,
-	0x49, 0x7b, 0x5d, 0xee, 0x36, 0xfa, 0x4e, 0xa2, 0x68, 0x6c, 0x88, 0x3d, 0xb5, 0xdb, 0xbc, 0x15,
-	0x7f, 0x3f, 0xd9, 0xd5, 0x62, 0xf4, 0x1e, 0xbc, 0x95, 0xbe, 0xb2, 0x48, 0x66, 0xfe, 0xac, 0x9a,
-	0x5f, 0xcb, 0x2a, 0x13, 0xa3, 0x53, 0x6a, 0x61, 0xfe, 0xf2, 0x6b, 0xe1, 0xad, 0xe2, 0x27, 0xcf,
-	0xea, 0x33, 0xff, 0x7a, 0x56, 0x37, 0x1a, 0xbf, 0x05, 0x94, 0xa6, 0x05, 0xbf, 0xcb, 0x42, 0xf5,
-	0x39, 0xe9, 0x8c, 0xbc, 0x7f, 0x08, 0xe5, 0xf4, 0x6c, 0x62, 0x82, 0x9f, 0xe2, 0x6b, 0x48, 0xea,
-	0xc5, 0xca, 0x02, 0x34, 0xfe, 0x94, 0x83, 0xab, 0xa3, 0x89, 0x39, 0xcd, 0x2a, 0x8e, 0x92, 0xac,
-	0x93, 0xe7, 0x14, 0x48, 0x2b, 0xbd, 0x94, 0x07, 0xe7, 0x59, 0x4a, 0xd6, 0xdd, 0xb8, 0x58, 0x3f,
-	0xe1, 0xdd, 0x51, 0xe9, 0x8a, 0x80, 0xda, 0x69, 0x13, 0x4f, 0x79, 0xce, 0xdd, 0x1d, 0x7d, 0xce,
-	0xdd, 0x38, 0xef, 0xc2, 0xb2, 0xaf, 0xb9, 0xbf, 0x18, 0xf0, 0xf6, 0x58, 0x79, 0x9e, 0xe6, 0x98,
-	0x7e, 0x0d, 0x99, 0x92, 0x11, 0x7f, 0xd8, 0x98, 0xba, 0x26, 0x8f, 0x39, 0xb4, 0x32, 0x47, 0xae,
-	0x0b, 0xf1, 0x0a, 0x14, 0xb9, 0x8f, 0x03, 0xde, 0x63, 0x51, 0xe1, 0x28, 0x5a, 0xc9, 0xb8, 0xf1,
-	0xbb, 0x39, 0x58, 0xb8, 0x17, 0x7d, 0x41, 0xdd, 0x17, 0xb2, 0x01, 0xbb, 0x0b, 0x85, 0x40, 0xdd,
-	0x3f, 0xb5, 0xca, 0xf2, 0xd6, 0xfa, 0xe4, 0x15, 0x44, 0xf7, 0x75, 0x3b, 0x2f, 0x93, 0xdf, 0xd2,
-	0xd6, 0x68, 0x1b, 0xe6, 0x3e, 0x62, 0x3e, 0x89, 0x03, 0xfe, 0xce, 0x74, 0x5f, 0x68, 0x34, 0x48,
-	0x64, 0x8a, 0xee, 0x43, 0x31, 0x8c, 0x38, 0x9e, 0x6b, 0xca, 0xb9, 0x3e, 0x19, 0x46, 0x57, 0x05,
-	0x8d, 0x94, 0x00, 0xa0, 0x5f, 0x8e, 0x5e, 0x89, 0x88, 0xc5, 0xbf, 0x77, 0x9e, 0x70, 0xc7, 0xb1,
-	0xd4, 0xd0, 0x59, 0x38, 0x44, 0x4f, 0x49, 0xf5, 0x39, 0xe5, 0xe2, 0xe6, 0x45, 0x53, 0x5d, 0xbb,
-	0x19, 0xcf, 0x6d, 0xe4, 0x25, 0xe9, 0xc2, 0x42, 0x3b, 0x6e, 0xe1, 0xa2, 0xef, 0x9d, 0x3f, 0x3a,
-	0x77, 0xba, 0x8c, 0x39, 0x4b, 0x39, 0x5f, 0xab, 0xd1, 0x01, 0x54, 0x55, 0x05, 0x4e, 0xcb, 0x32,
-	0x37, 0xe7, 0x95, 0xb3, 0xef, 0x4f, 0x91, 0x19, 0x27, 0xeb, 0x7e, 0xbc, 0xab, 0x60, 0x44, 0xc5,
-	0xb7, 0x1f, 0x7f, 0xfa, 0x6a, 0xd5, 0xf8, 0xec, 0xd5, 0xaa, 0xf1, 0xcf, 0x57, 0xab, 0xc6, 0xc7,
-	0xaf, 0x57, 0x67, 0x3e, 0x7b, 0xbd, 0x3a, 0xf3, 0xf7, 0xd7, 0xab, 0x33, 0x8f, 0x7f, 0x96, 0x21,
-	0x53, 0xea, 0x77, 0x89, 0x3f, 0xa0, 0xe2, 0x78, 0xa3, 0x33, 0xa0, 0x9e, 0xdb, 0xca, 0xfe, 0x75,
-	0xe0, 0xe8, 0x94, 0xbf, 0x0f, 0x28, 0xaa, 0xed, 0x14, 0xd4, 0x53, 0xf1, 0xbd, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x5b, 0xaa, 0x39, 0x52, 0x4d, 0x18, 0x00, 0x00,
+	// 2572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcb, 0x73, 0x23, 0x47,
+	0x19, 0xdf, 0x91, 0x65, 0x59, 0xfa, 0x64, 0x5b, 0x72, 0xaf, 0xe3, 0x9d, 0x75, 0x36, 0x96, 0x19,
+	0x8a, 0xe0, 0x24, 0xac, 0x94, 0xdd, 0x84, 0x10, 0x16, 0x8a, 0xc2, 0xde, 0x57, 0x4c, 0x70, 0xd6,
+	0x35, 0xde, 0x64, 0xab, 0x12, 0x60, 0xaa, 0xa5, 0x69, 0x8f, 0x86, 0x9d, 0x99, 0x9e, 0x9d, 0x6e,
+	0xc9, 0x76, 0x48, 0x15, 0x39, 0x71, 0xa4, 0xc2, 0x85, 0xe2, 0x44, 0xa5, 0x8a, 0x1b, 0xa7, 0x1c,
+	0x72, 0xe6, 0xc4, 0x21, 0x17, 0xa8, 0x90, 0x70, 0xa0, 0x38, 0x6c, 0xa8, 0xe4, 0xc2, 0x85, 0x4b,
+	0xfe, 0x01, 0xa8, 0xee, 0xe9, 0x79, 0xe8, 0x81, 0x25, 0x6d, 0x9c, 0x5c, 0x76, 0xd5, 0x5f, 0x77,
+	0xff, 0xbe, 0x7e, 0x7c, 0x8f, 0x5f, 0x7f, 0x63, 0x68, 0x3e, 0xe8, 0xb9, 0x9d, 0xfb, 0xcc, 0xf5,
+	0xfa, 0x24, 0x6a, 0xb9, 0x01, 0x27, 0x51, 0xa7, 0x8b, 0xdd, 0x80, 0x71, 0x7c, 0xdf, 0x0d, 0x9c,
+	0x56, 0xff, 0x4a, 0xcb, 0x21, 0x01, 0x61, 0x2e, 0x6b, 0x86, 0x11, 0xe5, 0x14, 0x6d, 0xe6, 0xc6,
+	0x37, 0x47, 0xc6, 0x37, 0xfb, 0x57, 0xd6, 0x57, 0x1d, 0xea, 0x50, 0x39, 0xb8, 0x25, 0x7e, 0xc5,
+	0xf3, 0xd6, 0x2f, 0x76, 0x28, 0xf3, 0x29, 0xb3, 0xe2, 0x8e, 0xb8, 0xa1, 0xba, 0x36, 0xe2, 0x56,
+	0xab, 0x8d, 0x19, 0x69, 0xf5, 0xaf, 0xb4, 0x09, 0xc7, 0x57, 0x5a, 0x1d, 0xea, 0x06, 0xaa, 0xff,
+	0x92, 0xea, 0x77, 0x68, 0x3f, 0xed, 0x76, 0x68, 0x5f, 0xf5, 0x36, 0x1c, 0x4a, 0x1d, 0x8f, 0xb4,
+	0x64, 0xab, 0xdd, 0x3b, 0x6c, 0x71, 0xd7, 0x27, 0x8c, 0x63, 0x3f, 0x8c, 0x07, 0x18, 0x7f, 0xad,
+	0x41, 0xf1, 0x75, 0x1a, 0x10, 0xf4, 0x75, 0x58, 0xea, 0xd0, 0x20, 0x20, 0x1d, 0xee, 0xd2, 0xc0,
+	0x72, 0x6d, 0x5d, 0xdb, 0xd4, 0xb6, 0x2a, 0xe6, 0x62, 0x26, 0xdc, 0xb5, 0xd1, 0x45, 0x28, 0xcb,
+	0x0d, 0x89, 0xfe, 0x82, 0xec, 0x5f, 0x90, 0xed, 0x5d, 0x1b, 0xbd, 0x0a, 0x35, 0x9b, 0x84, 0x94,
+	0xb9, 0xdc, 0xc2, 0xb6, 0x1d, 0x11, 0xc6, 0xf4, 0xb9, 0x4d, 0x6d, 0xab, 0x7a, 0xf5, 0x5b, 0xcd,
+	0x49, 0x87, 0xd2, 0xdc, 0xbd, 0xbe, 0xbd, 0xdd, 0xe9, 0xd0, 0x5e, 0xc0, 0xcd, 0x65, 0x05, 0xb2,
+	0x1d, 0x63, 0xa0, 0x37, 0x00, 0x1d, 0xb9, 0xbc, 0x6b, 0x47, 0xf8, 0x08, 0x7b, 0x29, 0x72, 0xf1,
+	0x11, 0x90, 0x57, 0x32, 0x9c, 0x04, 0xfc, 0xa7, 0x70, 0x3e, 0x24, 0xd1, 0x21, 0x8d, 0x7c, 0x1c,
+	0x74, 0x48, 0x8a, 0x3e, 0xff, 0x08, 0xe8, 0x28, 0x07, 0x94, 0xc0, 0x5b, 0xb0, 0x6a, 0x13, 0x8f,
+	0x38, 0x58, 0x1e, 0xa9, 0x42, 0x27, 0x4c, 0x2f, 0x6d, 0xce, 0xcd, 0x8c, 0x7f, 0x3e, 0x43, 0xda,
+	0x4e, 0x80, 0xd0, 0x37, 0x60, 0x19, 0xc7, 0xfd, 0x56, 0x18, 0x91, 0x43, 0xf7, 0x58, 0x5f, 0x90,
+	0x97, 0xb2, 0xa4, 0xa4, 0xfb, 0x52, 0x88, 0x1a, 0x50, 0xf5, 0x68, 0x07, 0x7b, 0x96, 0x4d, 0x02,
+	0xea, 0xeb, 0x65, 0x39, 0x06, 0xa4, 0xe8, 0x86, 0x90, 0xa0, 0x27, 0x00, 0x84, 0x79, 0xa9, 0xfe,
+	0x8a, 0xec, 0xaf, 0x08, 0x49, 0xdc, 0x4d, 0xa0, 0x16, 0x11, 0x9b, 0xf8, 0xa1, 0xdc, 0x47, 0x84,
+	0x39, 0xd1, 0x41, 0x8c, 0xd9, 0xf9, 0xfe, 0x07, 0x0f, 0x1b, 0xe7, 0xfe, 0xf9, 0xb0, 0xf1, 0xa4,
+	0xe3, 0xf2, 0x6e, 0xaf, 0xdd, 0xec, 0x50, 0x5f, 0x19, 0xaf, 0xfa, 0xef, 0x32, 0xb3, 0xef, 0xb7,
+	0xf8, 0x49, 0x48, 0x58, 0xf3, 0x06, 0xe9, 0x7c, 0xf4, 0xfe, 0x65, 0x88, 0xe5, 0xa2, 0x65, 0x2e,
+	0x67, 0xa0, 0x26, 0xe6, 0x04, 0x05, 0xb0, 0xea, 0x61, 0xc6, 0xad, 0x61, 0x5d, 0xd5, 0x33, 0xd0,
+	0x85, 0x04, 0xb2, 0x39, 0xa8, 0xef, 0x65, 0x80, 0x3e, 0xf6, 0x5c, 0x1b, 0x73, 0x1a, 0x31, 0x7d,
+	0x51, 0x5e, 0xca, 0x33, 0x93, 0x2f, 0xe5, 0xb5, 0x64, 0x8e, 0x99, 0x9b, 0x8e, 0x0e, 0xa1, 0x8e,
+	0x1d, 0x27, 0x12, 0x57, 0x44, 0x2c, 0x31, 0x2f, 0xe0, 0xfa, 0x92, 0x84, 0xfc, 0xde, 0x64, 0x48,
+	0xe1, 0x80, 0xcd, 0xed, 0x64, 0xfa, 0xae, 0x9c, 0x7d, 0x33, 0xe0, 0xd1, 0x89, 0x59, 0xc3, 0x83,
+	0x52, 0x71, 0x55, 0x7e, 0xcf, 0xe3, 0xae, 0xc5, 0x48, 0x60, 0xeb, 0xcb, 0x9b, 0xda, 0x56, 0xd9,
+	0xac, 0x48, 0xc9, 0x01, 0x09, 0x6c, 0xf4, 0x14, 0xd4, 0x3d, 0xf7, 0x41, 0xcf, 0xb5, 0x5d, 0x7e,
+	0x62, 0xf9, 0xd4, 0xee, 0x79, 0x44, 0xaf, 0xc9, 0x41, 0xb5, 0x54, 0xbe, 0x27, 0xc5, 0xe8, 0x0a,
+	0xac, 0xe6, 0x3c, 0xeb, 0x08, 0xbb, 0xdc, 0x89, 0x68, 0x2f, 0xd4, 0xeb, 0x9b, 0xda, 0xd6, 0x92,
+	0x79, 0x3e, 0xeb, 0xbb, 0x97, 0x74, 0xa1, 0xef, 0x80, 0xee, 0xb6, 0x3b, 0x56, 0x40, 0x8e, 0xb9,
+	0x95, 0xed, 0xdd, 0xea, 0x62, 0xd6, 0xd5, 0x57, 0x36, 0xb5, 0xad, 0x45, 0xf3, 0x31, 0xb7, 0xdd,
+	0x79, 0x85, 0x1c, 0xf3, 0xf4, 0x90, 0xd8, 0x4b, 0x98, 0x75, 0xd1, 0x6f, 0x34, 0xd8, 0x48, 0x27,
+	0x58, 0x8c, 0x78, 0x2a, 0xcc, 0x60, 0x4f, 0x58, 0xa1, 0xf8, 0xa9, 0x23, 0x79, 0x58, 0x17, 0x9b,
+	0xea, 0xd2, 0x84, 0xf5, 0x35, 0x55, 0x3c, 0x6b, 0x5e, 0xa7, 0x6e, 0xb0, 0xf3, 0xac, 0x30, 0x80,
+	0x3f, 0x7e, 0xd2, 0xd8, 0x9a, 0xc2, 0x00, 0xc4, 0x04, 0x66, 0x5e, 0x4a, 0x55, 0x1e, 0x24, 0x1a,
+	0xb7, 0x53, 0x85, 0xe8, 0x2d, 0x38, 0xdf, 0xa5, 0x9e, 0xed, 0x06, 0x0e, 0xcb, 0xaf, 0xe3, 0xfc,
+	0xd9, 0xaf, 0x03, 0x25, 0x7a, 0x72, 0xda, 0x9f, 0x86, 0x15, 0x69, 0xec, 0x24, 0xa4, 0x9d, 0xae,
+	0xd5, 0x25, 0xae, 0xd3, 0xe5, 0xfa, 0xea, 0xa6, 0xb6, 0x35, 0x67, 0xd6, 0x44, 0xc7, 0x4d, 0x21,
+	0x7f, 0x49, 0x8a, 0xd1, 0x06, 0x54, 0x7d, 0x7c, 0x6c, 0xf1, 0x63, 0xcb, 0x67, 0x0e, 0xd3, 0x1f,
+	0xdb, 0xd4, 0xb6, 0x8a, 0x66, 0xc5, 0xc7, 0xc7, 0x77, 0x8f, 0xf7, 0x98, 0xc3, 0xd0, 0x25, 0x00,
+	0xd5, 0xef, 0x60, 0xa6, 0xaf, 0xc9, 0xee, 0xb2, 0xec, 0xbe, 0x8d, 0x19, 0x8a, 0x60, 0x2d, 0x33,
+	0x89, 0x76, 0xef, 0xf0, 0x90, 0x44, 0xc2, 0xaf, 0x5c, 0xaa, 0x5f, 0x38, 0x03, 0xc7, 0x5a, 0x4d,
+	0xb1, 0x77, 0x24, 0xb4, 0x29, 0x90, 0x91, 0x03, 0xf5, 0x61, 0x9d, 0xba, 0x3e, 0xb3, 0xb6, 0xdd,
+	0x80, 0xe7, 0xb4, 0xed, 0x06, 0xdc, 0xac, 0x0d, 0x69, 0x43, 0x7b, 0x50, 0x11, 0x91, 0xcf, 0x93,
+	0xc7, 0x77, 0x51, 0xc6, 0xed, 0xd6, 0x74, 0xfe, 0xb6, 0x9f, 0x4c, 0x33, 0x33, 0x04, 0xf4, 0x23,
+	0x28, 0x85, 0x38, 0xc2, 0x3e, 0xd3, 0xd7, 0xa7, 0xcd, 0x01, 0x12, 0x4b, 0xce, 0xd9, 0x29, 0x8a,
+	0xbd, 0x99, 0x0a, 0x61, 0xbd, 0x07, 0xab, 0xe3, 0x5c, 0x1a, 0xd5, 0x61, 0xee, 0x3e, 0x39, 0x51,
+	0xe9, 0x55, 0xfc, 0x44, 0xb7, 0x61, 0xbe, 0x8f, 0xbd, 0x1e, 0x91, 0x29, 0xb5, 0x7a, 0xf5, 0xca,
+	0x0c, 0x31, 0x28, 0x06, 0x36, 0xe3, 0xf9, 0xd7, 0x0a, 0x2f, 0x6a, 0xc6, 0xaf, 0x0a, 0x00, 0xd9,
+	0x9a, 0xd0, 0x2d, 0xa8, 0x27, 0x69, 0x59, 0x22, 0xf5, 0xb1, 0x27, 0x55, 0x17, 0x77, 0x1e, 0xff,
+	0xfc, 0x61, 0xe3, 0xc2, 0x09, 0xf6, 0xbd, 0x6b, 0xc6, 0xf0, 0x08, 0xc3, 0x4c, 0x72, 0xf9, 0xae,
+	0x92, 0xa0, 0x7b, 0xb0, 0x96, 0x77, 0xe0, 0x1c, 0x5a, 0x41, 0xa2, 0x7d, 0xed, 0xf3, 0x87, 0x8d,
+	0x27, 0x62, 0xb4, 0xf1, 0xe3, 0x0c, 0x73, 0x35, 0xe7, 0x8e, 0x03, 0xc0, 0x2a, 0xb5, 0x11, 0x2b,
+	0x49, 0x66, 0xf2, 0x5f, 0x7d, 0x6e, 0x18, 0x78, 0xfc, 0x38, 0xc3, 0x4c, 0xb2, 0x2c, 0x51, 0xc9,
+	0xf2, 0xba, 0x14, 0xbf, 0xad, 0xc1, 0xd2, 0xc0, 0x45, 0xcb, 0x34, 0x47, 0x03, 0x5b, 0xa5, 0x39,
+	0x2d, 0x8e, 0x9d, 0x42, 0x12, 0xa7, 0xb9, 0xd1, 0x6c, 0x5a, 0x90, 0x43, 0x86, 0xb2, 0xe9, 0x53,
+	0x50, 0x17, 0xe1, 0xe2, 0x88, 0xd8, 0x96, 0x4f, 0x18, 0xc3, 0x0e, 0x89, 0x99, 0x4e, 0xd9, 0xac,
+	0x29, 0xf9, 0x9e, 0x12, 0x1b, 0xef, 0x16, 0x00, 0xb2, 0x1c, 0x8e, 0xae, 0xc2, 0x42, 0x42, 0x31,
+	0xe4, 0xed, 0xef, 0xe8, 0x1f, 0xbd, 0x7f, 0x79, 0x55, 0x99, 0xb7, 0xca, 0xea, 0x07, 0x3c, 0x72,
+	0x03, 0xc7, 0x4c, 0x06, 0x22, 0x02, 0x0b, 0x6d, 0xec, 0x09, 0x56, 0xa1, 0x17, 0xce, 0x3e, 0x32,
+	0x25, 0xd8, 0xe8, 0x71, 0xa8, 0x84, 0x34, 0xe2, 0x56, 0x80, 0x7d, 0x22, 0x77, 0x53, 0x31, 0xcb,
+	0x42, 0xf0, 0x0a, 0xf6, 0x09, 0xba, 0xfc, 0x7f, 0x39, 0x58, 0x65, 0x1c, 0xab, 0x7a, 0x06, 0x56,
+	0x14, 0x6c, 0x2e, 0xab, 0xcc, 0xcb, 0xac, 0x52, 0x57, 0x1d, 0x69, 0x4a, 0x31, 0xfe, 0xa6, 0x41,
+	0xed, 0xd5, 0x40, 0x5c, 0x82, 0xd8, 0x36, 0xe9, 0xd0, 0x68, 0x90, 0x65, 0x6a, 0x83, 0x2c, 0xf3,
+	0x12, 0x54, 0xd4, 0x65, 0xd3, 0x48, 0x31, 0xd0, 0x4c, 0x20, 0x7a, 0x53, 0x1b, 0x53, 0xbb, 0xc8,
+	0x04, 0xe8, 0x0e, 0xd4, 0x3b, 0xd4, 0x0f, 0x3d, 0x22, 0x53, 0x8f, 0x24, 0xc2, 0x7a, 0x51, 0x9e,
+	0xe9, 0x7a, 0x33, 0xa6, 0xc9, 0xcd, 0x84, 0x26, 0x37, 0xef, 0x26, 0x34, 0x79, 0xa7, 0x2c, 0x0e,
+	0xf5, 0x9d, 0x4f, 0x1a, 0x9a, 0x59, 0xcb, 0x66, 0xcb, 0x6e, 0xb4, 0x06, 0x25, 0x15, 0xb8, 0xe7,
+	0x65, 0xe0, 0x56, 0x2d, 0xe3, 0x2f, 0x25, 0xa8, 0xdf, 0x4b, 0x8f, 0x65, 0xf2, 0xa6, 0x5e, 0x18,
+	0xd9, 0xd4, 0x29, 0x96, 0x91, 0xdb, 0xee, 0x0b, 0x23, 0xdb, 0x3d, 0x6d, 0x5e, 0x76, 0x10, 0x2f,
+	0x40, 0x25, 0x22, 0x1d, 0x37, 0x74, 0x05, 0x49, 0x29, 0x4e, 0x9a, 0x97, 0x0e, 0x45, 0x0f, 0xa0,
+	0x84, 0x7d, 0xe9, 0x9a, 0x31, 0x43, 0x3e, 0xc5, 0x14, 0x7f, 0xa0, 0xc2, 0xfc, 0x37, 0xa7, 0x34,
+	0xc5, 0x8f, 0xde, 0xbf, 0x5c, 0x55, 0x60, 0xa2, 0x69, 0x2a, 0x45, 0xe8, 0x4d, 0xa8, 0xb6, 0x7b,
+	0x51, 0x60, 0x29, 0xbd, 0xa5, 0x2f, 0x5b, 0x2f, 0x08, 0x6d, 0xdb, 0xb1, 0xee, 0x35, 0x28, 0xf1,
+	0x63, 0xc9, 0x6d, 0x62, 0x56, 0xad, 0x5a, 0x42, 0xce, 0x38, 0xe6, 0x3d, 0x26, 0x99, 0xf4, 0xbc,
+	0xa9, 0x5a, 0x68, 0x0f, 0x6a, 0x43, 0xf6, 0x25, 0xa9, 0xf4, 0xb4, 0xe6, 0xb5, 0x3c, 0x68, 0x5e,
+	0xe8, 0x6d, 0x0d, 0x96, 0x99, 0x87, 0x59, 0x97, 0xd8, 0xc9, 0xf6, 0xe1, 0xcb, 0xde, 0xfe, 0x92,
+	0x52, 0xa8, 0x4e, 0xe0, 0x79, 0x28, 0x0b, 0x32, 0x4e, 0x7c, 0x12, 0xe9, 0xd5, 0x09, 0x76, 0x92,
+	0x8e, 0x14, 0x7e, 0xc6, 0x23, 0x1c, 0x30, 0x41, 0x34, 0xc4, 0x29, 0xd0, 0x1e, 0xd7, 0x17, 0x67,
+	0x38, 0x88, 0x5a, 0x32, 0xfb, 0x6e, 0x3c, 0xd9, 0xf8, 0x8f, 0x06, 0xcb, 0x77, 0x95, 0x4c, 0x79,
+	0xd3, 0xb3, 0x50, 0x62, 0x24, 0xb0, 0x49, 0x34, 0x31, 0x92, 0xaa, 0x71, 0x83, 0x46, 0x5f, 0x78,
+	0x14, 0xa3, 0x9f, 0xfb, 0x8a, 0x8c, 0xde, 0xf8, 0x78, 0x0e, 0x2a, 0x69, 0x86, 0x47, 0xdb, 0x50,
+	0xeb, 0x63, 0x8f, 0x86, 0x24, 0xb2, 0xa6, 0xcd, 0x1e, 0xcb, 0x6a, 0xc2, 0x76, 0x9a, 0x44, 0x84,
+	0x65, 0xfa, 0x2e, 0x63, 0xe9, 0xa3, 0xaa, 0x70, 0x16, 0x0f, 0xb8, 0x0c, 0x54, 0x3e, 0xa8, 0x1c,
+	0xa8, 0xa7, 0xc1, 0xc9, 0x62, 0x5d, 0x1c, 0xa9, 0xcc, 0xf8, 0x45, 0xf5, 0xd4, 0x52, 0xd4, 0x03,
+	0x09, 0x8a, 0x2c, 0x58, 0xec, 0x53, 0xee, 0x06, 0x8e, 0x15, 0xd2, 0x23, 0x12, 0xe9, 0xc5, 0x99,
+	0x95, 0x8c, 0x52, 0xcb, 0x6a, 0x8c, 0xb8, 0x2f, 0x00, 0x91, 0x09, 0xf3, 0xac, 0x43, 0x23, 0xa2,
+	0xcf, 0xcf, 0x8c, 0x3c, 0xba, 0xfc, 0x18, 0xca, 0x78, 0x0b, 0xea, 0x31, 0x5b, 0xbb, 0x91, 0xbe,
+	0xe4, 0x85, 0x83, 0x1d, 0x52, 0x49, 0x1a, 0x26, 0x1b, 0x72, 0x3a, 0x52, 0xf0, 0x88, 0x4e, 0x2f,
+	0x9a, 0x2a, 0x5b, 0x24, 0x03, 0x8d, 0x5f, 0x40, 0x7d, 0x9f, 0xc8, 0x24, 0x7b, 0x27, 0x24, 0x51,
+	0xac, 0xfd, 0x94, 0x94, 0x84, 0xa0, 0xe8, 0x13, 0x9f, 0xaa, 0x14, 0x2b, 0x7f, 0xa3, 0x4d, 0xa8,
+	0xd2, 0x1e, 0x67, 0x1c, 0x4b, 0x18, 0x79, 0xb3, 0x4b, 0x66, 0x5e, 0x24, 0x22, 0xe3, 0x21, 0x76,
+	0x3d, 0x62, 0xcb, 0x1b, 0x59, 0x32, 0x55, 0xcb, 0x78, 0x4f, 0x83, 0xc5, 0x97, 0x28, 0xe3, 0xfb,
+	0x11, 0x0d, 0x29, 0xc3, 0xde, 0x69, 0x9a, 0x1b, 0x50, 0x0d, 0xd5, 0xb0, 0xa4, 0xca, 0x54, 0x34,
+	0x21, 0x11, 0xed, 0xda, 0xe8, 0xc7, 0x50, 0x53, 0x97, 0x4f, 0x02, 0x3b, 0x0e, 0xb3, 0x73, 0x33,
+	0x44, 0x97, 0xa5, 0x78, 0xf2, 0xcd, 0xc0, 0x16, 0xbd, 0xb9, 0x60, 0x5e, 0xcc, 0x07, 0x73, 0xe3,
+	0x4f, 0x1a, 0x94, 0xc5, 0x92, 0x5f, 0xa3, 0x9c, 0x7c, 0xa1, 0xe5, 0x36, 0x61, 0xbe, 0x4f, 0x39,
+	0x99, 0x9c, 0xa0, 0xe3, 0x61, 0xe8, 0x16, 0x2c, 0x50, 0x59, 0xa3, 0x48, 0xc8, 0xc9, 0x93, 0x49,
+	0xc0, 0x11, 0x55, 0xbd, 0x24, 0xde, 0xdc, 0x93, 0x4c, 0x83, 0xd8, 0x62, 0x79, 0x77, 0xe4, 0x70,
+	0xf5, 0xfa, 0x48, 0x26, 0x1b, 0xbf, 0xd5, 0xa0, 0x76, 0x23, 0xf1, 0x1b, 0x55, 0x3c, 0x18, 0x20,
+	0x1a, 0xda, 0xf4, 0x44, 0xe3, 0x65, 0x58, 0x88, 0x4b, 0x1a, 0x4c, 0x91, 0xd0, 0x47, 0x78, 0xa2,
+	0x24, 0x08, 0xc6, 0x9f, 0x35, 0xa8, 0x0d, 0x75, 0x9e, 0x45, 0x8c, 0x0b, 0xa0, 0x74, 0x14, 0x93,
+	0xb1, 0xd8, 0x27, 0x5e, 0x9b, 0xcd, 0x67, 0x3f, 0x7f, 0xd8, 0x58, 0x8b, 0x5f, 0x19, 0x11, 0xf1,
+	0x30, 0x77, 0xfb, 0xc4, 0x8a, 0xe1, 0x8c, 0x21, 0x6f, 0x2e, 0x25, 0xe2, 0x02, 0x40, 0xce, 0x93,
+	0x6f, 0x03, 0x1a, 0xad, 0xf5, 0x4d, 0xdc, 0xc4, 0xca, 0x48, 0x55, 0x0f, 0xdd, 0x84, 0x95, 0xec,
+	0x01, 0x95, 0xe0, 0x4c, 0x72, 0xf3, 0x7a, 0x3a, 0x25, 0x81, 0xf9, 0xea, 0xd3, 0x56, 0x8e, 0x0e,
+	0x17, 0xf3, 0x74, 0x58, 0x3c, 0x98, 0x22, 0x92, 0x6d, 0x54, 0xb8, 0xad, 0x22, 0xcc, 0xb5, 0xbc,
+	0xfc, 0x66, 0x60, 0x1b, 0x07, 0x70, 0x7e, 0x9f, 0x46, 0xfc, 0x7a, 0x5a, 0x73, 0xbe, 0xdb, 0x0b,
+	0xbd, 0x29, 0x6b, 0xd3, 0x17, 0x60, 0x41, 0x3e, 0x61, 0xd2, 0xd2, 0x74, 0x49, 0x34, 0x77, 0x6d,
+	0xe3, 0x63, 0x0d, 0x16, 0x4c, 0xd2, 0x21, 0x6e, 0xc8, 0x4f, 0xf3, 0xe4, 0x8c, 0x52, 0x14, 0xa6,
+	0xa4, 0x14, 0x19, 0x41, 0x9c, 0x1b, 0x20, 0x88, 0x9d, 0xf4, 0xec, 0x8b, 0x67, 0xff, 0x64, 0x4b,
+	0x48, 0xc2, 0x7f, 0x35, 0x58, 0xce, 0xec, 0x6f, 0xdf, 0xc3, 0x01, 0xba, 0x01, 0x23, 0x76, 0x30,
+	0xd1, 0x02, 0x47, 0x2d, 0xe7, 0x46, 0x2e, 0x8b, 0x6f, 0x4f, 0x6b, 0x7f, 0xc3, 0x33, 0x10, 0x4e,
+	0x6a, 0x1a, 0x73, 0x67, 0x7f, 0x04, 0x31, 0xb2, 0xf1, 0xf7, 0x22, 0x94, 0x54, 0x95, 0xe3, 0x45,
+	0xd0, 0xf3, 0xde, 0x37, 0x50, 0x46, 0x90, 0xd5, 0x0e, 0x73, 0x2d, 0xe7, 0x69, 0xb9, 0x2a, 0x81,
+	0x30, 0xce, 0x91, 0xfa, 0x48, 0x1c, 0xc4, 0x47, 0x4a, 0x20, 0xcf, 0xc1, 0x63, 0xe9, 0x61, 0x0d,
+	0x54, 0x40, 0x64, 0xa1, 0x22, 0x57, 0xde, 0x60, 0xb9, 0xf2, 0xc6, 0x18, 0xea, 0x55, 0xfc, 0x12,
+	0xa8, 0xd7, 0xcf, 0x01, 0x89, 0x12, 0xa0, 0xca, 0xf6, 0x2a, 0x74, 0x9d, 0x09, 0x7b, 0xa9, 0xfb,
+	0xf8, 0xf8, 0x7a, 0x0c, 0x1b, 0x27, 0x1d, 0x51, 0x50, 0x94, 0x91, 0x3e, 0x18, 0x28, 0xd5, 0x1f,
+	0x12, 0xa2, 0x97, 0xce, 0x40, 0xdf, 0xaa, 0xc2, 0xce, 0x8a, 0xf5, 0xb7, 0x08, 0x11, 0x8c, 0xef,
+	0x41, 0x8f, 0x44, 0x27, 0x56, 0x5b, 0xdc, 0xda, 0x89, 0xbe, 0x30, 0xb3, 0xa6, 0x31, 0x8c, 0x4f,
+	0x22, 0xee, 0x48, 0xc0, 0x6b, 0xe5, 0xdf, 0xbd, 0xdb, 0x38, 0xf7, 0xef, 0x77, 0x1b, 0x9a, 0xf1,
+	0x4b, 0x40, 0x99, 0x5f, 0xb1, 0x5b, 0x34, 0x92, 0x9f, 0xc7, 0x4e, 0x09, 0x1c, 0xaf, 0x40, 0x35,
+	0x33, 0xae, 0x24, 0x43, 0x4e, 0x51, 0x39, 0xcc, 0xb4, 0x98, 0x79, 0x00, 0xe3, 0x0f, 0x05, 0x58,
+	0x1b, 0xf4, 0xec, 0x69, 0x56, 0x71, 0x9c, 0xba, 0xad, 0xb8, 0x8d, 0xd0, 0xc3, 0xe9, 0x52, 0xf6,
+	0x66, 0x59, 0x4a, 0x5e, 0xdd, 0xb0, 0x58, 0x7d, 0x92, 0xb0, 0x07, 0xa5, 0xeb, 0x1c, 0x56, 0xc7,
+	0x0d, 0x1c, 0x53, 0xe8, 0xbc, 0x35, 0x58, 0xe8, 0x7c, 0x76, 0xd6, 0x85, 0xe5, 0xeb, 0x9c, 0xef,
+	0x69, 0x70, 0x61, 0x88, 0xdf, 0x4c, 0x73, 0x4c, 0x3f, 0x83, 0x5c, 0xce, 0x4d, 0x3e, 0xd4, 0x4c,
+	0x4d, 0x6a, 0x86, 0x14, 0x9a, 0xb9, 0x23, 0x8f, 0x25, 0x68, 0x1d, 0xca, 0x2c, 0xc0, 0x21, 0xeb,
+	0x52, 0xae, 0xaa, 0x82, 0x69, 0xdb, 0xf8, 0xbd, 0x06, 0xfa, 0xf0, 0x13, 0x60, 0x9a, 0x35, 0x3b,
+	0x80, 0xe2, 0x85, 0x5a, 0xa3, 0x76, 0x76, 0x75, 0x8a, 0xaf, 0x88, 0x43, 0x2a, 0x15, 0x53, 0x5c,
+	0x71, 0x87, 0x97, 0x62, 0xfc, 0xba, 0x0c, 0x8b, 0xb7, 0xe3, 0x0f, 0xda, 0x07, 0x5c, 0x84, 0x95,
+	0x5b, 0x69, 0x3d, 0x5c, 0x93, 0x37, 0xb6, 0x35, 0x59, 0xdb, 0xb8, 0x5a, 0x38, 0xda, 0x81, 0xf9,
+	0x37, 0x69, 0x40, 0x92, 0x45, 0x3f, 0x39, 0x5d, 0x59, 0x5d, 0x81, 0xc4, 0x53, 0xd1, 0xcb, 0xa2,
+	0x18, 0x21, 0xb3, 0x38, 0x53, 0x49, 0xe5, 0xa9, 0xc9, 0x30, 0x2a, 0xef, 0x2b, 0xa4, 0x14, 0x00,
+	0xfd, 0x64, 0xd0, 0x67, 0xe3, 0x3c, 0xfd, 0xfc, 0x2c, 0xf6, 0x98, 0x5c, 0x9c, 0x82, 0xce, 0xc3,
+	0x21, 0x77, 0x8c, 0x2f, 0xce, 0x4b, 0x15, 0x2f, 0x3e, 0xaa, 0x2f, 0x2a, 0x35, 0xc3, 0xce, 0x87,
+	0xbc, 0xd4, 0x9e, 0x69, 0x64, 0x25, 0x24, 0x3d, 0xfe, 0xc0, 0xfc, 0xdd, 0x99, 0xed, 0x79, 0x48,
+	0x59, 0xdd, 0x1e, 0xea, 0x16, 0x5f, 0x39, 0x25, 0xc7, 0xca, 0x88, 0x17, 0xd3, 0x17, 0xa4, 0xb2,
+	0x6f, 0x4f, 0x61, 0x19, 0xa3, 0xcc, 0x2e, 0xd9, 0x55, 0x38, 0xd0, 0xc5, 0x10, 0x1d, 0x6b, 0xf1,
+	0x65, 0xa9, 0xe9, 0xda, 0xec, 0x16, 0x3f, 0xb4, 0xaf, 0x51, 0xcb, 0x47, 0x6f, 0xc0, 0x72, 0x97,
+	0x32, 0x6e, 0x25, 0x0f, 0x37, 0xa6, 0x57, 0xa4, 0xb2, 0xe6, 0x64, 0x65, 0xf9, 0x87, 0xad, 0x52,
+	0xb0, 0xd4, 0xcd, 0xc9, 0x18, 0xba, 0x03, 0x20, 0xc1, 0xc5, 0x03, 0x8f, 0xe9, 0x20, 0x81, 0x9f,
+	0x9e, 0x0e, 0x58, 0xbc, 0xef, 0x14, 0x68, 0xa5, 0xab, 0xda, 0x4c, 0x04, 0x84, 0x30, 0x7e, 0xcc,
+	0x5b, 0x34, 0x79, 0xcd, 0x33, 0xbd, 0x3a, 0x6d, 0x40, 0x18, 0x2e, 0x04, 0x24, 0xc7, 0x12, 0x0e,
+	0xc9, 0xd9, 0xce, 0xeb, 0x1f, 0x7c, 0xba, 0xa1, 0x7d, 0xf8, 0xe9, 0x86, 0xf6, 0xaf, 0x4f, 0x37,
+	0xb4, 0x77, 0x3e, 0xdb, 0x38, 0xf7, 0xe1, 0x67, 0x1b, 0xe7, 0xfe, 0xf1, 0xd9, 0xc6, 0xb9, 0xd7,
+	0x7f, 0x98, 0x4b, 0xb9, 0x6e, 0xe0, 0x90, 0xa0, 0xe7, 0xf2, 0x93, 0xcb, 0xed, 0x9e, 0xeb, 0xd9,
+	0xad, 0xfc, 0x1f, 0xcd, 0x1c, 0x8f, 0xf9, 0xb3, 0x19, 0x99, 0x90, 0xdb, 0x25, 0xf9, 0x4c, 0x7f,
+	0xee, 0x7f, 0x03, 0x00, 0x17, 0xf0, 0x3f, 0x29, 0x64, 0x23, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1323,6 +1998,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CommissionRate.Equal(that1.CommissionRate) {
 		return false
 	}
+	if !this.MaxCuratorWeight.Equal(that1.MaxCuratorWeight) {
+		return false
+	}
+	if !this.InstantRedemptionFee.Equal(that1.InstantRedemptionFee) {
+		return false
+	}
+	if !this.QueryBounty.Equal(that1.QueryBounty) {
+		return false
+	}
 	return true
 }
 func (m *Zone) Marshal() (dAtA []byte, err error) {
@@ -1345,6 +2029,70 @@ func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.Preflight != nil {
+		{
+			size, err := m.Preflight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	{
+		size := m.LiquidityBuffer.Size()
+		i -= size
+		if _, err := m.LiquidityBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.LiquidityBufferRatio.Size()
+		i -= size
+		if _, err := m.LiquidityBufferRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.MaxTxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxTxMsgs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxMsgs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.LastEpochHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEpochHeight))
 		i--
@@ -1568,6 +2316,97 @@ func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ZoneParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegateAccountCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegateAccountCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorSetInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValidatorSetInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DepositInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZonePreflight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZonePreflight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZonePreflight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowedMessages {
+		i--
+		if m.AllowedMessages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountPrefix {
+		i--
+		if m.AccountPrefix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BondDenom {
+		i--
+		if m.BondDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1631,6 +2470,67 @@ func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnbondingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CompletionTimes) > 0 {
+		for iNdEx := len(m.CompletionTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGenesis(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1651,12 +2551,37 @@ func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TransferTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TransferTimeout):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x62
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x4a
 	if m.Status != 0 {
@@ -1839,7 +2764,7 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
+func (m *IntentDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1849,41 +2774,34 @@ func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
+func (m *IntentDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IntentDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Intents) > 0 {
-		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if len(m.Follower) > 0 {
+		i -= len(m.Follower)
+		copy(dAtA[i:], m.Follower)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Follower)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
+func (m *PendingOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1893,30 +2811,225 @@ func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingOperation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ValoperAddress) > 0 {
-		i -= len(m.ValoperAddress)
-		copy(dAtA[i:], m.ValoperAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValoperAddress)))
+	if m.Failed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Outstanding != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Outstanding))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGenesis(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if m.ProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValoperAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2146,6 +3259,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.QueryBounty.Size()
+		i -= size
+		if _, err := m.QueryBounty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.InstantRedemptionFee.Size()
+		i -= size
+		if _, err := m.InstantRedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxCuratorWeight.Size()
+		i -= size
+		if _, err := m.MaxCuratorWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.CommissionRate.Size()
 		i -= size
@@ -2328,7 +3471,7 @@ func (m *DelegatorIntentsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *IntentDelegationsForZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2338,20 +3481,20 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *IntentDelegationsForZone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IntentDelegationsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PortConnections) > 0 {
-		for iNdEx := len(m.PortConnections) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IntentDelegations) > 0 {
+		for iNdEx := len(m.IntentDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PortConnections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IntentDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2359,13 +3502,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.DelegatorIntents) > 0 {
-		for iNdEx := len(m.DelegatorIntents) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOperations) > 0 {
+		for iNdEx := len(m.PendingOperations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegatorIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2373,13 +3546,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.DelegationPlans) > 0 {
-		for iNdEx := len(m.DelegationPlans) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HostVotes) > 0 {
+		for iNdEx := len(m.HostVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegationPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HostVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2387,13 +3560,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HostProposals) > 0 {
+		for iNdEx := len(m.HostProposals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HostProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2401,13 +3574,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.Receipts) > 0 {
-		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IntentDelegations) > 0 {
+		for iNdEx := len(m.IntentDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IntentDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2415,7 +3588,77 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PortConnections) > 0 {
+		for iNdEx := len(m.PortConnections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PortConnections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegatorIntents) > 0 {
+		for iNdEx := len(m.DelegatorIntents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegationPlans) > 0 {
+		for iNdEx := len(m.DelegationPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
 	if len(m.Zones) > 0 {
@@ -2551,6 +3794,58 @@ func (m *Zone) Size() (n int) {
 	if m.LastEpochHeight != 0 {
 		n += 2 + sovGenesis(uint64(m.LastEpochHeight))
 	}
+	if m.MaxTxMsgs != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxTxMsgs))
+	}
+	if m.MaxTxGas != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxTxGas))
+	}
+	l = m.LiquidityBufferRatio.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.LiquidityBuffer.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.Preflight != nil {
+		l = m.Preflight.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ZoneParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.DepositInterval))
+	}
+	if m.ValidatorSetInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.ValidatorSetInterval))
+	}
+	if m.DelegateAccountCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DelegateAccountCount))
+	}
+	return n
+}
+
+func (m *ZonePreflight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BondDenom {
+		n += 2
+	}
+	if m.AccountPrefix {
+		n += 2
+	}
+	if m.AllowedMessages {
+		n += 2
+	}
 	return n
 }
 
@@ -2584,6 +3879,36 @@ func (m *ICAAccount) Size() (n int) {
 	return n
 }
 
+func (m *UnbondingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.CompletionTimes) > 0 {
+		for _, e := range m.CompletionTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *WithdrawalRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2619,6 +3944,14 @@ func (m *WithdrawalRecord) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TransferTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -2662,6 +3995,93 @@ func (m *Validator) Size() (n int) {
 	return n
 }
 
+func (m *IntentDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Follower)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Outstanding != 0 {
+		n += 1 + sovGenesis(uint64(m.Outstanding))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovGenesis(uint64(m.Failed))
+	}
+	return n
+}
+
+func (m *HostProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *HostVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DelegatorIntent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2805,6 +4225,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.CommissionRate.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxCuratorWeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.InstantRedemptionFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.QueryBounty.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -2875,6 +4301,25 @@ func (m *DelegatorIntentsForZone) Size() (n int) {
 	return n
 }
 
+func (m *IntentDelegationsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.IntentDelegations) > 0 {
+		for _, e := range m.IntentDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2919,6 +4364,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IntentDelegations) > 0 {
+		for _, e := range m.IntentDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostProposals) > 0 {
+		for _, e := range m.HostProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostVotes) > 0 {
+		for _, e := range m.HostVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOperations) > 0 {
+		for _, e := range m.PendingOperations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3551,9 +5020,1396 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			}
 		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcNextValidatorsHash == nil {
+				m.IbcNextValidatorsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSelectionAllocation = append(m.ValidatorSelectionAllocation, types.Coin{})
+			if err := m.ValidatorSelectionAllocation[len(m.ValidatorSelectionAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HoldingsAllocation = append(m.HoldingsAllocation, types.Coin{})
+			if err := m.HoldingsAllocation[len(m.HoldingsAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochHeight", wireType)
+			}
+			m.LastEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxMsgs", wireType)
+			}
+			m.MaxTxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxGas", wireType)
+			}
+			m.MaxTxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBufferRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityBufferRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preflight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preflight == nil {
+				m.Preflight = &ZonePreflight{}
+			}
+			if err := m.Preflight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
+			}
+			m.DepositInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetInterval", wireType)
+			}
+			m.ValidatorSetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAccountCount", wireType)
+			}
+			m.DelegateAccountCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateAccountCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZonePreflight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZonePreflight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZonePreflight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BondDenom = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccountPrefix = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowedMessages = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			}
+			m.BalanceWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionTimes = append(m.CompletionTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.CompletionTimes[len(m.CompletionTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TransferTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3563,31 +6419,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.IbcNextValidatorsHash == nil {
-				m.IbcNextValidatorsHash = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3597,29 +6451,27 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSelectionAllocation = append(m.ValidatorSelectionAllocation, types.Coin{})
-			if err := m.ValidatorSelectionAllocation[len(m.ValidatorSelectionAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3646,30 +6498,10 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HoldingsAllocation = append(m.HoldingsAllocation, types.Coin{})
-			if err := m.HoldingsAllocation[len(m.HoldingsAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochHeight", wireType)
-			}
-			m.LastEpochHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastEpochHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3691,7 +6523,7 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3714,15 +6546,15 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3750,13 +6582,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3766,29 +6598,29 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3816,11 +6648,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortName = string(dAtA[iNdEx:postIndex])
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3848,13 +6682,15 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			m.BalanceWaitgroup = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3864,11 +6700,26 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3890,7 +6741,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+func (m *IntentDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3913,15 +6764,15 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: IntentDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IntentDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Follower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3949,43 +6800,11 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Follower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4013,78 +6832,63 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4094,28 +6898,27 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4143,13 +6946,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
 			}
-			m.Status = 0
+			m.Outstanding = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4159,16 +6962,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
+				m.Outstanding |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var msglen int
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4178,25 +6981,11 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Failed |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4218,7 +7007,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+func (m *HostProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4241,15 +7030,15 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4277,13 +7066,13 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4293,27 +7082,14 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4340,10 +7116,29 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4365,7 +7160,7 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *HostVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4388,15 +7183,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4424,13 +7219,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4440,29 +7235,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4490,49 +7270,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4542,23 +7286,23 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, types1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5513,7 +8257,94 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsetInterval", wireType)
 			}
-			m.ValidatorsetInterval = 0
+			m.ValidatorsetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCuratorWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCuratorWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionFee", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5523,14 +8354,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantRedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryBounty", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5558,7 +8404,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6046,6 +8892,122 @@ func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IntentDelegationsForZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntentDelegationsForZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntentDelegationsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntentDelegations = append(m.IntentDelegations, IntentDelegation{})
+			if err := m.IntentDelegations[len(m.IntentDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6312,6 +9274,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntentDelegations = append(m.IntentDelegations, IntentDelegationsForZone{})
+			if err := m.IntentDelegations[len(m.IntentDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostProposals = append(m.HostProposals, HostProposal{})
+			if err := m.HostProposals[len(m.HostProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostVotes = append(m.HostVotes, HostVote{})
+			if err := m.HostVotes[len(m.HostVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOperations = append(m.PendingOperations, PendingOperation{})
+			if err := m.PendingOperations[len(m.PendingOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
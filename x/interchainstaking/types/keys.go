@@ -1,10 +1,10 @@
 package types
 
 import (
-	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -52,8 +52,117 @@ var (
 	KeyPrefixDelegation       = []byte{0x06}
 	KeyPrefixDelegationPlan   = []byte{0x07}
 	KeyPrefixSnapshotIntent   = []byte{0x08}
+	KeyPrefixIntentDelegation = []byte{0x09}
+	KeyPrefixHostProposal     = []byte{0x0a}
+	KeyPrefixHostVote         = []byte{0x0b}
+	KeyPrefixPendingOperation = []byte{0x0c}
+	// 0x0d is unused.
+	KeyPrefixAccountZoneIndex = []byte{0x0e}
+	KeyPrefixDenomZoneIndex   = []byte{0x0f}
+	KeyPrefixUnbondingRecord  = []byte{0x10}
 )
 
+// GetZoneKey returns the store key for the zone with the given chain id. The chain id is length
+// prefixed so that no zone key is a prefix of another.
+func GetZoneKey(chainID string) []byte {
+	return append(KeyPrefixZone, address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetAccountZoneIndexPrefix returns the store key prefix for the zones indexed by the given interchain account address.
+func GetAccountZoneIndexPrefix(accountAddress string) []byte {
+	return append(KeyPrefixAccountZoneIndex, address.MustLengthPrefix([]byte(accountAddress))...)
+}
+
+// GetAccountZoneIndexKey returns the store key indexing the zone with the given chain id by one of its interchain
+// account addresses.
+func GetAccountZoneIndexKey(accountAddress string, chainID string) []byte {
+	return append(GetAccountZoneIndexPrefix(accountAddress), address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetDenomZoneIndexPrefix returns the store key prefix for the zones indexed by the given local denom.
+func GetDenomZoneIndexPrefix(denom string) []byte {
+	return append(KeyPrefixDenomZoneIndex, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetDenomZoneIndexKey returns the store key indexing the zone with the given chain id by its local denom.
+func GetDenomZoneIndexKey(denom string, chainID string) []byte {
+	return append(GetDenomZoneIndexPrefix(denom), address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetZoneWithdrawalRecordsKey returns the store key prefix for all withdrawal records of the given zone.
+func GetZoneWithdrawalRecordsKey(chainID string) []byte {
+	return append(KeyPrefixWithdrawalRecord, address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetDelegatorWithdrawalRecordsKey returns the store key prefix for all withdrawal records of the given
+// zone and delegation account.
+func GetDelegatorWithdrawalRecordsKey(chainID string, delegator string) []byte {
+	return append(GetZoneWithdrawalRecordsKey(chainID), address.MustLengthPrefix([]byte(delegator))...)
+}
+
+// GetWithdrawalKey returns the store key prefix for the withdrawal records of a single redemption from the
+// given delegation account; records are keyed under it by validator.
+func GetWithdrawalKey(chainID string, delegator string, txhash string) []byte {
+	return append(GetDelegatorWithdrawalRecordsKey(chainID, delegator), address.MustLengthPrefix([]byte(txhash))...)
+}
+
+// GetWithdrawalRecordKey returns the store key for a withdrawal record.
+func GetWithdrawalRecordKey(chainID string, delegator string, txhash string, validator string) []byte {
+	return append(GetWithdrawalKey(chainID, delegator, txhash), []byte(validator)...)
+}
+
+// GetZoneUnbondingRecordsKey returns the store prefix of the unbonding records of the given zone.
+func GetZoneUnbondingRecordsKey(chainID string) []byte {
+	return append(KeyPrefixUnbondingRecord, address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetUnbondingRecordKey returns the store key of the unbonding record of the given delegator/validator pair:
+// prefix | len(chain id) | chain id | len(delegator) | delegator | validator.
+func GetUnbondingRecordKey(chainID string, delegator string, validator string) []byte {
+	key := append(GetZoneUnbondingRecordsKey(chainID), address.MustLengthPrefix([]byte(delegator))...)
+	return append(key, []byte(validator)...)
+}
+
+// GetZoneIntentDelegationsKey returns the store key prefix for the intent delegations of the given zone.
+func GetZoneIntentDelegationsKey(chainID string) []byte {
+	return append(KeyPrefixIntentDelegation, address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetIntentDelegationKey returns the store key for the intent delegation of the given follower:
+// prefix | len(chain id) | chain id | follower.
+func GetIntentDelegationKey(chainID string, follower string) []byte {
+	return append(GetZoneIntentDelegationsKey(chainID), []byte(follower)...)
+}
+
+// GetZoneHostProposalsKey returns the store key prefix for the host zone governance proposals of the given zone.
+func GetZoneHostProposalsKey(chainID string) []byte {
+	return append(KeyPrefixHostProposal, address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// GetHostProposalKey returns the store key for the given host zone governance proposal:
+// prefix | len(chain id) | chain id | proposal id.
+func GetHostProposalKey(chainID string, proposalID uint64) []byte {
+	return append(GetZoneHostProposalsKey(chainID), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetHostVotesKey returns the store key prefix for votes on the given host zone governance proposal:
+// prefix | len(chain id) | chain id | proposal id.
+func GetHostVotesKey(chainID string, proposalID uint64) []byte {
+	key := append(KeyPrefixHostVote, address.MustLengthPrefix([]byte(chainID))...)
+	return append(key, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetHostVoteKey returns the store key for a voter's vote on the given host zone governance proposal.
+func GetHostVoteKey(chainID string, proposalID uint64, voter string) []byte {
+	return append(GetHostVotesKey(chainID, proposalID), []byte(voter)...)
+}
+
+// GetPendingOperationKey returns the store key for the pending operation identified by memo:
+// prefix | len(chain id) | chain id | memo.
+func GetPendingOperationKey(chainID string, memo string) []byte {
+	return append(append(KeyPrefixPendingOperation, address.MustLengthPrefix([]byte(chainID))...), []byte(memo)...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -61,11 +170,42 @@ func KeyPrefix(p string) []byte {
 // ParseStakingDelegationKey parses the KV store key for a delegation from Cosmos x/staking module,
 // as defined here: https://github.com/cosmos/cosmos-sdk/blob/v0.45.6/x/staking/types/keys.go#L180
 func ParseStakingDelegationKey(key []byte) (sdk.AccAddress, sdk.ValAddress, error) {
+	return parseStakingPairKey(key, 0x31, "delegation")
+}
+
+// ParseStakingDelegationsKey parses the KV store prefix for the delegations of a delegator from Cosmos x/staking
+// module, as defined here: https://github.com/cosmos/cosmos-sdk/blob/v0.45.6/x/staking/types/keys.go#L186
+func ParseStakingDelegationsKey(key []byte) (sdk.AccAddress, error) {
+	if len(key) < 1 {
+		return nil, fmt.Errorf("out of bounds reading byte 0")
+	}
+	if key[0] != 0x31 {
+		return []byte{}, fmt.Errorf("not a valid delegations key")
+	}
+	if len(key) < 2 {
+		return nil, fmt.Errorf("out of bounds reading delegator address length")
+	}
+	delAddrLen := int(key[1])
+	if len(key) != 2+delAddrLen {
+		return nil, fmt.Errorf("invalid delegator address length")
+	}
+	return key[2:], nil
+}
+
+// ParseStakingUnbondingDelegationKey parses the KV store key for an unbonding delegation from Cosmos x/staking
+// module, as defined here: https://github.com/cosmos/cosmos-sdk/blob/v0.45.6/x/staking/types/keys.go#L187
+func ParseStakingUnbondingDelegationKey(key []byte) (sdk.AccAddress, sdk.ValAddress, error) {
+	return parseStakingPairKey(key, 0x32, "unbonding delegation")
+}
+
+// parseStakingPairKey parses a Cosmos x/staking module key of the form prefix | len(delegator) | delegator |
+// len(validator) | validator.
+func parseStakingPairKey(key []byte, prefix byte, name string) (sdk.AccAddress, sdk.ValAddress, error) {
 	if len(key) < 1 {
 		return nil, nil, fmt.Errorf("out of bounds reading byte 0")
 	}
-	if !bytes.Equal(key[0:1], []byte{0x31}) {
-		return []byte{}, []byte{}, fmt.Errorf("not a valid delegation key")
+	if key[0] != prefix {
+		return []byte{}, []byte{}, fmt.Errorf("not a valid %s key", name)
 	}
 	if len(key) < 2 {
 		return nil, nil, fmt.Errorf("out of bounds reading delegator address length")
@@ -76,8 +216,8 @@ func ParseStakingDelegationKey(key []byte) (sdk.AccAddress, sdk.ValAddress, erro
 	}
 	delAddr := key[2 : 2+delAddrLen]
 	// use valAddrLen to validate the val address has not been truncated.
-	if len(key) < 2+delAddrLen {
-		return nil, nil, fmt.Errorf("out of bounds reading delegator address length")
+	if len(key) < 3+delAddrLen {
+		return nil, nil, fmt.Errorf("out of bounds reading validator address length")
 	}
 	valAddrLen := int(key[2+delAddrLen])
 	if len(key) < 3+delAddrLen+valAddrLen {
@@ -38,3 +38,36 @@ func TestParseStakingDelegationKeyInvalidTruncated(t *testing.T) {
 	_, _, err = types.ParseStakingDelegationKey(key[:len(key)-1])
 	require.Errorf(t, err, "out of bounds reading validator address")
 }
+
+func TestParseStakingUnbondingDelegationKey(t *testing.T) {
+	delAddr, err := sdk.AccAddressFromBech32("cosmos1zcuaqawcpzn7q9wmulagvjjv7f72qearnep4jt")
+	require.NoError(t, err, "failed to parse delAddress from bech32")
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1zcuaqawcpzn7q9wmulagvjjv7f72qearkd4q7c")
+	require.NoError(t, err, "failed to parse valAddress from bech32")
+
+	del, val, err := types.ParseStakingUnbondingDelegationKey(stakingtypes.GetUBDKey(delAddr, valAddr))
+	require.NoError(t, err, "expected no error in ParseStakingUnbondingDelegationKey()")
+	require.Equal(t, delAddr, del, "require original and parsed delegator addresses match")
+	require.Equal(t, valAddr, val, "require original and parsed validator addresses match")
+
+	// a delegation key is not an unbonding delegation key.
+	_, _, err = types.ParseStakingUnbondingDelegationKey(stakingtypes.GetDelegationKey(delAddr, valAddr))
+	require.ErrorContains(t, err, "not a valid unbonding delegation key")
+}
+
+func TestParseStakingDelegationsKey(t *testing.T) {
+	delAddr, err := sdk.AccAddressFromBech32("cosmos1zcuaqawcpzn7q9wmulagvjjv7f72qearnep4jt")
+	require.NoError(t, err, "failed to parse delAddress from bech32")
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1zcuaqawcpzn7q9wmulagvjjv7f72qearkd4q7c")
+	require.NoError(t, err, "failed to parse valAddress from bech32")
+
+	del, err := types.ParseStakingDelegationsKey(stakingtypes.GetDelegationsKey(delAddr))
+	require.NoError(t, err, "expected no error in ParseStakingDelegationsKey()")
+	require.Equal(t, delAddr, del, "require original and parsed delegator addresses match")
+
+	// a single delegation key is not the delegations prefix of a delegator.
+	_, err = types.ParseStakingDelegationsKey(stakingtypes.GetDelegationKey(delAddr, valAddr))
+	require.ErrorContains(t, err, "invalid delegator address length")
+	_, err = types.ParseStakingDelegationsKey(stakingtypes.GetUBDsKey(delAddr))
+	require.ErrorContains(t, err, "not a valid delegations key")
+}
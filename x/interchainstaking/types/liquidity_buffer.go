@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetLiquidityBufferRatio returns the share of the zone's native assets to be held in the liquidity buffer.
+func (z Zone) GetLiquidityBufferRatio() sdk.Dec {
+	if z.LiquidityBufferRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.LiquidityBufferRatio
+}
+
+// GetLiquidityBuffer returns the amount of base denom held in the zone's liquidity buffer.
+func (z Zone) GetLiquidityBuffer() sdk.Int {
+	if z.LiquidityBuffer.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return z.LiquidityBuffer
+}
+
+// LiquidityBufferTarget returns the size of the liquidity buffer, given the amount delegated by the zone.
+func (z Zone) LiquidityBufferTarget(delegated sdk.Int) sdk.Int {
+	return z.GetLiquidityBufferRatio().MulInt(delegated.Add(z.GetLiquidityBuffer())).TruncateInt()
+}
+
+// LiquidityBufferUtilisation returns the share of the liquidity buffer target drawn down, given the amount
+// delegated by the zone.
+func (z Zone) LiquidityBufferUtilisation(delegated sdk.Int) sdk.Dec {
+	target := z.LiquidityBufferTarget(delegated)
+	balance := z.GetLiquidityBuffer()
+	if !target.IsPositive() || balance.GTE(target) {
+		return sdk.ZeroDec()
+	}
+	return target.Sub(balance).ToDec().Quo(target.ToDec())
+}
+
+// LiquidityBufferTopUp returns the part of incoming native assets to be retained in the liquidity buffer, given the
+// amount delegated by the zone. The incoming assets count towards the total against which the target is measured.
+func (z Zone) LiquidityBufferTopUp(delegated sdk.Int, incoming sdk.Int) sdk.Int {
+	if !incoming.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	balance := z.GetLiquidityBuffer()
+	target := z.GetLiquidityBufferRatio().MulInt(delegated.Add(balance).Add(incoming)).TruncateInt()
+	if balance.GTE(target) {
+		return sdk.ZeroInt()
+	}
+	return sdk.MinInt(target.Sub(balance), incoming)
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestLiquidityBuffer(t *testing.T) {
+	// zones registered before the buffer was introduced have neither field set.
+	zone := types.Zone{}
+	require.True(t, zone.GetLiquidityBuffer().IsZero())
+	require.True(t, zone.LiquidityBufferTarget(sdk.NewInt(1000)).IsZero())
+	require.True(t, zone.LiquidityBufferUtilisation(sdk.NewInt(1000)).IsZero())
+	require.True(t, zone.LiquidityBufferTopUp(sdk.NewInt(1000), sdk.NewInt(100)).IsZero())
+
+	zone.LiquidityBufferRatio = sdk.NewDecWithPrec(1, 1)
+	zone.LiquidityBuffer = sdk.NewInt(50)
+
+	// target is 10% of 950 delegated + 50 held.
+	require.Equal(t, sdk.NewInt(100), zone.LiquidityBufferTarget(sdk.NewInt(950)))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), zone.LiquidityBufferUtilisation(sdk.NewInt(950)))
+
+	tests := []struct {
+		name      string
+		delegated int64
+		incoming  int64
+		expected  int64
+	}{
+		{"deposit smaller than shortfall is retained in full", 2000, 10, 10},
+		{"deposit counts towards the target", 450, 500, 100 - 50},
+		{"nothing incoming", 450, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, sdk.NewInt(tt.expected), zone.LiquidityBufferTopUp(sdk.NewInt(tt.delegated), sdk.NewInt(tt.incoming)))
+		})
+	}
+
+	// a full buffer retains nothing.
+	zone.LiquidityBuffer = sdk.NewInt(200)
+	require.True(t, zone.LiquidityBufferTopUp(sdk.NewInt(950), sdk.NewInt(100)).IsZero())
+	require.True(t, zone.LiquidityBufferUtilisation(sdk.NewInt(950)).IsZero())
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/gov/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -34,9 +35,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // MsgRequestRedemption represents a message type to request a burn of qAssets
 // for native assets.
 type MsgRequestRedemption struct {
-	Value              types.Coin `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"coin"`
-	DestinationAddress string     `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
-	FromAddress        string     `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Value types.Coin `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"coin"`
+	// destination_address is the host chain address to which native assets are
+	// sent, or a Quicksilver address to which they are returned over IBC.
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	FromAddress        string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 }
 
 func (m *MsgRequestRedemption) Reset()         { *m = MsgRequestRedemption{} }
@@ -72,6 +75,49 @@ func (m *MsgRequestRedemption) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestRedemption proto.InternalMessageInfo
 
+// MsgInstantRedeem represents a message type to burn qAssets for native assets
+// paid from the zone's liquidity buffer, without awaiting unbonding.
+type MsgInstantRedeem struct {
+	Value types.Coin `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"coin"`
+	// destination_address is the host chain address to which native assets are
+	// sent, or a Quicksilver address to which they are returned over IBC.
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	FromAddress        string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgInstantRedeem) Reset()         { *m = MsgInstantRedeem{} }
+func (m *MsgInstantRedeem) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantRedeem) ProtoMessage()    {}
+func (*MsgInstantRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{1}
+}
+func (m *MsgInstantRedeem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantRedeem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantRedeem.Merge(m, src)
+}
+func (m *MsgInstantRedeem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantRedeem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantRedeem proto.InternalMessageInfo
+
 // MsgSignalIntent represents a message type for signalling voting intent for
 // one or more validators.
 type MsgSignalIntent struct {
@@ -84,7 +130,7 @@ func (m *MsgSignalIntent) Reset()         { *m = MsgSignalIntent{} }
 func (m *MsgSignalIntent) String() string { return proto.CompactTextString(m) }
 func (*MsgSignalIntent) ProtoMessage()    {}
 func (*MsgSignalIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{1}
+	return fileDescriptor_ee484030fa140a82, []int{2}
 }
 func (m *MsgSignalIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -113,6 +159,89 @@ func (m *MsgSignalIntent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSignalIntent proto.InternalMessageInfo
 
+// MsgDelegateIntent represents a message type for following the voting intent
+// of a curator. An empty curator address removes an existing delegation.
+type MsgDelegateIntent struct {
+	ChainId        string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	CuratorAddress string `protobuf:"bytes,2,opt,name=curator_address,json=curatorAddress,proto3" json:"curator_address,omitempty"`
+	FromAddress    string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgDelegateIntent) Reset()         { *m = MsgDelegateIntent{} }
+func (m *MsgDelegateIntent) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateIntent) ProtoMessage()    {}
+func (*MsgDelegateIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{3}
+}
+func (m *MsgDelegateIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateIntent.Merge(m, src)
+}
+func (m *MsgDelegateIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateIntent proto.InternalMessageInfo
+
+// MsgVoteOnHostProposal represents a message type for voting on a governance
+// proposal of a host zone.
+type MsgVoteOnHostProposal struct {
+	ChainId     string                      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	ProposalId  uint64                      `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Options     []types1.WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+	FromAddress string                      `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgVoteOnHostProposal) Reset()         { *m = MsgVoteOnHostProposal{} }
+func (m *MsgVoteOnHostProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteOnHostProposal) ProtoMessage()    {}
+func (*MsgVoteOnHostProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{4}
+}
+func (m *MsgVoteOnHostProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteOnHostProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteOnHostProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteOnHostProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteOnHostProposal.Merge(m, src)
+}
+func (m *MsgVoteOnHostProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteOnHostProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteOnHostProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteOnHostProposal proto.InternalMessageInfo
+
 // MsgRequestRedemptionResponse defines the MsgRequestRedemption response type.
 type MsgRequestRedemptionResponse struct {
 }
@@ -121,7 +250,7 @@ func (m *MsgRequestRedemptionResponse) Reset()         { *m = MsgRequestRedempti
 func (m *MsgRequestRedemptionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestRedemptionResponse) ProtoMessage()    {}
 func (*MsgRequestRedemptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{2}
+	return fileDescriptor_ee484030fa140a82, []int{5}
 }
 func (m *MsgRequestRedemptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,6 +279,61 @@ func (m *MsgRequestRedemptionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestRedemptionResponse proto.InternalMessageInfo
 
+// MsgInstantRedeemResponse defines the MsgInstantRedeem response type.
+type MsgInstantRedeemResponse struct {
+	// amount is the native assets paid to the destination address.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// fee is the native assets retained in the liquidity buffer.
+	Fee types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgInstantRedeemResponse) Reset()         { *m = MsgInstantRedeemResponse{} }
+func (m *MsgInstantRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantRedeemResponse) ProtoMessage()    {}
+func (*MsgInstantRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{6}
+}
+func (m *MsgInstantRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantRedeemResponse.Merge(m, src)
+}
+func (m *MsgInstantRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantRedeemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantRedeemResponse proto.InternalMessageInfo
+
+func (m *MsgInstantRedeemResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgInstantRedeemResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
 // MsgSignalIntentResponse defines the MsgSignalIntent response type.
 type MsgSignalIntentResponse struct {
 }
@@ -158,7 +342,7 @@ func (m *MsgSignalIntentResponse) Reset()         { *m = MsgSignalIntentResponse
 func (m *MsgSignalIntentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSignalIntentResponse) ProtoMessage()    {}
 func (*MsgSignalIntentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{3}
+	return fileDescriptor_ee484030fa140a82, []int{7}
 }
 func (m *MsgSignalIntentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,11 +371,92 @@ func (m *MsgSignalIntentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSignalIntentResponse proto.InternalMessageInfo
 
+// MsgDelegateIntentResponse defines the MsgDelegateIntent response type.
+type MsgDelegateIntentResponse struct {
+}
+
+func (m *MsgDelegateIntentResponse) Reset()         { *m = MsgDelegateIntentResponse{} }
+func (m *MsgDelegateIntentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateIntentResponse) ProtoMessage()    {}
+func (*MsgDelegateIntentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{8}
+}
+func (m *MsgDelegateIntentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateIntentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateIntentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateIntentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateIntentResponse.Merge(m, src)
+}
+func (m *MsgDelegateIntentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateIntentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateIntentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateIntentResponse proto.InternalMessageInfo
+
+// MsgVoteOnHostProposalResponse defines the MsgVoteOnHostProposal response
+// type.
+type MsgVoteOnHostProposalResponse struct {
+}
+
+func (m *MsgVoteOnHostProposalResponse) Reset()         { *m = MsgVoteOnHostProposalResponse{} }
+func (m *MsgVoteOnHostProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteOnHostProposalResponse) ProtoMessage()    {}
+func (*MsgVoteOnHostProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{9}
+}
+func (m *MsgVoteOnHostProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteOnHostProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteOnHostProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteOnHostProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteOnHostProposalResponse.Merge(m, src)
+}
+func (m *MsgVoteOnHostProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteOnHostProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteOnHostProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteOnHostProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRequestRedemption)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemption")
+	proto.RegisterType((*MsgInstantRedeem)(nil), "quicksilver.interchainstaking.v1.MsgInstantRedeem")
 	proto.RegisterType((*MsgSignalIntent)(nil), "quicksilver.interchainstaking.v1.MsgSignalIntent")
+	proto.RegisterType((*MsgDelegateIntent)(nil), "quicksilver.interchainstaking.v1.MsgDelegateIntent")
+	proto.RegisterType((*MsgVoteOnHostProposal)(nil), "quicksilver.interchainstaking.v1.MsgVoteOnHostProposal")
 	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemptionResponse")
+	proto.RegisterType((*MsgInstantRedeemResponse)(nil), "quicksilver.interchainstaking.v1.MsgInstantRedeemResponse")
 	proto.RegisterType((*MsgSignalIntentResponse)(nil), "quicksilver.interchainstaking.v1.MsgSignalIntentResponse")
+	proto.RegisterType((*MsgDelegateIntentResponse)(nil), "quicksilver.interchainstaking.v1.MsgDelegateIntentResponse")
+	proto.RegisterType((*MsgVoteOnHostProposalResponse)(nil), "quicksilver.interchainstaking.v1.MsgVoteOnHostProposalResponse")
 }
 
 func init() {
@@ -199,43 +464,62 @@ func init() {
 }
 
 var fileDescriptor_ee484030fa140a82 = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xdd, 0x69, 0xd0, 0xb6, 0x93, 0x62, 0x71, 0x5b, 0xb0, 0x0d, 0x65, 0x37, 0xec, 0x29, 0x54,
-	0xba, 0xeb, 0x46, 0x28, 0x18, 0x41, 0x34, 0x7a, 0xc9, 0x21, 0x97, 0x2d, 0x78, 0xe8, 0x25, 0x4c,
-	0xb2, 0x3f, 0xa7, 0x43, 0x77, 0x67, 0xd2, 0x9d, 0xd9, 0xd0, 0x5c, 0x7b, 0xf2, 0x28, 0xf8, 0x05,
-	0xfa, 0x21, 0xfa, 0x0d, 0xbc, 0xf4, 0x58, 0xf4, 0xe2, 0x29, 0x48, 0xe2, 0x41, 0x2f, 0x1e, 0xf2,
-	0x09, 0x64, 0xff, 0xa4, 0x24, 0x26, 0x10, 0x2d, 0xde, 0x66, 0xe6, 0xcd, 0x7b, 0xbf, 0xf7, 0x66,
-	0x7e, 0x3f, 0xec, 0x9c, 0xc5, 0xac, 0x73, 0x2a, 0x59, 0xd0, 0x83, 0xc8, 0x61, 0x5c, 0x41, 0xd4,
-	0x39, 0x21, 0x8c, 0x4b, 0x45, 0x4e, 0x19, 0xa7, 0x4e, 0xcf, 0x75, 0x42, 0x90, 0x92, 0x50, 0x90,
-	0x76, 0x37, 0x12, 0x4a, 0xe8, 0xe5, 0x29, 0x82, 0x3d, 0x47, 0xb0, 0x7b, 0x6e, 0x69, 0x9b, 0x0a,
-	0x2a, 0xd2, 0xcb, 0x4e, 0xb2, 0xca, 0x78, 0xa5, 0xdd, 0x8e, 0x90, 0xa1, 0x90, 0xad, 0x0c, 0xc8,
-	0x36, 0x39, 0x64, 0x64, 0x3b, 0xa7, 0x4d, 0x24, 0x38, 0x3d, 0xb7, 0x0d, 0x8a, 0xb8, 0x4e, 0x47,
-	0x30, 0x9e, 0xe3, 0xf6, 0x52, 0x8f, 0x14, 0x38, 0x48, 0x36, 0xd1, 0xdb, 0xa3, 0x42, 0xd0, 0x00,
-	0x1c, 0xd2, 0x65, 0x0e, 0xe1, 0x5c, 0x28, 0xa2, 0x98, 0xe0, 0x39, 0x6a, 0xfd, 0x42, 0x78, 0xbb,
-	0x29, 0xa9, 0x07, 0x67, 0x31, 0x48, 0xe5, 0x81, 0x0f, 0x61, 0x37, 0xc1, 0xf5, 0x37, 0xf8, 0x5e,
-	0x8f, 0x04, 0x31, 0xec, 0xa0, 0x32, 0xaa, 0x14, 0xab, 0xbb, 0x76, 0x6e, 0x32, 0xb1, 0x65, 0xe7,
-	0xb6, 0xec, 0xd7, 0x82, 0xf1, 0xfa, 0xd6, 0xf5, 0xc0, 0xd4, 0xc6, 0x03, 0xb3, 0xd8, 0x27, 0x61,
-	0x50, 0xb3, 0x12, 0xab, 0x96, 0x97, 0x91, 0xf5, 0x06, 0xde, 0xf2, 0x41, 0x2a, 0xc6, 0xd3, 0xa2,
-	0x2d, 0xe2, 0xfb, 0x11, 0x48, 0xb9, 0xb3, 0x52, 0x46, 0x95, 0xf5, 0xfa, 0xce, 0xe7, 0xab, 0x83,
-	0xed, 0x5c, 0xf6, 0x55, 0x86, 0x1c, 0xa9, 0x88, 0x71, 0xea, 0xe9, 0x53, 0xa4, 0x1c, 0xd1, 0x9f,
-	0xe3, 0x8d, 0x77, 0x91, 0x08, 0x6f, 0x35, 0x0a, 0x4b, 0x34, 0x8a, 0xc9, 0xed, 0xfc, 0xa8, 0xb6,
-	0xf6, 0xfe, 0xd2, 0xd4, 0x7e, 0x5c, 0x9a, 0x9a, 0xf5, 0x13, 0xe1, 0xcd, 0xa6, 0xa4, 0x47, 0x8c,
-	0x72, 0x12, 0x34, 0xb8, 0x02, 0xae, 0x74, 0x1b, 0xaf, 0xa5, 0x6f, 0xd8, 0x62, 0x7e, 0x1a, 0x77,
-	0xbd, 0xbe, 0x35, 0x1e, 0x98, 0x9b, 0x79, 0x9e, 0x1c, 0xb1, 0xbc, 0xd5, 0x74, 0xd9, 0xf0, 0xf5,
-	0x16, 0x5e, 0x65, 0x29, 0x33, 0x49, 0x52, 0xa8, 0x14, 0xab, 0xae, 0xbd, 0xac, 0x0f, 0xec, 0xb7,
-	0x24, 0x60, 0x3e, 0x51, 0x22, 0xca, 0x6a, 0xd6, 0xf5, 0xf1, 0xc0, 0x7c, 0x90, 0x55, 0xc8, 0xb5,
-	0x2c, 0x6f, 0xa2, 0xfa, 0xbf, 0xb2, 0x1a, 0x78, 0x6f, 0xd1, 0xdf, 0x7a, 0x20, 0xbb, 0x82, 0x4b,
-	0xb0, 0x76, 0xf1, 0xa3, 0x3f, 0x9e, 0x62, 0x02, 0x55, 0x2f, 0x0a, 0xb8, 0xd0, 0x94, 0x54, 0xff,
-	0x84, 0xf0, 0xc3, 0xf9, 0xe6, 0x38, 0x5c, 0x9e, 0x77, 0x51, 0xe1, 0xd2, 0x8b, 0xbb, 0xf1, 0x6e,
-	0x0d, 0x1f, 0x5e, 0x7c, 0xf9, 0xfe, 0x71, 0xe5, 0x89, 0xf5, 0x78, 0x66, 0x50, 0xd5, 0x79, 0xd2,
-	0xf5, 0xf3, 0xa3, 0x10, 0x81, 0x0f, 0x10, 0xd6, 0xd0, 0xbe, 0x7e, 0x85, 0xf0, 0xc6, 0xcc, 0x8f,
-	0xbb, 0x7f, 0x65, 0x64, 0x9a, 0x52, 0x7a, 0xf6, 0xcf, 0x94, 0x3b, 0xda, 0xce, 0x9a, 0xa0, 0x86,
-	0xf6, 0xeb, 0xc7, 0xd7, 0x43, 0x03, 0xdd, 0x0c, 0x0d, 0xf4, 0x6d, 0x68, 0xa0, 0x0f, 0x23, 0x43,
-	0xbb, 0x19, 0x19, 0xda, 0xd7, 0x91, 0xa1, 0x1d, 0xbf, 0xa4, 0x4c, 0x9d, 0xc4, 0x6d, 0xbb, 0x23,
-	0x42, 0x87, 0x71, 0x0a, 0x3c, 0x66, 0xaa, 0x7f, 0xd0, 0x8e, 0x59, 0xe0, 0xcf, 0xd4, 0x38, 0x5f,
-	0xa0, 0xaf, 0xfa, 0x5d, 0x90, 0xed, 0xfb, 0xe9, 0xfc, 0x3f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xd9, 0x2d, 0x8f, 0x29, 0xf3, 0x04, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x24, 0xa1, 0x69, 0xc7, 0x25, 0xa1, 0x9b, 0x00, 0x8e, 0x09, 0xbb, 0xd1, 0x1e, 0x50,
+	0x00, 0x75, 0x17, 0x3b, 0x55, 0x43, 0x5d, 0xa9, 0xa5, 0xa6, 0x42, 0xf8, 0x60, 0x81, 0xb6, 0x52,
+	0x91, 0x7a, 0xb1, 0xc6, 0xde, 0xe9, 0x64, 0xd4, 0xdd, 0x19, 0x77, 0x67, 0x76, 0xd5, 0x5c, 0x39,
+	0x20, 0x6e, 0x20, 0xf1, 0x07, 0xfa, 0x23, 0x7a, 0xe7, 0x00, 0x87, 0x5e, 0x90, 0x0a, 0x5c, 0x38,
+	0x59, 0x28, 0xe1, 0x00, 0x1c, 0x38, 0xf8, 0x17, 0xa0, 0xd9, 0x9d, 0x5d, 0xd9, 0xb1, 0x25, 0x6f,
+	0xac, 0x9e, 0x7a, 0xdb, 0xf1, 0xf7, 0xbe, 0x37, 0xdf, 0xf7, 0x66, 0xde, 0x1b, 0x43, 0xf7, 0x71,
+	0x4c, 0x07, 0x8f, 0x04, 0x0d, 0x12, 0x1c, 0xb9, 0x94, 0x49, 0x1c, 0x0d, 0x8e, 0x10, 0x65, 0x42,
+	0xa2, 0x47, 0x94, 0x11, 0x37, 0x69, 0xb8, 0x21, 0x16, 0x02, 0x11, 0x2c, 0x9c, 0x61, 0xc4, 0x25,
+	0x37, 0xf6, 0x26, 0x08, 0xce, 0x0c, 0xc1, 0x49, 0x1a, 0xf5, 0x6d, 0xc2, 0x09, 0x4f, 0x83, 0x5d,
+	0xf5, 0x95, 0xf1, 0xea, 0x3b, 0x03, 0x2e, 0x42, 0x2e, 0x7a, 0x19, 0x90, 0x2d, 0x34, 0x64, 0x66,
+	0x2b, 0xb7, 0x8f, 0x04, 0x76, 0x93, 0x46, 0x1f, 0x4b, 0xd4, 0x70, 0x07, 0x9c, 0x32, 0x8d, 0xef,
+	0x6a, 0x9c, 0xf0, 0xa4, 0x80, 0x09, 0x4f, 0x34, 0xea, 0x2c, 0x74, 0x40, 0x30, 0xc3, 0x82, 0xe6,
+	0xbb, 0xed, 0x12, 0xce, 0x49, 0x80, 0x5d, 0x34, 0xa4, 0x2e, 0x62, 0x8c, 0x4b, 0x24, 0x29, 0x67,
+	0x1a, 0xb5, 0xff, 0x03, 0x70, 0xbb, 0x2b, 0x88, 0x87, 0x1f, 0xc7, 0x58, 0x48, 0x0f, 0xfb, 0x38,
+	0x1c, 0x2a, 0xdc, 0xb8, 0x0b, 0x5f, 0x4b, 0x50, 0x10, 0xe3, 0x1a, 0xd8, 0x03, 0xfb, 0xd5, 0xe6,
+	0x8e, 0xa3, 0x2d, 0x28, 0xd1, 0x8e, 0x56, 0xe5, 0x7c, 0xca, 0x29, 0x6b, 0x6f, 0x3d, 0x1f, 0x59,
+	0x95, 0xf1, 0xc8, 0xaa, 0x1e, 0xa3, 0x30, 0x68, 0xd9, 0xca, 0x88, 0xed, 0x65, 0x64, 0xa3, 0x03,
+	0xb7, 0x7c, 0x2c, 0x24, 0x65, 0xe9, 0xa6, 0x3d, 0xe4, 0xfb, 0x11, 0x16, 0xa2, 0xb6, 0xb2, 0x07,
+	0xf6, 0x2f, 0xb5, 0x6b, 0xbf, 0x3d, 0xbb, 0xba, 0xad, 0xd3, 0xde, 0xc9, 0x90, 0x7b, 0x32, 0xa2,
+	0x8c, 0x78, 0xc6, 0x04, 0x49, 0x23, 0xc6, 0x4d, 0x78, 0xf9, 0x61, 0xc4, 0xc3, 0x22, 0xc7, 0xea,
+	0x82, 0x1c, 0x55, 0x15, 0xad, 0x7f, 0x6a, 0x5d, 0xfc, 0xf6, 0xa9, 0x55, 0xf9, 0xfb, 0xa9, 0x55,
+	0xb1, 0xff, 0x05, 0xf0, 0x8d, 0xae, 0x20, 0x1d, 0x55, 0x30, 0x96, 0x1a, 0xc6, 0xe1, 0x2b, 0x6b,
+	0xf6, 0x1f, 0x00, 0x37, 0xbb, 0x82, 0xdc, 0xa3, 0x84, 0xa1, 0xa0, 0xc3, 0x24, 0x66, 0xd2, 0x70,
+	0xe0, 0xc5, 0xf4, 0xc2, 0xf4, 0xa8, 0x9f, 0xda, 0xbd, 0xd4, 0xde, 0x1a, 0x8f, 0xac, 0x4d, 0xed,
+	0x47, 0x23, 0xb6, 0xb7, 0x9e, 0x7e, 0x76, 0x7c, 0xa3, 0x07, 0xd7, 0x69, 0xca, 0x54, 0x4e, 0x56,
+	0xf7, 0xab, 0xcd, 0x86, 0xb3, 0xa8, 0x25, 0x9c, 0xfb, 0x28, 0xa0, 0x3e, 0x92, 0x3c, 0xca, 0xf6,
+	0x6c, 0x1b, 0xe3, 0x91, 0xb5, 0x91, 0xed, 0xa0, 0x73, 0xd9, 0x5e, 0x9e, 0xf5, 0x65, 0x79, 0xfd,
+	0x05, 0xc0, 0x2b, 0x5d, 0x41, 0xee, 0xe2, 0x00, 0x13, 0x24, 0xf1, 0x92, 0x6e, 0xef, 0xc0, 0xcd,
+	0x41, 0x1c, 0x29, 0xe9, 0xa5, 0xcf, 0x6f, 0x43, 0x13, 0x5e, 0xf2, 0xd9, 0x7d, 0xb7, 0x02, 0xdf,
+	0xec, 0x0a, 0x72, 0x9f, 0x4b, 0xfc, 0x05, 0xfb, 0x9c, 0x0b, 0xf9, 0x65, 0xc4, 0x87, 0x5c, 0xa0,
+	0xe0, 0xdc, 0x9e, 0x0e, 0x61, 0x75, 0xa8, 0xb9, 0x8a, 0xa2, 0xfc, 0xac, 0xb5, 0xdf, 0x1a, 0x8f,
+	0x2c, 0x23, 0xa3, 0x4c, 0x80, 0xb6, 0x07, 0xf3, 0x55, 0xc7, 0x37, 0x3e, 0x83, 0xeb, 0x3c, 0x9d,
+	0x06, 0xca, 0x84, 0x3a, 0xfa, 0xf7, 0xf2, 0xc6, 0x50, 0xe3, 0x28, 0xef, 0x8b, 0xaf, 0x30, 0x25,
+	0x47, 0x12, 0xfb, 0xa9, 0xd2, 0x34, 0xbc, 0xbd, 0xa6, 0xba, 0xc4, 0xcb, 0xc9, 0x33, 0x15, 0x59,
+	0x5b, 0xae, 0x22, 0x26, 0xdc, 0x9d, 0x37, 0xaa, 0x3c, 0x2c, 0x86, 0x9c, 0x09, 0x6c, 0x7f, 0x03,
+	0x60, 0xed, 0x6c, 0x6b, 0xe7, 0xa0, 0x71, 0x08, 0x2f, 0xa0, 0x90, 0xc7, 0x4c, 0x2e, 0xee, 0xf1,
+	0x4c, 0xbd, 0x0e, 0x37, 0x1a, 0x70, 0xf5, 0x21, 0xc6, 0xb5, 0x95, 0x72, 0x2c, 0x15, 0x6b, 0xef,
+	0xc0, 0xb7, 0xcf, 0x74, 0x5d, 0xa1, 0xf1, 0x1d, 0xb8, 0x33, 0x73, 0x49, 0x0b, 0xd0, 0x82, 0xef,
+	0xce, 0x3d, 0xf1, 0x3c, 0xa0, 0xf9, 0xeb, 0x3a, 0x5c, 0xed, 0x0a, 0x62, 0xfc, 0x04, 0xe0, 0x95,
+	0xd9, 0x91, 0x7d, 0x7d, 0x71, 0x63, 0xce, 0xab, 0x5f, 0xfd, 0xd6, 0x72, 0xbc, 0x42, 0xf6, 0xf5,
+	0xaf, 0x7f, 0xff, 0xeb, 0x87, 0x95, 0x8f, 0x5a, 0xe0, 0x03, 0xfb, 0xc3, 0xa9, 0xf7, 0x55, 0x3e,
+	0x51, 0xcf, 0xd1, 0xec, 0x1b, 0x15, 0x65, 0x53, 0xf7, 0x47, 0x00, 0x5f, 0x9f, 0x9e, 0xc3, 0xcd,
+	0x52, 0x4a, 0xa6, 0x38, 0xf5, 0xd6, 0xf9, 0x39, 0x85, 0xf2, 0x5b, 0xa9, 0xf2, 0x8f, 0x95, 0xf2,
+	0x83, 0x52, 0xca, 0x69, 0x96, 0xa6, 0xa7, 0x1d, 0x3c, 0x03, 0xf0, 0xf2, 0xd4, 0x70, 0x6d, 0x94,
+	0x12, 0x33, 0x49, 0xa9, 0xdf, 0x38, 0x37, 0x65, 0xf9, 0xc2, 0x67, 0x23, 0x57, 0x5d, 0x9f, 0x8d,
+	0x33, 0x73, 0xf2, 0xa0, 0x94, 0x8a, 0x69, 0x52, 0xfd, 0xe6, 0x12, 0xa4, 0x42, 0xfc, 0xed, 0x54,
+	0xfc, 0x0d, 0x25, 0xfe, 0x5a, 0x29, 0xf1, 0xbe, 0xce, 0xd3, 0xd3, 0x2e, 0x7e, 0x06, 0xd0, 0x98,
+	0x33, 0x1d, 0x0f, 0x4b, 0x89, 0x9a, 0x25, 0xd6, 0x6f, 0x2f, 0x49, 0x2c, 0x1c, 0x5d, 0x4b, 0x1d,
+	0x39, 0xca, 0xd1, 0xfb, 0xa5, 0x1c, 0x25, 0x5c, 0xe2, 0xf6, 0x83, 0xe7, 0x27, 0x26, 0x78, 0x71,
+	0x62, 0x82, 0x3f, 0x4f, 0x4c, 0xf0, 0xfd, 0xa9, 0x59, 0x79, 0x71, 0x6a, 0x56, 0xfe, 0x38, 0x35,
+	0x2b, 0x0f, 0x3e, 0x21, 0x54, 0x1e, 0xc5, 0x7d, 0x67, 0xc0, 0x43, 0x97, 0x32, 0x82, 0x59, 0x4c,
+	0xe5, 0xf1, 0xd5, 0x7e, 0x4c, 0x03, 0x7f, 0x2a, 0xfd, 0x93, 0x39, 0xa9, 0xe5, 0xf1, 0x10, 0x8b,
+	0xfe, 0x85, 0xf4, 0x4f, 0xde, 0xc1, 0xff, 0x03, 0x00, 0x42, 0x43, 0x3c, 0x45, 0xf6, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -253,9 +537,18 @@ type MsgClient interface {
 	// RequestRedemption defines a method for requesting burning of qAssets for
 	// native assets.
 	RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
+	// InstantRedeem defines a method for burning qAssets for native assets paid
+	// immediately from the zone's liquidity buffer, less a fee.
+	InstantRedeem(ctx context.Context, in *MsgInstantRedeem, opts ...grpc.CallOption) (*MsgInstantRedeemResponse, error)
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	SignalIntent(ctx context.Context, in *MsgSignalIntent, opts ...grpc.CallOption) (*MsgSignalIntentResponse, error)
+	// DelegateIntent defines a method for delegating voting intent to a curator,
+	// whose intent is then followed in place of the delegator's own.
+	DelegateIntent(ctx context.Context, in *MsgDelegateIntent, opts ...grpc.CallOption) (*MsgDelegateIntentResponse, error)
+	// VoteOnHostProposal defines a method for voting on a governance proposal of
+	// a host zone, weighted by the voter's qAsset balance.
+	VoteOnHostProposal(ctx context.Context, in *MsgVoteOnHostProposal, opts ...grpc.CallOption) (*MsgVoteOnHostProposalResponse, error)
 }
 
 type msgClient struct {
@@ -275,6 +568,15 @@ func (c *msgClient) RequestRedemption(ctx context.Context, in *MsgRequestRedempt
 	return out, nil
 }
 
+func (c *msgClient) InstantRedeem(ctx context.Context, in *MsgInstantRedeem, opts ...grpc.CallOption) (*MsgInstantRedeemResponse, error) {
+	out := new(MsgInstantRedeemResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/InstantRedeem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SignalIntent(ctx context.Context, in *MsgSignalIntent, opts ...grpc.CallOption) (*MsgSignalIntentResponse, error) {
 	out := new(MsgSignalIntentResponse)
 	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/SignalIntent", in, out, opts...)
@@ -284,14 +586,41 @@ func (c *msgClient) SignalIntent(ctx context.Context, in *MsgSignalIntent, opts
 	return out, nil
 }
 
+func (c *msgClient) DelegateIntent(ctx context.Context, in *MsgDelegateIntent, opts ...grpc.CallOption) (*MsgDelegateIntentResponse, error) {
+	out := new(MsgDelegateIntentResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/DelegateIntent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteOnHostProposal(ctx context.Context, in *MsgVoteOnHostProposal, opts ...grpc.CallOption) (*MsgVoteOnHostProposalResponse, error) {
+	out := new(MsgVoteOnHostProposalResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/VoteOnHostProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RequestRedemption defines a method for requesting burning of qAssets for
 	// native assets.
 	RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error)
+	// InstantRedeem defines a method for burning qAssets for native assets paid
+	// immediately from the zone's liquidity buffer, less a fee.
+	InstantRedeem(context.Context, *MsgInstantRedeem) (*MsgInstantRedeemResponse, error)
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	SignalIntent(context.Context, *MsgSignalIntent) (*MsgSignalIntentResponse, error)
+	// DelegateIntent defines a method for delegating voting intent to a curator,
+	// whose intent is then followed in place of the delegator's own.
+	DelegateIntent(context.Context, *MsgDelegateIntent) (*MsgDelegateIntentResponse, error)
+	// VoteOnHostProposal defines a method for voting on a governance proposal of
+	// a host zone, weighted by the voter's qAsset balance.
+	VoteOnHostProposal(context.Context, *MsgVoteOnHostProposal) (*MsgVoteOnHostProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -301,9 +630,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestRedemption not implemented")
 }
+func (*UnimplementedMsgServer) InstantRedeem(ctx context.Context, req *MsgInstantRedeem) (*MsgInstantRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantRedeem not implemented")
+}
 func (*UnimplementedMsgServer) SignalIntent(ctx context.Context, req *MsgSignalIntent) (*MsgSignalIntentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalIntent not implemented")
 }
+func (*UnimplementedMsgServer) DelegateIntent(ctx context.Context, req *MsgDelegateIntent) (*MsgDelegateIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateIntent not implemented")
+}
+func (*UnimplementedMsgServer) VoteOnHostProposal(ctx context.Context, req *MsgVoteOnHostProposal) (*MsgVoteOnHostProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteOnHostProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -327,6 +665,24 @@ func _Msg_RequestRedemption_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstantRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantRedeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/InstantRedeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantRedeem(ctx, req.(*MsgInstantRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SignalIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSignalIntent)
 	if err := dec(in); err != nil {
@@ -345,6 +701,42 @@ func _Msg_SignalIntent_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateIntent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/DelegateIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateIntent(ctx, req.(*MsgDelegateIntent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteOnHostProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteOnHostProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteOnHostProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/VoteOnHostProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteOnHostProposal(ctx, req.(*MsgVoteOnHostProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.interchainstaking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -353,10 +745,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestRedemption",
 			Handler:    _Msg_RequestRedemption_Handler,
 		},
+		{
+			MethodName: "InstantRedeem",
+			Handler:    _Msg_InstantRedeem_Handler,
+		},
 		{
 			MethodName: "SignalIntent",
 			Handler:    _Msg_SignalIntent_Handler,
 		},
+		{
+			MethodName: "DelegateIntent",
+			Handler:    _Msg_DelegateIntent_Handler,
+		},
+		{
+			MethodName: "VoteOnHostProposal",
+			Handler:    _Msg_VoteOnHostProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/interchainstaking/v1/messages.proto",
@@ -409,6 +813,53 @@ func (m *MsgRequestRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgInstantRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSignalIntent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -460,7 +911,7 @@ func (m *MsgSignalIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateIntent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -470,21 +921,164 @@ func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateIntent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSignalIntentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CuratorAddress) > 0 {
+		i -= len(m.CuratorAddress)
+		copy(dAtA[i:], m.CuratorAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.CuratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteOnHostProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteOnHostProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteOnHostProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -506,6 +1100,52 @@ func (m *MsgSignalIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteOnHostProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteOnHostProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteOnHostProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessages(v)
 	base := offset
@@ -536,6 +1176,25 @@ func (m *MsgRequestRedemption) Size() (n int) {
 	return n
 }
 
+func (m *MsgInstantRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgSignalIntent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -559,6 +1218,53 @@ func (m *MsgSignalIntent) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.CuratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteOnHostProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovMessages(uint64(m.ProposalId))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgRequestRedemptionResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -568,6 +1274,19 @@ func (m *MsgRequestRedemptionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInstantRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
 func (m *MsgSignalIntentResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -577,13 +1296,473 @@ func (m *MsgSignalIntentResponse) Size() (n int) {
 	return n
 }
 
-func sovMessages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgDelegateIntentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteOnHostProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantRedeem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantRedeem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intents = append(m.Intents, &ValidatorIntent{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateIntent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,17 +1785,17 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateIntent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateIntent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -626,28 +1805,27 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CuratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -675,7 +1853,7 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			m.CuratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -730,7 +1908,7 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignalIntent) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteOnHostProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -753,10 +1931,10 @@ func (m *MsgSignalIntent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignalIntent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteOnHostProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignalIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteOnHostProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -792,8 +1970,27 @@ func (m *MsgSignalIntent) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -820,12 +2017,12 @@ func (m *MsgSignalIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Intents = append(m.Intents, &ValidatorIntent{})
-			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, types1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
@@ -928,6 +2125,122 @@ func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgInstantRedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSignalIntentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -978,6 +2291,106 @@ func (m *MsgSignalIntentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteOnHostProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteOnHostProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteOnHostProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,19 +7,26 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/ingenuity-build/quicksilver/internal/multierror"
 )
 
 // interchainstaking message types
 const (
-	TypeMsgRequestRedemption = "requestredemption"
-	TypeMsgSignalIntent      = "signalintent"
+	TypeMsgRequestRedemption  = "requestredemption"
+	TypeMsgInstantRedeem      = "instantredeem"
+	TypeMsgSignalIntent       = "signalintent"
+	TypeMsgDelegateIntent     = "delegateintent"
+	TypeMsgVoteOnHostProposal = "voteonhostproposal"
 )
 
 var (
 	_ sdk.Msg = &MsgRequestRedemption{}
+	_ sdk.Msg = &MsgInstantRedeem{}
 	_ sdk.Msg = &MsgSignalIntent{}
+	_ sdk.Msg = &MsgDelegateIntent{}
+	_ sdk.Msg = &MsgVoteOnHostProposal{}
 )
 
 // NewMsgRequestRedemption - construct a msg to request redemption.
@@ -68,6 +75,59 @@ func (msg MsgRequestRedemption) GetSigners() []sdk.AccAddress {
 
 //----------------------------------------------------------------
 
+// NewMsgInstantRedeem - construct a msg to redeem qAssets from the liquidity buffer.
+func NewMsgInstantRedeem(value sdk.Coin, destinationAddress string, fromAddress sdk.Address) *MsgInstantRedeem {
+	return &MsgInstantRedeem{Value: value, DestinationAddress: destinationAddress, FromAddress: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgInstantRedeem) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgInstantRedeem) Type() string { return TypeMsgInstantRedeem }
+
+// ValidateBasic Implements Msg.
+func (msg MsgInstantRedeem) ValidateBasic() error {
+	errors := make(map[string]error)
+
+	// check from address
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		errors["FromAddress"] = err
+	}
+
+	// check destination address
+	if _, _, err := bech32.DecodeAndConvert(msg.DestinationAddress); err != nil {
+		errors["DestinationAddress"] = err
+	}
+
+	// check coin
+	if err = msg.Value.Validate(); err != nil {
+		errors["Value"] = err
+	} else if !msg.Value.IsPositive() {
+		errors["Value"] = fmt.Errorf("must be positive")
+	}
+
+	if len(errors) > 0 {
+		return multierror.New(errors)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgInstantRedeem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgInstantRedeem) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+//----------------------------------------------------------------
+
 // IntentsFromString parses and validates the given string into a slice
 // containing pointers to ValidatorIntent.
 //
@@ -176,3 +236,123 @@ func (msg MsgSignalIntent) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
 	return []sdk.AccAddress{fromAddress}
 }
+
+//----------------------------------------------------------------
+
+// NewMsgDelegateIntent - construct a msg to follow the intent of a curator.
+func NewMsgDelegateIntent(chainID string, curatorAddress string, fromAddress sdk.Address) *MsgDelegateIntent {
+	return &MsgDelegateIntent{ChainId: chainID, CuratorAddress: curatorAddress, FromAddress: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgDelegateIntent) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgDelegateIntent) Type() string { return TypeMsgDelegateIntent }
+
+// ValidateBasic Implements Msg.
+func (msg MsgDelegateIntent) ValidateBasic() error {
+	errors := make(map[string]error)
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		errors["FromAddress"] = err
+	}
+
+	if msg.ChainId == "" {
+		errors["ChainId"] = fmt.Errorf("undefined")
+	}
+
+	// an empty curator address removes the delegation.
+	if msg.CuratorAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.CuratorAddress); err != nil {
+			errors["CuratorAddress"] = err
+		} else if msg.CuratorAddress == msg.FromAddress {
+			errors["CuratorAddress"] = fmt.Errorf("cannot delegate intent to self")
+		}
+	}
+
+	if len(errors) > 0 {
+		return multierror.New(errors)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDelegateIntent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDelegateIntent) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+//----------------------------------------------------------------
+
+// NewMsgVoteOnHostProposal - construct a msg to vote on a host zone governance proposal.
+func NewMsgVoteOnHostProposal(chainID string, proposalID uint64, options govtypes.WeightedVoteOptions, fromAddress sdk.Address) *MsgVoteOnHostProposal {
+	return &MsgVoteOnHostProposal{ChainId: chainID, ProposalId: proposalID, Options: options, FromAddress: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgVoteOnHostProposal) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgVoteOnHostProposal) Type() string { return TypeMsgVoteOnHostProposal }
+
+// ValidateBasic Implements Msg.
+func (msg MsgVoteOnHostProposal) ValidateBasic() error {
+	errors := make(map[string]error)
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		errors["FromAddress"] = err
+	}
+
+	if msg.ChainId == "" {
+		errors["ChainId"] = fmt.Errorf("undefined")
+	}
+
+	if msg.ProposalId == 0 {
+		errors["ProposalId"] = fmt.Errorf("undefined")
+	}
+
+	if len(msg.Options) == 0 {
+		errors["Options"] = fmt.Errorf("undefined")
+	}
+
+	wantSum := sdk.OneDec()
+	weightSum := sdk.ZeroDec()
+	usedOptions := make(map[govtypes.VoteOption]bool)
+	for i, option := range msg.Options {
+		if !govtypes.ValidWeightedVoteOption(option) {
+			errors[fmt.Sprintf("Option_%02d", i)] = fmt.Errorf("invalid option %s", option.String())
+			continue
+		}
+		if usedOptions[option.Option] {
+			errors[fmt.Sprintf("Option_%02d", i)] = fmt.Errorf("duplicated option %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+		weightSum = weightSum.Add(option.Weight)
+	}
+
+	if len(msg.Options) > 0 && !weightSum.Equal(wantSum) {
+		errors["OptionWeights"] = fmt.Errorf("sum of weights is %v, not %v", weightSum, wantSum)
+	}
+
+	if len(errors) > 0 {
+		return multierror.New(errors)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgVoteOnHostProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgVoteOnHostProposal) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -111,3 +112,70 @@ func TestIntentsFromStringInvalidValoperAddressesFailsOnValidate(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid checksum")
 	require.Contains(t, err.Error(), "undefined")
 }
+
+func TestMsgDelegateIntentValidateBasic(t *testing.T) {
+	fromAddr := (sdk.AccAddress)([]byte{0x84, 0xbf, 0xf8, 0x4c, 0x7d, 0xda, 0xd1, 0x1c, 0xb8, 0xc0, 0x73, 0x86, 0xe9, 0x19, 0x28, 0xc5, 0x67, 0x5c, 0xa4, 0xbc})
+	curatorAddr := (sdk.AccAddress)([]byte{0x01, 0xbf, 0xf8, 0x4c, 0x7d, 0xda, 0xd1, 0x1c, 0xb8, 0xc0, 0x73, 0x86, 0xe9, 0x19, 0x28, 0xc5, 0x67, 0x5c, 0xa4, 0xbc})
+
+	msg := types.NewMsgDelegateIntent("quicksilver", curatorAddr.String(), fromAddr)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgDelegateIntent, msg.Type())
+
+	// empty curator address clears the delegation.
+	msg = types.NewMsgDelegateIntent("quicksilver", "", fromAddr)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgDelegateIntent("quicksilver", fromAddr.String(), fromAddr)
+	require.ErrorContains(t, msg.ValidateBasic(), "cannot delegate intent to self")
+
+	msg = types.NewMsgDelegateIntent("", "notanaddress", fromAddr)
+	err := msg.ValidateBasic()
+	require.ErrorContains(t, err, "ChainId")
+	require.ErrorContains(t, err, "CuratorAddress")
+}
+
+func TestMsgVoteOnHostProposalValidateBasic(t *testing.T) {
+	fromAddr := (sdk.AccAddress)([]byte{0x84, 0xbf, 0xf8, 0x4c, 0x7d, 0xda, 0xd1, 0x1c, 0xb8, 0xc0, 0x73, 0x86, 0xe9, 0x19, 0x28, 0xc5, 0x67, 0x5c, 0xa4, 0xbc})
+
+	msg := types.NewMsgVoteOnHostProposal("cosmoshub-4", 1, govtypes.NewNonSplitVoteOption(govtypes.OptionYes), fromAddr)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.TypeMsgVoteOnHostProposal, msg.Type())
+
+	msg = types.NewMsgVoteOnHostProposal("cosmoshub-4", 1, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionYes, Weight: sdk.MustNewDecFromStr("0.6")},
+		{Option: govtypes.OptionNo, Weight: sdk.MustNewDecFromStr("0.4")},
+	}, fromAddr)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgVoteOnHostProposal("cosmoshub-4", 1, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionYes, Weight: sdk.MustNewDecFromStr("0.5")},
+		{Option: govtypes.OptionYes, Weight: sdk.MustNewDecFromStr("0.5")},
+	}, fromAddr)
+	require.ErrorContains(t, msg.ValidateBasic(), "duplicated option")
+
+	msg = types.NewMsgVoteOnHostProposal("cosmoshub-4", 1, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionYes, Weight: sdk.MustNewDecFromStr("0.5")},
+	}, fromAddr)
+	require.ErrorContains(t, msg.ValidateBasic(), "sum of weights")
+
+	msg = types.NewMsgVoteOnHostProposal("", 0, govtypes.WeightedVoteOptions{}, fromAddr)
+	err := msg.ValidateBasic()
+	require.ErrorContains(t, err, "ChainId")
+	require.ErrorContains(t, err, "ProposalId")
+	require.ErrorContains(t, err, "Options")
+}
+
+func TestMsgInstantRedeemValidateBasic(t *testing.T) {
+	fromAddr := (sdk.AccAddress)([]byte{0x84, 0xbf, 0xf8, 0x4c, 0x7d, 0xda, 0xd1, 0x1c, 0xb8, 0xc0, 0x73, 0x86, 0xe9, 0x19, 0x28, 0xc5, 0x67, 0x5c, 0xa4, 0xbc})
+
+	msg := types.NewMsgInstantRedeem(sdk.NewCoin("uqatom", sdk.NewInt(1000)), "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e", fromAddr)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgInstantRedeem, msg.Type())
+
+	msg = types.NewMsgInstantRedeem(sdk.NewCoin("uqatom", sdk.ZeroInt()), "notanaddress", fromAddr)
+	err := msg.ValidateBasic()
+	require.ErrorContains(t, err, "DestinationAddress")
+	require.ErrorContains(t, err, "Value")
+}
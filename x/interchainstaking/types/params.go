@@ -16,6 +16,9 @@ var (
 	DefaultDepositInterval      uint64  = 20
 	DefaultValidatorSetInterval uint64  = 200
 	DefaultCommissionRate       sdk.Dec = sdk.MustNewDecFromStr("0.025")
+	DefaultMaxCuratorWeight     sdk.Dec = sdk.MustNewDecFromStr("0.1")
+	DefaultInstantRedemptionFee sdk.Dec = sdk.MustNewDecFromStr("0.005")
+	DefaultQueryBounty          sdk.Int = sdk.ZeroInt()
 
 	// KeyDelegateAccountCount is store's key for DelegateAccountCount option
 	KeyDelegateAccountCount = []byte("DelegateAccountCount")
@@ -25,6 +28,12 @@ var (
 	KeyValidatorSetInterval = []byte("ValidatorSetInterval")
 	// KeyCommissionRate is store's key for the CommissionRate option
 	KeyCommissionRate = []byte("CommissionRate")
+	// KeyMaxCuratorWeight is store's key for the MaxCuratorWeight option
+	KeyMaxCuratorWeight = []byte("MaxCuratorWeight")
+	// KeyInstantRedemptionFee is store's key for the InstantRedemptionFee option
+	KeyInstantRedemptionFee = []byte("InstantRedemptionFee")
+	// KeyQueryBounty is store's key for the QueryBounty option
+	KeyQueryBounty = []byte("QueryBounty")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -77,6 +86,30 @@ func validateParams(i interface{}) error {
 	if v.CommissionRate.IsNegative() {
 		return fmt.Errorf("commission rate must be non-negative: %s", v.CommissionRate.String())
 	}
+
+	if v.MaxCuratorWeight.IsNil() {
+		return fmt.Errorf("max curator weight must be non-nil")
+	}
+
+	if v.MaxCuratorWeight.IsNegative() || v.MaxCuratorWeight.GT(sdk.OneDec()) {
+		return fmt.Errorf("max curator weight must be between 0 and 1: %s", v.MaxCuratorWeight.String())
+	}
+
+	if v.InstantRedemptionFee.IsNil() {
+		return fmt.Errorf("instant redemption fee must be non-nil")
+	}
+
+	if v.InstantRedemptionFee.IsNegative() || v.InstantRedemptionFee.GT(sdk.OneDec()) {
+		return fmt.Errorf("instant redemption fee must be between 0 and 1: %s", v.InstantRedemptionFee.String())
+	}
+
+	if v.QueryBounty.IsNil() {
+		return fmt.Errorf("query bounty must be non-nil")
+	}
+
+	if v.QueryBounty.IsNegative() {
+		return fmt.Errorf("query bounty must be non-negative: %s", v.QueryBounty.String())
+	}
 	return nil
 }
 
@@ -86,12 +119,18 @@ func NewParams(
 	depositInterval uint64,
 	valsetInterval uint64,
 	commissionRate sdk.Dec,
+	maxCuratorWeight sdk.Dec,
+	instantRedemptionFee sdk.Dec,
+	queryBounty sdk.Int,
 ) Params {
 	return Params{
 		DelegationAccountCount: delegateAccountCount,
 		DepositInterval:        depositInterval,
 		ValidatorsetInterval:   valsetInterval,
 		CommissionRate:         commissionRate,
+		MaxCuratorWeight:       maxCuratorWeight,
+		InstantRedemptionFee:   instantRedemptionFee,
+		QueryBounty:            queryBounty,
 	}
 }
 
@@ -102,6 +141,9 @@ func DefaultParams() Params {
 		DefaultDepositInterval,
 		DefaultValidatorSetInterval,
 		DefaultCommissionRate,
+		DefaultMaxCuratorWeight,
+		DefaultInstantRedemptionFee,
+		DefaultQueryBounty,
 	)
 }
 
@@ -117,9 +159,25 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDepositInterval, &p.DepositInterval, validatePositiveInt),
 		paramtypes.NewParamSetPair(KeyValidatorSetInterval, &p.ValidatorsetInterval, validatePositiveInt),
 		paramtypes.NewParamSetPair(KeyCommissionRate, &p.CommissionRate, validateNonNegativeDec),
+		paramtypes.NewParamSetPair(KeyMaxCuratorWeight, &p.MaxCuratorWeight, validateFraction),
+		paramtypes.NewParamSetPair(KeyInstantRedemptionFee, &p.InstantRedemptionFee, validateFraction),
+		paramtypes.NewParamSetPair(KeyQueryBounty, &p.QueryBounty, validateNonNegativeInt),
 	}
 }
 
+// Get returns the zone override of the module parameter with the given key, or zero if it is not overridden.
+func (p ZoneParams) Get(key []byte) uint64 {
+	switch {
+	case bytes.Equal(key, KeyDepositInterval):
+		return p.DepositInterval
+	case bytes.Equal(key, KeyValidatorSetInterval):
+		return p.ValidatorSetInterval
+	case bytes.Equal(key, KeyDelegateAccountCount):
+		return p.DelegateAccountCount
+	}
+	return 0
+}
+
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -148,3 +206,27 @@ func validateNonNegativeDec(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFraction(i interface{}) error {
+	decval, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if decval.IsNegative() || decval.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid parameter value, must be between 0 and 1: %s", decval)
+	}
+	return nil
+}
+
+func validateNonNegativeInt(i interface{}) error {
+	intval, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if intval.IsNil() || intval.IsNegative() {
+		return fmt.Errorf("invalid (negative) parameter value: %s", intval)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+const (
+	PreflightCheckBondDenom       = "bond_denom"
+	PreflightCheckAccountPrefix   = "account_prefix"
+	PreflightCheckAllowedMessages = "allowed_messages"
+)
+
+// IsActive returns true if the zone has passed its preflight checks. Zones registered before preflight checks were
+// introduced have no preflight state, and are active.
+func (z Zone) IsActive() bool {
+	return z.Preflight == nil
+}
+
+// Passed returns true if every preflight check has passed.
+func (p ZonePreflight) Passed() bool {
+	return p.BondDenom && p.AccountPrefix && p.AllowedMessages
+}
+
+// RequiredHostMessages returns the type urls of the messages the zone's interchain accounts must be allowed to
+// execute on the host chain.
+func (z Zone) RequiredHostMessages() []string {
+	msgs := []sdk.Msg{
+		&stakingtypes.MsgDelegate{},
+		&stakingtypes.MsgUndelegate{},
+		&banktypes.MsgSend{},
+		&distrtypes.MsgSetWithdrawAddress{},
+		&distrtypes.MsgWithdrawDelegatorReward{},
+		&ibctransfertypes.MsgTransfer{},
+		&govtypes.MsgVoteWeighted{},
+	}
+	if z.SupportMultiSend() {
+		msgs = append(msgs, &banktypes.MsgMultiSend{})
+	}
+	if z.SupportLsm() {
+		msgs = append(msgs, &stakingtypes.MsgTokenizeShares{}, &stakingtypes.MsgRedeemTokensforShares{})
+	}
+
+	out := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		out = append(out, sdk.MsgTypeURL(msg))
+	}
+	return out
+}
+
+// HostParamKey returns the key of a parameter in the params store of a host chain.
+func HostParamKey(subspace string, key []byte) []byte {
+	return append([]byte(subspace+"/"), key...)
+}
+
+// HostBondDenomKey is the key of the staking bond denom in the params store of a host chain.
+var HostBondDenomKey = HostParamKey(stakingtypes.ModuleName, stakingtypes.KeyBondDenom)
+
+// HostAllowMessagesKey is the key of the interchain accounts host allow list in the params store of a host chain.
+var HostAllowMessagesKey = HostParamKey(icahosttypes.SubModuleName, icahosttypes.KeyAllowMessages)
+
+// ParseHostBondDenom parses the staking bond denom from its value in the params store of a host chain.
+func ParseHostBondDenom(value []byte) (string, error) {
+	var denom string
+	if err := json.Unmarshal(value, &denom); err != nil {
+		return "", fmt.Errorf("unable to parse host bond denom: %w", err)
+	}
+	return denom, nil
+}
+
+// MissingHostMessages returns the messages required by the zone that are absent from the interchain accounts host
+// allow list, given its value in the params store of the host chain.
+func (z Zone) MissingHostMessages(value []byte) ([]string, error) {
+	var allowed []string
+	if err := json.Unmarshal(value, &allowed); err != nil {
+		return nil, fmt.Errorf("unable to parse host allowed messages: %w", err)
+	}
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, msg := range allowed {
+		allowedSet[msg] = true
+	}
+
+	missing := []string{}
+	for _, msg := range z.RequiredHostMessages() {
+		if !allowedSet[msg] {
+			missing = append(missing, msg)
+		}
+	}
+	return missing, nil
+}
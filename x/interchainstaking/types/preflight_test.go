@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestHostParamKeys(t *testing.T) {
+	require.Equal(t, []byte("staking/BondDenom"), types.HostBondDenomKey)
+	require.Equal(t, []byte("icahost/AllowMessages"), types.HostAllowMessagesKey)
+
+	denom, err := types.ParseHostBondDenom([]byte(`"uatom"`))
+	require.NoError(t, err)
+	require.Equal(t, "uatom", denom)
+	_, err = types.ParseHostBondDenom([]byte("uatom"))
+	require.Error(t, err)
+}
+
+func TestMissingHostMessages(t *testing.T) {
+	zone := types.Zone{ChainId: "cosmoshub-4", BaseDenom: "uatom"}
+	all := []byte(`["/cosmos.bank.v1beta1.MsgSend","/cosmos.bank.v1beta1.MsgMultiSend","/cosmos.staking.v1beta1.MsgDelegate","/cosmos.staking.v1beta1.MsgUndelegate","/cosmos.distribution.v1beta1.MsgSetWithdrawAddress","/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward","/cosmos.gov.v1beta1.MsgVoteWeighted","/ibc.applications.transfer.v1.MsgTransfer"]`)
+
+	missing, err := zone.MissingHostMessages(all)
+	require.NoError(t, err)
+	require.Empty(t, missing)
+
+	// multisend and lsm zones require additional messages.
+	zone.MultiSend = true
+	zone.LiquidityModule = true
+	missing, err = zone.MissingHostMessages(all)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/cosmos.staking.v1beta1.MsgTokenizeShares", "/cosmos.staking.v1beta1.MsgRedeemTokensforShares"}, missing)
+
+	missing, err = zone.MissingHostMessages([]byte(`["/cosmos.bank.v1beta1.MsgSend"]`))
+	require.NoError(t, err)
+	require.Len(t, missing, len(zone.RequiredHostMessages())-1)
+
+	_, err = zone.MissingHostMessages([]byte("not json"))
+	require.Error(t, err)
+}
@@ -67,7 +67,11 @@ func (m RegisterZoneProposal) String() string {
   Local Denom:                      %s
   Multi Send Enabled:               %t
   Liquidity Staking Module Enabled: %t
-`, m.Title, m.Description, m.ConnectionId, m.BaseDenom, m.LocalDenom, m.MultiSend, m.LiquidityModule))
+  Deposit Interval:                 %d
+  Validator Set Interval:           %d
+  Delegate Account Count:           %d
+`, m.Title, m.Description, m.ConnectionId, m.BaseDenom, m.LocalDenom, m.MultiSend, m.LiquidityModule,
+		m.Params.DepositInterval, m.Params.ValidatorSetInterval, m.Params.DelegateAccountCount))
 	return b.String()
 }
 
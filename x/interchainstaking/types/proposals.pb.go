@@ -34,6 +34,9 @@ type RegisterZoneProposal struct {
 	AccountPrefix   string `protobuf:"bytes,6,opt,name=account_prefix,json=accountPrefix,proto3" json:"account_prefix,omitempty" yaml:"account_prefix"`
 	MultiSend       bool   `protobuf:"varint,7,opt,name=multi_send,json=multiSend,proto3" json:"multi_send,omitempty"`
 	LiquidityModule bool   `protobuf:"varint,8,opt,name=liquidity_module,json=liquidityModule,proto3" json:"liquidity_module,omitempty"`
+	// params overrides module parameters for the zone; zero values are not
+	// overridden.
+	Params ZoneParams `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
 }
 
 func (m *RegisterZoneProposal) Reset()      { *m = RegisterZoneProposal{} }
@@ -69,15 +72,16 @@ func (m *RegisterZoneProposal) XXX_DiscardUnknown() {
 var xxx_messageInfo_RegisterZoneProposal proto.InternalMessageInfo
 
 type RegisterZoneProposalWithDeposit struct {
-	Title           string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
-	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	ConnectionId    string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	BaseDenom       string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
-	LocalDenom      string `protobuf:"bytes,5,opt,name=local_denom,json=localDenom,proto3" json:"local_denom,omitempty" yaml:"local_denom"`
-	AccountPrefix   string `protobuf:"bytes,6,opt,name=account_prefix,json=accountPrefix,proto3" json:"account_prefix,omitempty" yaml:"account_prefix"`
-	MultiSend       bool   `protobuf:"varint,7,opt,name=multi_send,json=multiSend,proto3" json:"multi_send,omitempty" yaml:"multi_send"`
-	LiquidityModule bool   `protobuf:"varint,8,opt,name=liquidity_module,json=liquidityModule,proto3" json:"liquidity_module,omitempty" yaml:"liquidity_module"`
-	Deposit         string `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+	Title           string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description     string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ConnectionId    string     `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	BaseDenom       string     `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	LocalDenom      string     `protobuf:"bytes,5,opt,name=local_denom,json=localDenom,proto3" json:"local_denom,omitempty" yaml:"local_denom"`
+	AccountPrefix   string     `protobuf:"bytes,6,opt,name=account_prefix,json=accountPrefix,proto3" json:"account_prefix,omitempty" yaml:"account_prefix"`
+	MultiSend       bool       `protobuf:"varint,7,opt,name=multi_send,json=multiSend,proto3" json:"multi_send,omitempty" yaml:"multi_send"`
+	LiquidityModule bool       `protobuf:"varint,8,opt,name=liquidity_module,json=liquidityModule,proto3" json:"liquidity_module,omitempty" yaml:"liquidity_module"`
+	Deposit         string     `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+	Params          ZoneParams `protobuf:"bytes,10,opt,name=params,proto3" json:"params" yaml:"params"`
 }
 
 func (m *RegisterZoneProposalWithDeposit) Reset()         { *m = RegisterZoneProposalWithDeposit{} }
@@ -260,51 +264,54 @@ func init() {
 }
 
 var fileDescriptor_04d034c830a7acfe = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x4d, 0x9a, 0xfe, 0x24, 0xd3, 0xdf, 0x6f, 0xbe, 0x14, 0xdc, 0x42, 0xe3, 0x68, 0x16, 0xa8,
-	0x48, 0x60, 0x53, 0xa8, 0x04, 0xaa, 0x84, 0x54, 0x45, 0x15, 0x52, 0x17, 0x48, 0x95, 0x11, 0x20,
-	0x95, 0x85, 0xe5, 0xd8, 0x83, 0x3b, 0x8a, 0x33, 0xe3, 0x7a, 0xc6, 0x51, 0xf3, 0x06, 0x5d, 0xb2,
-	0x01, 0xb1, 0xec, 0x96, 0x37, 0x41, 0xac, 0xba, 0x64, 0x65, 0xa1, 0x76, 0xc3, 0xda, 0x4f, 0x80,
-	0x3c, 0xe3, 0x10, 0xa7, 0x09, 0x42, 0x2c, 0x40, 0x48, 0xec, 0xee, 0xbd, 0xe7, 0x9e, 0xc9, 0xcd,
-	0x39, 0x77, 0x3c, 0xe0, 0xde, 0x71, 0x4c, 0xdc, 0x0e, 0x27, 0x41, 0x0f, 0x47, 0x26, 0xa1, 0x02,
-	0x47, 0xee, 0x91, 0x43, 0x28, 0x17, 0x4e, 0x87, 0x50, 0xdf, 0xec, 0x6d, 0x99, 0x61, 0xc4, 0x42,
-	0xc6, 0x9d, 0x80, 0x1b, 0x61, 0xc4, 0x04, 0x83, 0xcd, 0x02, 0xc3, 0x18, 0x63, 0x18, 0xbd, 0xad,
-	0xf5, 0xba, 0xcf, 0x7c, 0x26, 0x9b, 0xcd, 0x2c, 0x52, 0xbc, 0xf5, 0x35, 0x97, 0xf1, 0x2e, 0xe3,
-	0xb6, 0x02, 0x54, 0x92, 0x43, 0x37, 0x7d, 0xc6, 0xfc, 0x00, 0x9b, 0x4e, 0x48, 0x4c, 0x87, 0x52,
-	0x26, 0x1c, 0x41, 0x18, 0xcd, 0x51, 0xf4, 0xae, 0x02, 0xea, 0x16, 0xf6, 0x09, 0x17, 0x38, 0x3a,
-	0x64, 0x14, 0x1f, 0xe4, 0x03, 0xc1, 0x3a, 0x98, 0x11, 0x44, 0x04, 0x58, 0x2b, 0x37, 0xcb, 0x9b,
-	0x35, 0x4b, 0x25, 0xb0, 0x09, 0xe6, 0x3d, 0xcc, 0xdd, 0x88, 0x84, 0xd9, 0x21, 0xda, 0x94, 0xc4,
-	0x8a, 0x25, 0xf8, 0x18, 0x2c, 0xba, 0x8c, 0x52, 0xec, 0x66, 0x99, 0x4d, 0x3c, 0xad, 0x92, 0xf5,
-	0xb4, 0xb4, 0x34, 0xd1, 0xeb, 0x7d, 0xa7, 0x1b, 0xec, 0xa0, 0x11, 0x18, 0x59, 0x0b, 0xc3, 0x7c,
-	0xdf, 0x83, 0xdb, 0x00, 0xb4, 0x1d, 0x8e, 0x6d, 0x0f, 0x53, 0xd6, 0xd5, 0xa6, 0x25, 0x77, 0x35,
-	0x4d, 0xf4, 0xff, 0x14, 0x77, 0x88, 0x21, 0xab, 0x96, 0x25, 0x7b, 0x59, 0x0c, 0x1f, 0x82, 0xf9,
-	0x80, 0xb9, 0x4e, 0x90, 0xd3, 0x66, 0x24, 0xed, 0x5a, 0x9a, 0xe8, 0x50, 0xd1, 0x0a, 0x20, 0xb2,
-	0x80, 0xcc, 0x14, 0x71, 0x17, 0x2c, 0x39, 0xae, 0xcb, 0x62, 0x2a, 0xec, 0x30, 0xc2, 0xaf, 0xc9,
-	0x89, 0x36, 0x2b, 0xb9, 0x6b, 0x69, 0xa2, 0xaf, 0x2a, 0xee, 0x28, 0x8e, 0xac, 0xc5, 0xbc, 0x70,
-	0x20, 0x73, 0xb8, 0x01, 0x40, 0x37, 0x0e, 0x04, 0xb1, 0x39, 0xa6, 0x9e, 0x36, 0xd7, 0x2c, 0x6f,
-	0x56, 0xad, 0x9a, 0xac, 0x3c, 0xc3, 0xd4, 0x83, 0xb7, 0xc1, 0x4a, 0x40, 0x8e, 0x63, 0xe2, 0x11,
-	0xd1, 0xb7, 0xbb, 0xcc, 0x8b, 0x03, 0xac, 0x55, 0x65, 0xd3, 0xf2, 0xf7, 0xfa, 0x53, 0x59, 0xde,
-	0x59, 0x38, 0x3d, 0xd3, 0x4b, 0xef, 0xcf, 0xf4, 0xd2, 0xd7, 0x33, 0xbd, 0x84, 0x3e, 0x4c, 0x03,
-	0x7d, 0x92, 0x31, 0x2f, 0x89, 0x38, 0xda, 0xc3, 0x21, 0xe3, 0x44, 0xc0, 0x5b, 0x23, 0x1e, 0xb5,
-	0x56, 0xd2, 0x44, 0x5f, 0x50, 0x43, 0xcb, 0x32, 0x1a, 0xb8, 0xf6, 0x68, 0x82, 0x6b, 0x45, 0x79,
-	0x0a, 0x20, 0xfa, 0xb7, 0xdd, 0xdc, 0x1e, 0x77, 0xb3, 0x38, 0xf0, 0x10, 0x43, 0x45, 0x93, 0x9f,
-	0xfc, 0xc8, 0xe4, 0xd6, 0x8d, 0x34, 0xd1, 0xaf, 0xe7, 0x53, 0x5f, 0xe9, 0x40, 0x63, 0x1b, 0x00,
-	0xef, 0x80, 0x39, 0x4f, 0x59, 0xab, 0xd5, 0xe4, 0xe0, 0x30, 0x4d, 0xf4, 0xa5, 0x81, 0x47, 0x12,
-	0x40, 0xd6, 0xa0, 0x65, 0xa7, 0x7a, 0x3a, 0xd8, 0x95, 0xb7, 0x53, 0x00, 0x3e, 0x0f, 0x3d, 0x47,
-	0xe0, 0x91, 0x2b, 0xfc, 0xfb, 0xd7, 0xc3, 0x00, 0x55, 0xf9, 0x7d, 0x1a, 0x6e, 0xc6, 0xff, 0x69,
-	0xa2, 0x2f, 0xe7, 0x9b, 0x91, 0x23, 0xc8, 0x9a, 0x93, 0xe1, 0xbe, 0x07, 0x6d, 0x90, 0x85, 0xd4,
-	0xc7, 0x5c, 0x9b, 0x6e, 0x56, 0x36, 0xe7, 0xef, 0x6f, 0x19, 0x3f, 0xfb, 0xe0, 0x19, 0xc3, 0x3f,
-	0xf6, 0xc2, 0x09, 0x62, 0x5c, 0xd4, 0x24, 0x3f, 0x4b, 0xfd, 0x40, 0x16, 0x5d, 0xb9, 0x43, 0x9f,
-	0xa6, 0xc0, 0xc6, 0xb8, 0x2e, 0x7f, 0xf6, 0x06, 0xfd, 0x6d, 0x12, 0x15, 0x97, 0x6c, 0xe6, 0x57,
-	0x96, 0xec, 0x15, 0x58, 0xbe, 0xf2, 0x3b, 0xb0, 0x09, 0x2a, 0x1d, 0xdc, 0xcf, 0xb5, 0x5b, 0x4a,
-	0x13, 0x1d, 0xa8, 0x63, 0x3a, 0xb8, 0x8f, 0xac, 0x0c, 0xca, 0xf4, 0xed, 0x65, 0xad, 0xb9, 0x62,
-	0x05, 0x7d, 0x65, 0x19, 0x59, 0x0a, 0x6e, 0x1d, 0x7e, 0xbc, 0x68, 0x94, 0xcf, 0x2f, 0x1a, 0xe5,
-	0x2f, 0x17, 0x8d, 0xf2, 0x9b, 0xcb, 0x46, 0xe9, 0xfc, 0xb2, 0x51, 0xfa, 0x7c, 0xd9, 0x28, 0x1d,
-	0xee, 0xfa, 0x44, 0x1c, 0xc5, 0x6d, 0xc3, 0x65, 0x5d, 0x93, 0x50, 0x1f, 0xd3, 0x98, 0x88, 0xfe,
-	0xdd, 0x76, 0x4c, 0x02, 0xcf, 0x2c, 0x3e, 0xaf, 0x27, 0x13, 0x1e, 0x58, 0xd1, 0x0f, 0x31, 0x6f,
-	0xcf, 0xca, 0x97, 0xee, 0xc1, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xc6, 0x3c, 0xe1, 0x8e,
-	0x07, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x6b, 0xdb, 0x48,
+	0x14, 0xc7, 0xfd, 0x23, 0x4e, 0xec, 0x71, 0x7e, 0xed, 0xac, 0xb3, 0xab, 0x64, 0x37, 0x96, 0x99,
+	0xc3, 0xe2, 0x85, 0xac, 0xb4, 0xc9, 0x06, 0x76, 0x09, 0x2c, 0x04, 0x13, 0x0a, 0x29, 0x14, 0x82,
+	0x4a, 0x5b, 0x48, 0x0e, 0x46, 0x96, 0xa6, 0xca, 0x60, 0x79, 0x46, 0x91, 0x46, 0x26, 0xfe, 0x07,
+	0x4a, 0x8e, 0xbd, 0x14, 0x7a, 0xcc, 0x9f, 0x13, 0x7a, 0xca, 0xb1, 0x27, 0x51, 0x9c, 0x4b, 0xcf,
+	0xfa, 0x0b, 0x8a, 0x66, 0xe4, 0x58, 0xb6, 0x53, 0x0c, 0x85, 0x96, 0x42, 0x6f, 0xf3, 0xde, 0xf7,
+	0x7d, 0x67, 0xc6, 0xef, 0x7d, 0xe4, 0x01, 0x7f, 0x5f, 0x84, 0xc4, 0xea, 0x06, 0xc4, 0xed, 0x63,
+	0x5f, 0x27, 0x94, 0x63, 0xdf, 0x3a, 0x37, 0x09, 0x0d, 0xb8, 0xd9, 0x25, 0xd4, 0xd1, 0xfb, 0xbb,
+	0xba, 0xe7, 0x33, 0x8f, 0x05, 0xa6, 0x1b, 0x68, 0x9e, 0xcf, 0x38, 0x83, 0x8d, 0x8c, 0x43, 0x9b,
+	0x71, 0x68, 0xfd, 0xdd, 0xad, 0x9a, 0xc3, 0x1c, 0x26, 0x8a, 0xf5, 0x64, 0x25, 0x7d, 0x5b, 0x9b,
+	0x16, 0x0b, 0x7a, 0x2c, 0x68, 0x4b, 0x41, 0x06, 0xa9, 0xf4, 0xbb, 0xc3, 0x98, 0xe3, 0x62, 0xdd,
+	0xf4, 0x88, 0x6e, 0x52, 0xca, 0xb8, 0xc9, 0x09, 0xa3, 0x23, 0x55, 0x9b, 0x7b, 0x45, 0x07, 0x53,
+	0x1c, 0x90, 0xb4, 0x1e, 0x0d, 0x8b, 0xa0, 0x66, 0x60, 0x87, 0x04, 0x1c, 0xfb, 0xa7, 0x8c, 0xe2,
+	0x93, 0xf4, 0x07, 0xc0, 0x1a, 0x28, 0x71, 0xc2, 0x5d, 0xac, 0xe4, 0x1b, 0xf9, 0x66, 0xc5, 0x90,
+	0x01, 0x6c, 0x80, 0xaa, 0x8d, 0x03, 0xcb, 0x27, 0x5e, 0x72, 0xa8, 0x52, 0x10, 0x5a, 0x36, 0x05,
+	0xff, 0x07, 0x2b, 0x16, 0xa3, 0x14, 0x5b, 0x49, 0xd4, 0x26, 0xb6, 0x52, 0x4c, 0x6a, 0x5a, 0x4a,
+	0x1c, 0xa9, 0xb5, 0x81, 0xd9, 0x73, 0x0f, 0xd0, 0x84, 0x8c, 0x8c, 0xe5, 0x71, 0x7c, 0x6c, 0xc3,
+	0x7d, 0x00, 0x3a, 0x66, 0x80, 0xdb, 0x36, 0xa6, 0xac, 0xa7, 0x2c, 0x08, 0xef, 0x46, 0x1c, 0xa9,
+	0x3f, 0x49, 0xef, 0x58, 0x43, 0x46, 0x25, 0x09, 0x8e, 0x92, 0x35, 0xfc, 0x17, 0x54, 0x5d, 0x66,
+	0x99, 0x6e, 0x6a, 0x2b, 0x09, 0xdb, 0x2f, 0x71, 0xa4, 0x42, 0x69, 0xcb, 0x88, 0xc8, 0x00, 0x22,
+	0x92, 0xc6, 0x43, 0xb0, 0x6a, 0x5a, 0x16, 0x0b, 0x29, 0x6f, 0x7b, 0x3e, 0x7e, 0x49, 0x2e, 0x95,
+	0x45, 0xe1, 0xdd, 0x8c, 0x23, 0x75, 0x43, 0x7a, 0x27, 0x75, 0x64, 0xac, 0xa4, 0x89, 0x13, 0x11,
+	0xc3, 0x6d, 0x00, 0x7a, 0xa1, 0xcb, 0x49, 0x3b, 0xc0, 0xd4, 0x56, 0x96, 0x1a, 0xf9, 0x66, 0xd9,
+	0xa8, 0x88, 0xcc, 0x53, 0x4c, 0x6d, 0xf8, 0x27, 0x58, 0x77, 0xc9, 0x45, 0x48, 0x6c, 0xc2, 0x07,
+	0xed, 0x1e, 0xb3, 0x43, 0x17, 0x2b, 0x65, 0x51, 0xb4, 0x76, 0x9f, 0x7f, 0x22, 0xd2, 0xf0, 0x31,
+	0x58, 0xf4, 0x4c, 0xdf, 0xec, 0x05, 0x4a, 0xa5, 0x91, 0x6f, 0x56, 0xf7, 0x76, 0xb4, 0x79, 0xf0,
+	0x68, 0x62, 0x62, 0xc2, 0xd3, 0x5a, 0xb8, 0x89, 0xd4, 0x9c, 0x91, 0xee, 0x70, 0xb0, 0x7c, 0x75,
+	0xad, 0xe6, 0xde, 0x5e, 0xab, 0xb9, 0x8f, 0xd7, 0x6a, 0x0e, 0xbd, 0x2a, 0x01, 0xf5, 0xa1, 0x21,
+	0xbf, 0x20, 0xfc, 0xfc, 0x08, 0x7b, 0x2c, 0x20, 0x1c, 0xfe, 0x31, 0x31, 0xef, 0xd6, 0x7a, 0x1c,
+	0xa9, 0xcb, 0xb2, 0x01, 0x22, 0x8d, 0x46, 0x04, 0xfc, 0xf7, 0x00, 0x01, 0xd9, 0x56, 0x67, 0x44,
+	0xf4, 0x63, 0x93, 0xb1, 0x3f, 0x4b, 0x46, 0xf6, 0xc2, 0x63, 0x0d, 0x65, 0x81, 0x79, 0xf4, 0x39,
+	0x60, 0x5a, 0xbf, 0xc5, 0x91, 0xfa, 0x6b, 0x7a, 0xeb, 0xa9, 0x0a, 0x34, 0x4b, 0xd3, 0x0e, 0x58,
+	0xb2, 0xe5, 0x68, 0x05, 0x4e, 0x95, 0x16, 0x8c, 0x23, 0x75, 0x75, 0x34, 0x23, 0x21, 0x20, 0x63,
+	0x54, 0x02, 0xcf, 0xee, 0xd9, 0x03, 0x5f, 0xc0, 0xde, 0x46, 0xc2, 0x5e, 0x1c, 0xa9, 0x2b, 0x72,
+	0x7b, 0xb9, 0x13, 0xba, 0x87, 0xb1, 0x7c, 0x35, 0x02, 0xf1, 0x4d, 0x01, 0xc0, 0x67, 0x9e, 0x6d,
+	0x72, 0x3c, 0xf1, 0x5f, 0xf3, 0xf5, 0xd9, 0xd3, 0x40, 0x59, 0xdc, 0x7f, 0x8c, 0xdd, 0xcf, 0x71,
+	0xa4, 0xae, 0xa5, 0xd8, 0xa5, 0x0a, 0x32, 0x96, 0xc4, 0xf2, 0xd8, 0x86, 0x6d, 0x90, 0x2c, 0xa9,
+	0x83, 0x03, 0x65, 0xa1, 0x51, 0x6c, 0x56, 0xf7, 0x76, 0xe7, 0x37, 0x64, 0xfc, 0xc3, 0x9e, 0x9b,
+	0x6e, 0x88, 0xb3, 0x0d, 0x4f, 0xf7, 0x92, 0x07, 0x24, 0xab, 0xa9, 0x0f, 0xf4, 0x5d, 0x01, 0x6c,
+	0xcf, 0xf6, 0xe5, 0xdb, 0x7e, 0x9e, 0xdf, 0x5b, 0x8b, 0xb2, 0x04, 0x97, 0xe6, 0x12, 0x9c, 0x81,
+	0xec, 0x0c, 0xac, 0x4d, 0x9d, 0x03, 0x1b, 0xa0, 0xd8, 0xc5, 0x83, 0xb4, 0x77, 0xab, 0x71, 0xa4,
+	0x02, 0xb9, 0x4d, 0x17, 0x0f, 0x90, 0x91, 0x48, 0x49, 0x7f, 0xfb, 0x49, 0xa9, 0x52, 0x98, 0xee,
+	0xaf, 0x48, 0x23, 0x43, 0xca, 0xad, 0xd3, 0x9b, 0x61, 0x3d, 0x7f, 0x3b, 0xac, 0xe7, 0x3f, 0x0c,
+	0xeb, 0xf9, 0xd7, 0x77, 0xf5, 0xdc, 0xed, 0x5d, 0x3d, 0xf7, 0xfe, 0xae, 0x9e, 0x3b, 0x3d, 0x74,
+	0x08, 0x3f, 0x0f, 0x3b, 0x9a, 0xc5, 0x7a, 0x3a, 0xa1, 0x0e, 0xa6, 0x21, 0xe1, 0x83, 0xbf, 0x3a,
+	0x21, 0x71, 0x6d, 0x3d, 0xfb, 0x28, 0x5f, 0x3e, 0xf0, 0x2c, 0xf3, 0x81, 0x87, 0x83, 0xce, 0xa2,
+	0x78, 0x92, 0xff, 0xf9, 0x34, 0x00, 0x16, 0x48, 0xf1, 0xab, 0x67, 0x08, 0x00, 0x00,
 }
 
 func (m *RegisterZoneProposal) Marshal() (dAtA []byte, err error) {
@@ -327,6 +334,16 @@ func (m *RegisterZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.LiquidityModule {
 		i--
 		if m.LiquidityModule {
@@ -412,6 +429,16 @@ func (m *RegisterZoneProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if len(m.Deposit) > 0 {
 		i -= len(m.Deposit)
 		copy(dAtA[i:], m.Deposit)
@@ -691,6 +718,8 @@ func (m *RegisterZoneProposal) Size() (n int) {
 	if m.LiquidityModule {
 		n += 2
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovProposals(uint64(l))
 	return n
 }
 
@@ -734,6 +763,8 @@ func (m *RegisterZoneProposalWithDeposit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProposals(uint64(l))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovProposals(uint64(l))
 	return n
 }
 
@@ -1079,6 +1110,39 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LiquidityModule = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -1393,6 +1457,39 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			}
 			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
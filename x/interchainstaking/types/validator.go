@@ -6,12 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// SharesToTokens returns the number of tokens represented by the given delegator shares of the validator.
 func (v Validator) SharesToTokens(shares sdk.Dec) sdk.Int {
 	if v.DelegatorShares.IsZero() {
 		return sdk.ZeroInt()
 	}
 
-	return v.VotingPower.ToDec().Quo(v.DelegatorShares).TruncateInt()
+	return shares.MulInt(v.VotingPower).Quo(v.DelegatorShares).TruncateInt()
 }
 
 func (di DelegatorIntent) AddOrdinal(multiplier sdk.Dec, intents ValidatorIntents) DelegatorIntent {
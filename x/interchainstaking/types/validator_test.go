@@ -117,3 +117,11 @@ func TestAddOrdinalWithNewVal(t *testing.T) {
 	require.Equal(t, di.Intents[2].Weight, sdk.NewDec(4).QuoTruncate(sdk.NewDec(9)))
 	require.Equal(t, di.Intents[3].Weight, sdk.NewDec(1).QuoTruncate(sdk.NewDec(9)))
 }
+
+func TestSharesToTokens(t *testing.T) {
+	val := types.Validator{ValoperAddress: "cosmosvaloper12345678", VotingPower: sdk.NewInt(2000), DelegatorShares: sdk.NewDec(1000)}
+	require.Equal(t, sdk.NewInt(500), val.SharesToTokens(sdk.NewDec(250)))
+
+	val.DelegatorShares = sdk.ZeroDec()
+	require.Equal(t, sdk.ZeroInt(), val.SharesToTokens(sdk.NewDec(250)))
+}
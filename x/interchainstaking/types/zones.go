@@ -177,6 +177,22 @@ func (z *Zone) GetDelegationAccounts() []*ICAAccount {
 	return delegationAccounts
 }
 
+// GetAccountAddresses returns the addresses of all interchain accounts of the zone that have been set.
+func (z *Zone) GetAccountAddresses() []string {
+	addresses := []string{}
+	for _, account := range []*ICAAccount{z.DepositAddress, z.WithdrawalAddress, z.PerformanceAddress} {
+		if account != nil && account.Address != "" {
+			addresses = append(addresses, account.Address)
+		}
+	}
+	for _, account := range z.DelegationAddresses {
+		if account != nil && account.Address != "" {
+			addresses = append(addresses, account.Address)
+		}
+	}
+	return addresses
+}
+
 func (z *Zone) GetAggregateIntentOrDefault() ValidatorIntents {
 	if len(z.AggregateIntent) == 0 {
 		return z.DefaultAggregateIntents()
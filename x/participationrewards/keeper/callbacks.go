@@ -32,6 +32,11 @@ func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.
 	return c.callbacks[id](c.k, ctx, args, query)
 }
 
+// CallTimeout is a no-op; participation rewards queries are re-requested on the following epoch.
+func (c Callbacks) CallTimeout(ctx sdk.Context, id string, query icqtypes.Query) error {
+	return nil
+}
+
 func (c Callbacks) Has(id string) bool {
 	_, found := c.callbacks[id]
 	return found
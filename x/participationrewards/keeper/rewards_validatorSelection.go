@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
 	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
 )
@@ -62,6 +63,8 @@ func (k Keeper) allocateValidatorSelectionRewards(ctx sdk.Context) {
 			types.ModuleName,
 			"validatorselectionrewards",
 			0,
+			0,
+			sdk.Coin{},
 		)
 	}
 }
@@ -228,7 +231,7 @@ func (k Keeper) calcOverallScores(
 	// submit rewards withdrawals to reset zone performance for next epoch
 	k.Logger(ctx).Info("send performance rewards withdrawal messages to reset scores for next epoch")
 	if len(msgs) > 0 {
-		if err := k.icsKeeper.SubmitTx(ctx, msgs, zone.PerformanceAddress, ""); err != nil {
+		if err := k.icsKeeper.SubmitTx(ctx, msgs, zone.PerformanceAddress, icskeeper.OperationMemo(ctx, "performancerewards", zone.PerformanceAddress.Address)); err != nil {
 			return err
 		}
 	}
@@ -33,7 +33,7 @@ func (m *OsmosisModule) Hooks(ctx sdk.Context, k Keeper) {
 		pool, _ := ipool.(types.OsmosisPoolProtocolData)
 
 		// update pool datas
-		k.IcqKeeper.MakeRequest(ctx, connectionData.ConnectionID, connectionData.ChainID, "store/gamm/key", m.GetKeyPrefixPools(pool.PoolID), sdk.NewInt(-1), types.ModuleName, "osmosispoolupdate", 0) // query pool data
+		k.IcqKeeper.MakeRequest(ctx, connectionData.ConnectionID, connectionData.ChainID, "store/gamm/key", m.GetKeyPrefixPools(pool.PoolID), sdk.NewInt(-1), types.ModuleName, "osmosispoolupdate", 0, 0, sdk.Coin{}) // query pool data
 		return false
 	})
 }